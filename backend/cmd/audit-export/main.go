@@ -0,0 +1,157 @@
+// Command audit-export downloads the audit log from the server, newest
+// entries first, and writes it as JSON lines or CSV. It needs the token of
+// a user who may view the audit log.
+//
+// Usage:
+//
+//	audit-export -token <token> [-event auth.*] [-actor 7] [-ip 10.0.0.1] [-since 2024-01-01T00:00:00Z] [-until ...] [-format jsonl|csv] [-o audit.jsonl]
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+)
+
+// pageSize is the number of entries fetched per request, the most the
+// server returns at once.
+const pageSize = 1000
+
+func main() {
+	server := flag.String("server", "http://localhost:8080", "API base URL")
+	token := flag.String("token", "", "bearer token of a user who may view the audit log")
+	event := flag.String("event", "", "only this event, or events with this prefix if it ends in *")
+	actorID := flag.Int("actor", 0, "only events of this user ID")
+	ip := flag.String("ip", "", "only events from this IP address")
+	since := flag.String("since", "", "only events at or after this RFC 3339 time")
+	until := flag.String("until", "", "only events before this RFC 3339 time")
+	format := flag.String("format", "jsonl", "output format, jsonl or csv")
+	output := flag.String("o", "", "write to this file instead of standard output")
+	flag.Parse()
+
+	if *token == "" || (*format != "jsonl" && *format != "csv") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(pageSize))
+	if *event != "" {
+		query.Set("event", *event)
+	}
+	if *actorID != 0 {
+		query.Set("actor_id", strconv.Itoa(*actorID))
+	}
+	if *ip != "" {
+		query.Set("ip", *ip)
+	}
+	if *since != "" {
+		query.Set("since", *since)
+	}
+	if *until != "" {
+		query.Set("until", *until)
+	}
+
+	out := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", *output, err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	var write func(*ent.AuditEvent) error
+	var flush func() error
+	if *format == "csv" {
+		w := csv.NewWriter(out)
+		if err := w.Write([]string{"id", "created_at", "event", "actor_id", "ip", "user_agent", "details"}); err != nil {
+			log.Fatalf("Failed to write: %v", err)
+		}
+		write = func(e *ent.AuditEvent) error { return w.Write(csvRecord(e)) }
+		flush = func() error { w.Flush(); return w.Error() }
+	} else {
+		enc := json.NewEncoder(out)
+		write = func(e *ent.AuditEvent) error { return enc.Encode(e) }
+		flush = func() error { return nil }
+	}
+
+	total := 0
+	for {
+		events, err := fetchPage(*server, *token, query)
+		if err != nil {
+			log.Fatalf("Failed to fetch audit log: %v", err)
+		}
+		for _, e := range events {
+			if err := write(e); err != nil {
+				log.Fatalf("Failed to write: %v", err)
+			}
+		}
+		total += len(events)
+		if len(events) < pageSize {
+			break
+		}
+		query.Set("before_id", strconv.Itoa(events[len(events)-1].ID))
+	}
+	if err := flush(); err != nil {
+		log.Fatalf("Failed to write: %v", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %d audit events\n", total)
+}
+
+func fetchPage(server, token string, query url.Values) ([]*ent.AuditEvent, error) {
+	u := strings.TrimRight(server, "/") + "/api/admin/audit?" + query.Encode()
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", u, resp.Status)
+	}
+
+	var events []*ent.AuditEvent
+	if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func csvRecord(e *ent.AuditEvent) []string {
+	actor := ""
+	if e.ActorID != nil {
+		actor = strconv.Itoa(*e.ActorID)
+	}
+	details := ""
+	if len(e.Details) > 0 {
+		data, _ := json.Marshal(e.Details)
+		details = string(data)
+	}
+	return []string{
+		strconv.Itoa(e.ID),
+		e.CreatedAt.Format(time.RFC3339),
+		e.Event,
+		actor,
+		e.IP,
+		e.UserAgent,
+		details,
+	}
+}
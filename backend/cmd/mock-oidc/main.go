@@ -0,0 +1,42 @@
+// Command mock-oidc is a stand-in OpenID Connect provider for trying out
+// and testing PollApp's single sign-on locally. It signs in whoever asks,
+// as whatever email they enter, so never expose it.
+//
+// Usage:
+//
+//	mock-oidc [-addr :9000] [-client-id pollapp] [-email alice@example.com] [-auto]
+//
+// Then start the server with OIDC_ISSUER=http://localhost:9000,
+// OIDC_CLIENT_ID=pollapp and
+// OIDC_REDIRECT_URL=http://localhost:8080/api/auth/oidc/callback. With
+// -auto the sign-in page is skipped and -email signs in straight away.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"pollapp/backend/internal/oidc/oidctest"
+)
+
+func main() {
+	addr := flag.String("addr", ":9000", "listen address")
+	issuer := flag.String("issuer", "http://localhost:9000", "issuer URL, as PollApp reaches this server")
+	clientID := flag.String("client-id", "pollapp", "client ID PollApp uses")
+	email := flag.String("email", "alice@example.com", "email to sign in as")
+	auto := flag.Bool("auto", false, "sign in as -email without showing the sign-in page")
+	flag.Parse()
+
+	p, err := oidctest.New(*issuer, *clientID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	p.SignInAs(*email, true)
+	if !*auto {
+		p.ShowSignInPage()
+	}
+
+	log.Printf("Mock OpenID provider %s listening on %s", p.Issuer(), *addr)
+	log.Fatal(http.ListenAndServe(*addr, p))
+}
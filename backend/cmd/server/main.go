@@ -1,13 +1,21 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"net/http"
 	"os"
+	"strings"
 
+	"pollapp/backend/ent/user"
+	"pollapp/backend/internal/audit"
+	"pollapp/backend/internal/event"
 	"pollapp/backend/internal/handler"
+	"pollapp/backend/internal/jwtkeys"
+	"pollapp/backend/internal/mail"
 	"pollapp/backend/internal/middleware"
+	"pollapp/backend/internal/oidc"
 	"pollapp/backend/internal/service"
 
 	"entgo.io/ent/dialect"
@@ -52,7 +60,7 @@ func main() {
 	log.Println("Database connection successful")
 
 	// Check if required tables exist
-	requiredTables := []string{"users", "polls", "poll_options", "votes"}
+	requiredTables := []string{"users", "polls", "poll_options", "votes", "ballots", "ballot_entries", "poll_transitions", "participations", "anonymous_ballots", "ballot_log_entries", "guest_voters", "poll_members", "share_links", "organizations", "organization_members", "poll_collaborators", "poll_revisions", "audit_events", "sessions", "refresh_tokens", "user_identities", "api_keys", "email_tokens", "recovery_codes"}
 	missingTables := []string{}
 	
 	for _, table := range requiredTables {
@@ -72,13 +80,117 @@ func main() {
 	drv := entsql.OpenDB(dialect.MySQL, db)
 	client := handler.NewEntClient(drv)
 
+	// Poll lifecycle events
+	bus := event.NewBus()
+	bus.Subscribe(event.PollClosed, func(e event.Event) {
+		log.Printf("Poll %d closed at %s", e.PollID, e.At.Format("2006-01-02T15:04:05Z07:00"))
+	})
+
+	// Token signing keys. Retired keys stay listed in JWT_VERIFY_KEYS or
+	// JWT_VERIFY_SECRETS until the tokens they signed have expired
+	keyConfig := jwtkeys.Config{
+		SigningKeyFile: os.Getenv("JWT_SIGNING_KEY"),
+		Secret:         os.Getenv("JWT_SECRET"),
+		VerifyKeyFiles: splitList(os.Getenv("JWT_VERIFY_KEYS")),
+		VerifySecrets:  splitList(os.Getenv("JWT_VERIFY_SECRETS")),
+		Issuer:         os.Getenv("JWT_ISSUER"),
+		Audience:       os.Getenv("JWT_AUDIENCE"),
+	}
+	if keyConfig.SigningKeyFile == "" && keyConfig.Secret == "" {
+		keyConfig.Secret = "your-secret-key-change-in-production" // Set JWT_SIGNING_KEY or JWT_SECRET in production
+		log.Println("Warning: JWT_SIGNING_KEY and JWT_SECRET are unset, signing tokens with the development secret")
+	}
+	if keyConfig.Issuer == "" {
+		keyConfig.Issuer = "pollapp"
+	}
+	if keyConfig.Audience == "" {
+		keyConfig.Audience = "pollapp"
+	}
+	keys, err := jwtkeys.Load(keyConfig)
+	if err != nil {
+		log.Fatal("Failed to load signing keys:", err)
+	}
+
 	// Initialize services
-	authService := service.NewAuthService(client)
-	pollService := service.NewPollService(client)
+	authService := service.NewAuthService(client, keys)
+	pollService := service.NewPollService(client, bus)
+	orgService := service.NewOrgService(client)
+	adminService := service.NewAdminService(client)
+
+	// Single sign-on through an OpenID Connect provider, if configured
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		if os.Getenv("OIDC_CLIENT_ID") == "" || os.Getenv("OIDC_REDIRECT_URL") == "" {
+			log.Fatal("OIDC_ISSUER is set but OIDC_CLIENT_ID or OIDC_REDIRECT_URL is not")
+		}
+		authService.EnableOIDC(oidc.New(oidc.Config{
+			Issuer:       issuer,
+			ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		}))
+	}
+
+	// Email for password resets and verification: SMTP if configured,
+	// otherwise .eml files in MAIL_DIR, otherwise the log
+	mailFrom := os.Getenv("MAIL_FROM")
+	if mailFrom == "" {
+		mailFrom = "PollApp <no-reply@localhost>"
+	}
+	var mailer mail.Mailer = mail.LogMailer{}
+	if addr := os.Getenv("MAIL_SMTP_ADDR"); addr != "" {
+		mailer = &mail.SMTPMailer{
+			Addr:          addr,
+			Username:      os.Getenv("MAIL_SMTP_USERNAME"),
+			Password:      os.Getenv("MAIL_SMTP_PASSWORD"),
+			From:          mailFrom,
+			AllowInsecure: os.Getenv("MAIL_SMTP_INSECURE") == "true",
+		}
+	} else if dir := os.Getenv("MAIL_DIR"); dir != "" {
+		mailer = &mail.FileMailer{Dir: dir, From: mailFrom}
+	} else {
+		log.Println("WARNING: MAIL_SMTP_ADDR and MAIL_DIR are unset, printing emails to the log. " +
+			"They hold live password reset and verification links; anyone who can read the log can take over accounts. " +
+			"Set MAIL_SMTP_ADDR in production")
+	}
+	appURL := os.Getenv("APP_URL")
+	if appURL == "" {
+		appURL = "http://localhost:3000"
+	}
+	authService.UseMailer(mailer, appURL)
+
+	if os.Getenv("REQUIRE_VERIFIED_EMAIL") == "true" {
+		pollService.RequireVerifiedEmail()
+	}
+
+	// Roles that must sign in with a second factor, admins unless set
+	mfaRoles := []user.Role{user.RoleAdmin}
+	if roles, ok := os.LookupEnv("MFA_REQUIRED_ROLES"); ok {
+		mfaRoles = nil
+		for _, role := range splitList(roles) {
+			if err := user.RoleValidator(user.Role(role)); err != nil {
+				log.Fatalf("MFA_REQUIRED_ROLES: unknown role %q", role)
+			}
+			mfaRoles = append(mfaRoles, user.Role(role))
+		}
+	}
+	authService.RequireMFA(mfaRoles...)
+
+	// Promote the configured admins, a comma-separated list of emails
+	if err := adminService.PromoteAdmins(context.Background(), splitList(os.Getenv("ADMIN_EMAILS"))); err != nil {
+		log.Fatal("Failed to promote admins:", err)
+	}
+
+	// Publish scheduled poll closes in the background
+	scheduler := service.NewPollScheduler(client, bus)
+	go scheduler.Run(context.Background())
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authService)
+	oidcHandler := handler.NewOIDCHandler(authService, os.Getenv("OIDC_POST_LOGIN_REDIRECT"))
 	pollHandler := handler.NewPollHandler(pollService)
+	guestHandler := handler.NewGuestHandler(authService, pollService)
+	orgHandler := handler.NewOrgHandler(orgService)
+	adminHandler := handler.NewAdminHandler(adminService)
 
 	// Setup router
 	router := httprouter.New()
@@ -89,7 +201,7 @@ func main() {
 			header := w.Header()
 			header.Set("Access-Control-Allow-Origin", "*")
 			header.Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			header.Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			header.Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Guest-Token, X-API-Key")
 		}
 		w.WriteHeader(http.StatusNoContent)
 	})
@@ -99,22 +211,88 @@ func main() {
 		return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Guest-Token, X-API-Key")
 			h(w, r, ps)
 		}
 	}
 
 	// Public routes
+	router.GET("/.well-known/jwks.json", corsHandler(authHandler.JWKS))
 	router.POST("/api/auth/register", corsHandler(authHandler.Register))
 	router.POST("/api/auth/login", corsHandler(authHandler.Login))
+	router.POST("/api/auth/refresh", corsHandler(authHandler.Refresh))
+	router.POST("/api/auth/logout", corsHandler(authHandler.Logout))
+	router.POST("/api/auth/forgot", corsHandler(authHandler.ForgotPassword))
+	router.POST("/api/auth/reset", corsHandler(authHandler.ResetPassword))
+	router.POST("/api/auth/verify", corsHandler(authHandler.VerifyEmail))
+	router.POST("/api/auth/verify/resend", corsHandler(middleware.AuthMiddleware(authService, authHandler.ResendVerification)))
+	router.POST("/api/auth/mfa/verify", corsHandler(authHandler.VerifyMFA))
+	router.POST("/api/auth/mfa/enroll", corsHandler(middleware.OptionalAuthMiddleware(authService, authHandler.EnrollTOTP)))
+	router.POST("/api/auth/mfa/confirm", corsHandler(middleware.OptionalAuthMiddleware(authService, authHandler.ConfirmTOTP)))
+	router.POST("/api/auth/mfa/disable", corsHandler(middleware.AuthMiddleware(authService, authHandler.DisableTOTP)))
+	router.POST("/api/auth/mfa/recovery-codes", corsHandler(middleware.AuthMiddleware(authService, authHandler.RegenerateRecoveryCodes)))
+	router.GET("/api/auth/oidc/login", oidcHandler.Login)
+	router.GET("/api/auth/oidc/callback", oidcHandler.Callback)
+	router.POST("/api/auth/switch-org", corsHandler(middleware.AuthMiddleware(authService, authHandler.SwitchOrganization)))
+
+	// Personal API key routes
+	router.GET("/api/keys", corsHandler(middleware.AuthMiddleware(authService, authHandler.ListAPIKeys)))
+	router.POST("/api/keys", corsHandler(middleware.AuthMiddleware(authService, authHandler.CreateAPIKey)))
+	router.DELETE("/api/keys/:id", corsHandler(middleware.AuthMiddleware(authService, authHandler.RevokeAPIKey)))
+
+	// Organizations
+	router.GET("/api/orgs", corsHandler(middleware.AuthMiddleware(authService, orgHandler.ListOrganizations)))
+	router.POST("/api/orgs", corsHandler(middleware.AuthMiddleware(authService, orgHandler.CreateOrganization)))
+	router.GET("/api/orgs/:id/members", corsHandler(middleware.AuthMiddleware(authService, orgHandler.ListMembers)))
+	router.POST("/api/orgs/:id/members", corsHandler(middleware.AuthMiddleware(authService, orgHandler.SetMember)))
+	router.DELETE("/api/orgs/:id/members/:user", corsHandler(middleware.AuthMiddleware(authService, orgHandler.RemoveMember)))
+	router.POST("/api/orgs/:id/accept", corsHandler(middleware.AuthMiddleware(authService, orgHandler.AcceptInvitation)))
 
 	// Protected routes
-	router.POST("/api/polls", corsHandler(middleware.AuthMiddleware(authService, pollHandler.CreatePoll)))
-	router.GET("/api/polls", corsHandler(pollHandler.ListPolls))
-	router.GET("/api/polls/:id", corsHandler(pollHandler.GetPoll))
-	router.PUT("/api/polls/:id", corsHandler(middleware.AuthMiddleware(authService, pollHandler.UpdatePoll)))
-	router.DELETE("/api/polls/:id", corsHandler(middleware.AuthMiddleware(authService, pollHandler.DeletePoll)))
-	router.POST("/api/polls/:id/vote", corsHandler(middleware.AuthMiddleware(authService, pollHandler.Vote)))
+	router.POST("/api/polls", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.CreatePoll))))
+	router.GET("/api/polls", corsHandler(middleware.OptionalAuthMiddleware(authService, pollHandler.ListPolls)))
+	router.GET("/api/polls/:id", corsHandler(middleware.OptionalAuthMiddleware(authService, pollHandler.GetPoll)))
+	router.GET("/api/polls/:id/results", corsHandler(middleware.OptionalAuthMiddleware(authService, pollHandler.GetResults)))
+	router.GET("/api/polls/:id/ballot-log", corsHandler(middleware.OptionalAuthMiddleware(authService, pollHandler.BallotLog)))
+	router.POST("/api/polls/:id/verify-receipt", corsHandler(middleware.OptionalAuthMiddleware(authService, pollHandler.VerifyReceipt)))
+	router.PUT("/api/polls/:id", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.UpdatePoll))))
+	router.DELETE("/api/polls/:id", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.DeletePoll))))
+	router.POST("/api/polls/:id/options", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.AddOption))))
+	router.PUT("/api/polls/:id/options", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.ReorderOptions))))
+	router.PUT("/api/polls/:id/options/:optionID", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.RenameOption))))
+	router.DELETE("/api/polls/:id/options/:optionID", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.RemoveOption))))
+	router.GET("/api/polls/:id/history", corsHandler(middleware.AuthMiddleware(authService, pollHandler.History)))
+	router.POST("/api/polls/:id/vote", corsHandler(middleware.APIKeyScope(service.ScopeVotesWrite, middleware.VoterMiddleware(authService, pollHandler.Vote))))
+	router.PUT("/api/polls/:id/visibility", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.SetVisibility))))
+	router.GET("/api/polls/:id/members", corsHandler(middleware.AuthMiddleware(authService, pollHandler.ListMembers)))
+	router.POST("/api/polls/:id/members", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.AddMember))))
+	router.DELETE("/api/polls/:id/members/:user", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.RemoveMember))))
+	router.GET("/api/polls/:id/collaborators", corsHandler(middleware.AuthMiddleware(authService, pollHandler.ListCollaborators)))
+	router.POST("/api/polls/:id/collaborators", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.SetCollaborator))))
+	router.DELETE("/api/polls/:id/collaborators/:user", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.RemoveCollaborator))))
+	router.GET("/api/polls/:id/links", corsHandler(middleware.AuthMiddleware(authService, pollHandler.ListShareLinks)))
+	router.POST("/api/polls/:id/links", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.CreateShareLink))))
+	router.DELETE("/api/polls/:id/links/:linkID", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.RevokeShareLink))))
+	router.POST("/api/polls/:id/guest-token", corsHandler(guestHandler.IssueToken))
+	router.GET("/api/polls/:id/participation", corsHandler(middleware.AuthMiddleware(authService, pollHandler.ParticipationCounts)))
+	router.POST("/api/polls/:id/close", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.ClosePoll))))
+	router.POST("/api/polls/:id/reopen", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.ReopenPoll))))
+	router.POST("/api/polls/:id/archive", corsHandler(middleware.APIKeyScope(service.ScopePollsWrite, middleware.AuthMiddleware(authService, pollHandler.ArchivePoll))))
+
+	// Share links; the link stands in for the poll ID
+	router.GET("/p/:token", corsHandler(middleware.ShareLinkMiddleware(pollService, false, middleware.OptionalAuthMiddleware(authService, pollHandler.GetPoll))))
+	router.GET("/p/:token/results", corsHandler(middleware.ShareLinkMiddleware(pollService, false, middleware.OptionalAuthMiddleware(authService, pollHandler.GetResults))))
+	router.POST("/p/:token/vote", corsHandler(middleware.ShareLinkMiddleware(pollService, true, middleware.APIKeyScope(service.ScopeVotesWrite, middleware.VoterMiddleware(authService, pollHandler.Vote)))))
+	router.POST("/p/:token/guest-token", corsHandler(middleware.ShareLinkMiddleware(pollService, true, guestHandler.IssueToken)))
+
+	// Site administration routes
+	router.GET("/api/admin/users", corsHandler(middleware.RequirePermission(authService, service.PermBanUsers, adminHandler.ListUsers)))
+	router.PUT("/api/admin/users/:id/role", corsHandler(middleware.RequirePermission(authService, service.PermManageRoles, adminHandler.SetRole)))
+	router.POST("/api/admin/users/:id/ban", corsHandler(middleware.RequirePermission(authService, service.PermBanUsers, adminHandler.BanUser)))
+	router.DELETE("/api/admin/users/:id/ban", corsHandler(middleware.RequirePermission(authService, service.PermBanUsers, adminHandler.UnbanUser)))
+	router.GET("/api/admin/transitions", corsHandler(middleware.RequirePermission(authService, service.PermViewAudit, adminHandler.Transitions)))
+	router.GET("/api/admin/polls/:id/history", corsHandler(middleware.RequirePermission(authService, service.PermViewAudit, adminHandler.PollHistory)))
+	router.GET("/api/admin/audit", corsHandler(middleware.RequirePermission(authService, service.PermViewAudit, adminHandler.AuditLog)))
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -122,5 +300,17 @@ func main() {
 	}
 
 	log.Printf("Server starting on port %s", port)
-	log.Fatal(http.ListenAndServe(":"+port, router))
+	log.Fatal(http.ListenAndServe(":"+port, audit.WithRequest(router)))
+}
+
+// splitList splits a comma-separated environment variable, dropping empty
+// entries.
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
 }
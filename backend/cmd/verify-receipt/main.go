@@ -0,0 +1,172 @@
+// Command verify-receipt checks a voting receipt against a poll's ballot
+// log without trusting the server's own verification: it fetches the log
+// (or reads a saved copy), recomputes every chain hash and looks for the
+// receipt's ballot hash.
+//
+// Usage:
+//
+//	verify-receipt -poll 12 -ballot-hash <hash> [-nonce <nonce> -choices 3,1 | -scores 3=5,1=2]
+//
+// Given the nonce and the ballot as cast, it also checks that the ballot
+// hash really stands for that ballot.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"pollapp/backend/internal/ballotlog"
+	"pollapp/backend/internal/service"
+)
+
+func main() {
+	server := flag.String("server", "http://localhost:8080", "API base URL")
+	token := flag.String("token", "", "bearer token, for polls whose results aren't public yet")
+	file := flag.String("file", "", "read the ballot log from this file instead of the server")
+	pollID := flag.Int("poll", 0, "poll ID")
+	ballotHash := flag.String("ballot-hash", "", "ballot_hash from the receipt")
+	nonce := flag.String("nonce", "", "nonce from the receipt")
+	choices := flag.String("choices", "", "comma-separated option IDs as voted, most preferred first on ranked polls")
+	scores := flag.String("scores", "", "comma-separated option=score pairs as voted")
+	flag.Parse()
+
+	if *pollID == 0 || *ballotHash == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	chain, err := loadChain(*server, *token, *file, *pollID)
+	if err != nil {
+		log.Fatalf("Failed to load ballot log: %v", err)
+	}
+	if chain.PollID != *pollID {
+		log.Fatalf("Ballot log is for poll %d, not %d", chain.PollID, *pollID)
+	}
+
+	ok := true
+
+	if *nonce != "" {
+		ids, err := parseChoices(*choices)
+		if err != nil {
+			log.Fatalf("Invalid -choices: %v", err)
+		}
+		if chain.VotingMethod != "ranked" {
+			sort.Ints(ids)
+		}
+		scoreMap, err := parseScores(*scores)
+		if err != nil {
+			log.Fatalf("Invalid -scores: %v", err)
+		}
+		if ballotlog.BallotHash(*pollID, *nonce, ids, scoreMap) == *ballotHash {
+			fmt.Println("✓ ballot hash matches the ballot and nonce")
+		} else {
+			fmt.Println("✗ ballot hash does not match the ballot and nonce")
+			ok = false
+		}
+	}
+
+	head, err := ballotlog.Verify(*pollID, chain.Entries)
+	switch {
+	case err != nil:
+		fmt.Printf("✗ chain is broken: %v\n", err)
+		ok = false
+	case head != chain.Head:
+		fmt.Printf("✗ chain hashes to %s, but the published head is %s\n", head, chain.Head)
+		ok = false
+	default:
+		fmt.Printf("✓ chain of %d entries is intact, head %s\n", len(chain.Entries), head)
+	}
+
+	if e, found := ballotlog.Find(chain.Entries, *ballotHash); found {
+		fmt.Printf("✓ ballot is included at sequence %d\n", e.Sequence)
+	} else {
+		fmt.Println("✗ ballot is not in the log")
+		ok = false
+	}
+
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+func loadChain(server, token, file string, pollID int) (*service.BallotChain, error) {
+	var chain service.BallotChain
+
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if err := json.NewDecoder(f).Decode(&chain); err != nil {
+			return nil, err
+		}
+		return &chain, nil
+	}
+
+	url := fmt.Sprintf("%s/api/polls/%d/ballot-log", strings.TrimRight(server, "/"), pollID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&chain); err != nil {
+		return nil, err
+	}
+	return &chain, nil
+}
+
+func parseChoices(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func parseScores(s string) (map[int]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	scores := make(map[int]int)
+	for _, part := range strings.Split(s, ",") {
+		id, score, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			return nil, fmt.Errorf("%q is not option=score", part)
+		}
+		optionID, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+		value, err := strconv.Atoi(score)
+		if err != nil {
+			return nil, err
+		}
+		scores[optionID] = value
+	}
+	return scores, nil
+}
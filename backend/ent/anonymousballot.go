@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/poll"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AnonymousBallot is the model entity for the AnonymousBallot schema.
+type AnonymousBallot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID int `json:"poll_id,omitempty"`
+	// Choices holds the value of the "choices" field.
+	Choices []int `json:"choices,omitempty"`
+	// Scores holds the value of the "scores" field.
+	Scores map[int]int `json:"scores,omitempty"`
+	// ReceiptHash holds the value of the "receipt_hash" field.
+	ReceiptHash string `json:"-"`
+	// CastOn holds the value of the "cast_on" field.
+	CastOn time.Time `json:"cast_on,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnonymousBallotQuery when eager-loading is set.
+	Edges        AnonymousBallotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnonymousBallotEdges holds the relations/edges for other nodes in the graph.
+type AnonymousBallotEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnonymousBallotEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnonymousBallot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case anonymousballot.FieldChoices, anonymousballot.FieldScores:
+			values[i] = new([]byte)
+		case anonymousballot.FieldPollID:
+			values[i] = new(sql.NullInt64)
+		case anonymousballot.FieldID, anonymousballot.FieldReceiptHash:
+			values[i] = new(sql.NullString)
+		case anonymousballot.FieldCastOn:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnonymousBallot fields.
+func (_m *AnonymousBallot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case anonymousballot.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case anonymousballot.FieldPollID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value.Valid {
+				_m.PollID = int(value.Int64)
+			}
+		case anonymousballot.FieldChoices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field choices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Choices); err != nil {
+					return fmt.Errorf("unmarshal field choices: %w", err)
+				}
+			}
+		case anonymousballot.FieldScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scores); err != nil {
+					return fmt.Errorf("unmarshal field scores: %w", err)
+				}
+			}
+		case anonymousballot.FieldReceiptHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_hash", values[i])
+			} else if value.Valid {
+				_m.ReceiptHash = value.String
+			}
+		case anonymousballot.FieldCastOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cast_on", values[i])
+			} else if value.Valid {
+				_m.CastOn = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnonymousBallot.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnonymousBallot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the AnonymousBallot entity.
+func (_m *AnonymousBallot) QueryPoll() *PollQuery {
+	return NewAnonymousBallotClient(_m.config).QueryPoll(_m)
+}
+
+// Update returns a builder for updating this AnonymousBallot.
+// Note that you need to call AnonymousBallot.Unwrap() before calling this method if this AnonymousBallot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnonymousBallot) Update() *AnonymousBallotUpdateOne {
+	return NewAnonymousBallotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnonymousBallot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnonymousBallot) Unwrap() *AnonymousBallot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnonymousBallot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnonymousBallot) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnonymousBallot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("choices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Choices))
+	builder.WriteString(", ")
+	builder.WriteString("scores=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scores))
+	builder.WriteString(", ")
+	builder.WriteString("receipt_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("cast_on=")
+	builder.WriteString(_m.CastOn.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnonymousBallots is a parsable slice of AnonymousBallot.
+type AnonymousBallots []*AnonymousBallot
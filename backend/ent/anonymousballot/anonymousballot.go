@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package anonymousballot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the anonymousballot type in the database.
+	Label = "anonymous_ballot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPollID holds the string denoting the poll_id field in the database.
+	FieldPollID = "poll_id"
+	// FieldChoices holds the string denoting the choices field in the database.
+	FieldChoices = "choices"
+	// FieldScores holds the string denoting the scores field in the database.
+	FieldScores = "scores"
+	// FieldReceiptHash holds the string denoting the receipt_hash field in the database.
+	FieldReceiptHash = "receipt_hash"
+	// FieldCastOn holds the string denoting the cast_on field in the database.
+	FieldCastOn = "cast_on"
+	// EdgePoll holds the string denoting the poll edge name in mutations.
+	EdgePoll = "poll"
+	// Table holds the table name of the anonymousballot in the database.
+	Table = "anonymous_ballots"
+	// PollTable is the table that holds the poll relation/edge.
+	PollTable = "anonymous_ballots"
+	// PollInverseTable is the table name for the Poll entity.
+	// It exists in this package in order to avoid circular dependency with the "poll" package.
+	PollInverseTable = "polls"
+	// PollColumn is the table column denoting the poll relation/edge.
+	PollColumn = "poll_id"
+)
+
+// Columns holds all SQL columns for anonymousballot fields.
+var Columns = []string{
+	FieldID,
+	FieldPollID,
+	FieldChoices,
+	FieldScores,
+	FieldReceiptHash,
+	FieldCastOn,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the AnonymousBallot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPollID orders the results by the poll_id field.
+func ByPollID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollID, opts...).ToFunc()
+}
+
+// ByReceiptHash orders the results by the receipt_hash field.
+func ByReceiptHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptHash, opts...).ToFunc()
+}
+
+// ByCastOn orders the results by the cast_on field.
+func ByCastOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCastOn, opts...).ToFunc()
+}
+
+// ByPollField orders the results by poll field.
+func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPollStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PollTable, PollColumn),
+	)
+}
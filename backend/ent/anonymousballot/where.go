@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package anonymousballot
+
+import (
+	"pollapp/backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldContainsFold(FieldID, id))
+}
+
+// PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
+func PollID(v int) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldPollID, v))
+}
+
+// ReceiptHash applies equality check predicate on the "receipt_hash" field. It's identical to ReceiptHashEQ.
+func ReceiptHash(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldReceiptHash, v))
+}
+
+// CastOn applies equality check predicate on the "cast_on" field. It's identical to CastOnEQ.
+func CastOn(v time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldCastOn, v))
+}
+
+// PollIDEQ applies the EQ predicate on the "poll_id" field.
+func PollIDEQ(v int) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollIDNEQ applies the NEQ predicate on the "poll_id" field.
+func PollIDNEQ(v int) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNEQ(FieldPollID, v))
+}
+
+// PollIDIn applies the In predicate on the "poll_id" field.
+func PollIDIn(vs ...int) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldIn(FieldPollID, vs...))
+}
+
+// PollIDNotIn applies the NotIn predicate on the "poll_id" field.
+func PollIDNotIn(vs ...int) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNotIn(FieldPollID, vs...))
+}
+
+// ChoicesIsNil applies the IsNil predicate on the "choices" field.
+func ChoicesIsNil() predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldIsNull(FieldChoices))
+}
+
+// ChoicesNotNil applies the NotNil predicate on the "choices" field.
+func ChoicesNotNil() predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNotNull(FieldChoices))
+}
+
+// ScoresIsNil applies the IsNil predicate on the "scores" field.
+func ScoresIsNil() predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldIsNull(FieldScores))
+}
+
+// ScoresNotNil applies the NotNil predicate on the "scores" field.
+func ScoresNotNil() predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNotNull(FieldScores))
+}
+
+// ReceiptHashEQ applies the EQ predicate on the "receipt_hash" field.
+func ReceiptHashEQ(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldReceiptHash, v))
+}
+
+// ReceiptHashNEQ applies the NEQ predicate on the "receipt_hash" field.
+func ReceiptHashNEQ(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNEQ(FieldReceiptHash, v))
+}
+
+// ReceiptHashIn applies the In predicate on the "receipt_hash" field.
+func ReceiptHashIn(vs ...string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldIn(FieldReceiptHash, vs...))
+}
+
+// ReceiptHashNotIn applies the NotIn predicate on the "receipt_hash" field.
+func ReceiptHashNotIn(vs ...string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNotIn(FieldReceiptHash, vs...))
+}
+
+// ReceiptHashGT applies the GT predicate on the "receipt_hash" field.
+func ReceiptHashGT(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldGT(FieldReceiptHash, v))
+}
+
+// ReceiptHashGTE applies the GTE predicate on the "receipt_hash" field.
+func ReceiptHashGTE(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldGTE(FieldReceiptHash, v))
+}
+
+// ReceiptHashLT applies the LT predicate on the "receipt_hash" field.
+func ReceiptHashLT(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldLT(FieldReceiptHash, v))
+}
+
+// ReceiptHashLTE applies the LTE predicate on the "receipt_hash" field.
+func ReceiptHashLTE(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldLTE(FieldReceiptHash, v))
+}
+
+// ReceiptHashContains applies the Contains predicate on the "receipt_hash" field.
+func ReceiptHashContains(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldContains(FieldReceiptHash, v))
+}
+
+// ReceiptHashHasPrefix applies the HasPrefix predicate on the "receipt_hash" field.
+func ReceiptHashHasPrefix(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldHasPrefix(FieldReceiptHash, v))
+}
+
+// ReceiptHashHasSuffix applies the HasSuffix predicate on the "receipt_hash" field.
+func ReceiptHashHasSuffix(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldHasSuffix(FieldReceiptHash, v))
+}
+
+// ReceiptHashEqualFold applies the EqualFold predicate on the "receipt_hash" field.
+func ReceiptHashEqualFold(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEqualFold(FieldReceiptHash, v))
+}
+
+// ReceiptHashContainsFold applies the ContainsFold predicate on the "receipt_hash" field.
+func ReceiptHashContainsFold(v string) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldContainsFold(FieldReceiptHash, v))
+}
+
+// CastOnEQ applies the EQ predicate on the "cast_on" field.
+func CastOnEQ(v time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldEQ(FieldCastOn, v))
+}
+
+// CastOnNEQ applies the NEQ predicate on the "cast_on" field.
+func CastOnNEQ(v time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNEQ(FieldCastOn, v))
+}
+
+// CastOnIn applies the In predicate on the "cast_on" field.
+func CastOnIn(vs ...time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldIn(FieldCastOn, vs...))
+}
+
+// CastOnNotIn applies the NotIn predicate on the "cast_on" field.
+func CastOnNotIn(vs ...time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldNotIn(FieldCastOn, vs...))
+}
+
+// CastOnGT applies the GT predicate on the "cast_on" field.
+func CastOnGT(v time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldGT(FieldCastOn, v))
+}
+
+// CastOnGTE applies the GTE predicate on the "cast_on" field.
+func CastOnGTE(v time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldGTE(FieldCastOn, v))
+}
+
+// CastOnLT applies the LT predicate on the "cast_on" field.
+func CastOnLT(v time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldLT(FieldCastOn, v))
+}
+
+// CastOnLTE applies the LTE predicate on the "cast_on" field.
+func CastOnLTE(v time.Time) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.FieldLTE(FieldCastOn, v))
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnonymousBallot) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnonymousBallot) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnonymousBallot) predicate.AnonymousBallot {
+	return predicate.AnonymousBallot(sql.NotPredicates(p))
+}
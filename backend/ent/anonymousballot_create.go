@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/poll"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnonymousBallotCreate is the builder for creating a AnonymousBallot entity.
+type AnonymousBallotCreate struct {
+	config
+	mutation *AnonymousBallotMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *AnonymousBallotCreate) SetPollID(v int) *AnonymousBallotCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetChoices sets the "choices" field.
+func (_c *AnonymousBallotCreate) SetChoices(v []int) *AnonymousBallotCreate {
+	_c.mutation.SetChoices(v)
+	return _c
+}
+
+// SetScores sets the "scores" field.
+func (_c *AnonymousBallotCreate) SetScores(v map[int]int) *AnonymousBallotCreate {
+	_c.mutation.SetScores(v)
+	return _c
+}
+
+// SetReceiptHash sets the "receipt_hash" field.
+func (_c *AnonymousBallotCreate) SetReceiptHash(v string) *AnonymousBallotCreate {
+	_c.mutation.SetReceiptHash(v)
+	return _c
+}
+
+// SetCastOn sets the "cast_on" field.
+func (_c *AnonymousBallotCreate) SetCastOn(v time.Time) *AnonymousBallotCreate {
+	_c.mutation.SetCastOn(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnonymousBallotCreate) SetID(v string) *AnonymousBallotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AnonymousBallotCreate) SetNillableID(v *string) *AnonymousBallotCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *AnonymousBallotCreate) SetPoll(v *Poll) *AnonymousBallotCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// Mutation returns the AnonymousBallotMutation object of the builder.
+func (_c *AnonymousBallotCreate) Mutation() *AnonymousBallotMutation {
+	return _c.mutation
+}
+
+// Save creates the AnonymousBallot in the database.
+func (_c *AnonymousBallotCreate) Save(ctx context.Context) (*AnonymousBallot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnonymousBallotCreate) SaveX(ctx context.Context) *AnonymousBallot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnonymousBallotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnonymousBallotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnonymousBallotCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := anonymousballot.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnonymousBallotCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "AnonymousBallot.poll_id"`)}
+	}
+	if _, ok := _c.mutation.ReceiptHash(); !ok {
+		return &ValidationError{Name: "receipt_hash", err: errors.New(`ent: missing required field "AnonymousBallot.receipt_hash"`)}
+	}
+	if _, ok := _c.mutation.CastOn(); !ok {
+		return &ValidationError{Name: "cast_on", err: errors.New(`ent: missing required field "AnonymousBallot.cast_on"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "AnonymousBallot.poll"`)}
+	}
+	return nil
+}
+
+func (_c *AnonymousBallotCreate) sqlSave(ctx context.Context) (*AnonymousBallot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AnonymousBallot.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnonymousBallotCreate) createSpec() (*AnonymousBallot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnonymousBallot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(anonymousballot.Table, sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Choices(); ok {
+		_spec.SetField(anonymousballot.FieldChoices, field.TypeJSON, value)
+		_node.Choices = value
+	}
+	if value, ok := _c.mutation.Scores(); ok {
+		_spec.SetField(anonymousballot.FieldScores, field.TypeJSON, value)
+		_node.Scores = value
+	}
+	if value, ok := _c.mutation.ReceiptHash(); ok {
+		_spec.SetField(anonymousballot.FieldReceiptHash, field.TypeString, value)
+		_node.ReceiptHash = value
+	}
+	if value, ok := _c.mutation.CastOn(); ok {
+		_spec.SetField(anonymousballot.FieldCastOn, field.TypeTime, value)
+		_node.CastOn = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   anonymousballot.PollTable,
+			Columns: []string{anonymousballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AnonymousBallotCreateBulk is the builder for creating many AnonymousBallot entities in bulk.
+type AnonymousBallotCreateBulk struct {
+	config
+	err      error
+	builders []*AnonymousBallotCreate
+}
+
+// Save creates the AnonymousBallot entities in the database.
+func (_c *AnonymousBallotCreateBulk) Save(ctx context.Context) ([]*AnonymousBallot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnonymousBallot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnonymousBallotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnonymousBallotCreateBulk) SaveX(ctx context.Context) []*AnonymousBallot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnonymousBallotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnonymousBallotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
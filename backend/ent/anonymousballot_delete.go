@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnonymousBallotDelete is the builder for deleting a AnonymousBallot entity.
+type AnonymousBallotDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnonymousBallotMutation
+}
+
+// Where appends a list predicates to the AnonymousBallotDelete builder.
+func (_d *AnonymousBallotDelete) Where(ps ...predicate.AnonymousBallot) *AnonymousBallotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnonymousBallotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnonymousBallotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnonymousBallotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(anonymousballot.Table, sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnonymousBallotDeleteOne is the builder for deleting a single AnonymousBallot entity.
+type AnonymousBallotDeleteOne struct {
+	_d *AnonymousBallotDelete
+}
+
+// Where appends a list predicates to the AnonymousBallotDelete builder.
+func (_d *AnonymousBallotDeleteOne) Where(ps ...predicate.AnonymousBallot) *AnonymousBallotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnonymousBallotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{anonymousballot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnonymousBallotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AnonymousBallotUpdate is the builder for updating AnonymousBallot entities.
+type AnonymousBallotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnonymousBallotMutation
+}
+
+// Where appends a list predicates to the AnonymousBallotUpdate builder.
+func (_u *AnonymousBallotUpdate) Where(ps ...predicate.AnonymousBallot) *AnonymousBallotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *AnonymousBallotUpdate) SetPollID(v int) *AnonymousBallotUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *AnonymousBallotUpdate) SetNillablePollID(v *int) *AnonymousBallotUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetChoices sets the "choices" field.
+func (_u *AnonymousBallotUpdate) SetChoices(v []int) *AnonymousBallotUpdate {
+	_u.mutation.SetChoices(v)
+	return _u
+}
+
+// AppendChoices appends value to the "choices" field.
+func (_u *AnonymousBallotUpdate) AppendChoices(v []int) *AnonymousBallotUpdate {
+	_u.mutation.AppendChoices(v)
+	return _u
+}
+
+// ClearChoices clears the value of the "choices" field.
+func (_u *AnonymousBallotUpdate) ClearChoices() *AnonymousBallotUpdate {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// SetScores sets the "scores" field.
+func (_u *AnonymousBallotUpdate) SetScores(v map[int]int) *AnonymousBallotUpdate {
+	_u.mutation.SetScores(v)
+	return _u
+}
+
+// ClearScores clears the value of the "scores" field.
+func (_u *AnonymousBallotUpdate) ClearScores() *AnonymousBallotUpdate {
+	_u.mutation.ClearScores()
+	return _u
+}
+
+// SetReceiptHash sets the "receipt_hash" field.
+func (_u *AnonymousBallotUpdate) SetReceiptHash(v string) *AnonymousBallotUpdate {
+	_u.mutation.SetReceiptHash(v)
+	return _u
+}
+
+// SetNillableReceiptHash sets the "receipt_hash" field if the given value is not nil.
+func (_u *AnonymousBallotUpdate) SetNillableReceiptHash(v *string) *AnonymousBallotUpdate {
+	if v != nil {
+		_u.SetReceiptHash(*v)
+	}
+	return _u
+}
+
+// SetCastOn sets the "cast_on" field.
+func (_u *AnonymousBallotUpdate) SetCastOn(v time.Time) *AnonymousBallotUpdate {
+	_u.mutation.SetCastOn(v)
+	return _u
+}
+
+// SetNillableCastOn sets the "cast_on" field if the given value is not nil.
+func (_u *AnonymousBallotUpdate) SetNillableCastOn(v *time.Time) *AnonymousBallotUpdate {
+	if v != nil {
+		_u.SetCastOn(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *AnonymousBallotUpdate) SetPoll(v *Poll) *AnonymousBallotUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// Mutation returns the AnonymousBallotMutation object of the builder.
+func (_u *AnonymousBallotUpdate) Mutation() *AnonymousBallotMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *AnonymousBallotUpdate) ClearPoll() *AnonymousBallotUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnonymousBallotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnonymousBallotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnonymousBallotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnonymousBallotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnonymousBallotUpdate) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnonymousBallot.poll"`)
+	}
+	return nil
+}
+
+func (_u *AnonymousBallotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(anonymousballot.Table, anonymousballot.Columns, sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Choices(); ok {
+		_spec.SetField(anonymousballot.FieldChoices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, anonymousballot.FieldChoices, value)
+		})
+	}
+	if _u.mutation.ChoicesCleared() {
+		_spec.ClearField(anonymousballot.FieldChoices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scores(); ok {
+		_spec.SetField(anonymousballot.FieldScores, field.TypeJSON, value)
+	}
+	if _u.mutation.ScoresCleared() {
+		_spec.ClearField(anonymousballot.FieldScores, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReceiptHash(); ok {
+		_spec.SetField(anonymousballot.FieldReceiptHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CastOn(); ok {
+		_spec.SetField(anonymousballot.FieldCastOn, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   anonymousballot.PollTable,
+			Columns: []string{anonymousballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   anonymousballot.PollTable,
+			Columns: []string{anonymousballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{anonymousballot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnonymousBallotUpdateOne is the builder for updating a single AnonymousBallot entity.
+type AnonymousBallotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnonymousBallotMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *AnonymousBallotUpdateOne) SetPollID(v int) *AnonymousBallotUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *AnonymousBallotUpdateOne) SetNillablePollID(v *int) *AnonymousBallotUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetChoices sets the "choices" field.
+func (_u *AnonymousBallotUpdateOne) SetChoices(v []int) *AnonymousBallotUpdateOne {
+	_u.mutation.SetChoices(v)
+	return _u
+}
+
+// AppendChoices appends value to the "choices" field.
+func (_u *AnonymousBallotUpdateOne) AppendChoices(v []int) *AnonymousBallotUpdateOne {
+	_u.mutation.AppendChoices(v)
+	return _u
+}
+
+// ClearChoices clears the value of the "choices" field.
+func (_u *AnonymousBallotUpdateOne) ClearChoices() *AnonymousBallotUpdateOne {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// SetScores sets the "scores" field.
+func (_u *AnonymousBallotUpdateOne) SetScores(v map[int]int) *AnonymousBallotUpdateOne {
+	_u.mutation.SetScores(v)
+	return _u
+}
+
+// ClearScores clears the value of the "scores" field.
+func (_u *AnonymousBallotUpdateOne) ClearScores() *AnonymousBallotUpdateOne {
+	_u.mutation.ClearScores()
+	return _u
+}
+
+// SetReceiptHash sets the "receipt_hash" field.
+func (_u *AnonymousBallotUpdateOne) SetReceiptHash(v string) *AnonymousBallotUpdateOne {
+	_u.mutation.SetReceiptHash(v)
+	return _u
+}
+
+// SetNillableReceiptHash sets the "receipt_hash" field if the given value is not nil.
+func (_u *AnonymousBallotUpdateOne) SetNillableReceiptHash(v *string) *AnonymousBallotUpdateOne {
+	if v != nil {
+		_u.SetReceiptHash(*v)
+	}
+	return _u
+}
+
+// SetCastOn sets the "cast_on" field.
+func (_u *AnonymousBallotUpdateOne) SetCastOn(v time.Time) *AnonymousBallotUpdateOne {
+	_u.mutation.SetCastOn(v)
+	return _u
+}
+
+// SetNillableCastOn sets the "cast_on" field if the given value is not nil.
+func (_u *AnonymousBallotUpdateOne) SetNillableCastOn(v *time.Time) *AnonymousBallotUpdateOne {
+	if v != nil {
+		_u.SetCastOn(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *AnonymousBallotUpdateOne) SetPoll(v *Poll) *AnonymousBallotUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// Mutation returns the AnonymousBallotMutation object of the builder.
+func (_u *AnonymousBallotUpdateOne) Mutation() *AnonymousBallotMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *AnonymousBallotUpdateOne) ClearPoll() *AnonymousBallotUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// Where appends a list predicates to the AnonymousBallotUpdate builder.
+func (_u *AnonymousBallotUpdateOne) Where(ps ...predicate.AnonymousBallot) *AnonymousBallotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnonymousBallotUpdateOne) Select(field string, fields ...string) *AnonymousBallotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnonymousBallot entity.
+func (_u *AnonymousBallotUpdateOne) Save(ctx context.Context) (*AnonymousBallot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnonymousBallotUpdateOne) SaveX(ctx context.Context) *AnonymousBallot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnonymousBallotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnonymousBallotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnonymousBallotUpdateOne) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnonymousBallot.poll"`)
+	}
+	return nil
+}
+
+func (_u *AnonymousBallotUpdateOne) sqlSave(ctx context.Context) (_node *AnonymousBallot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(anonymousballot.Table, anonymousballot.Columns, sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnonymousBallot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, anonymousballot.FieldID)
+		for _, f := range fields {
+			if !anonymousballot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != anonymousballot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Choices(); ok {
+		_spec.SetField(anonymousballot.FieldChoices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, anonymousballot.FieldChoices, value)
+		})
+	}
+	if _u.mutation.ChoicesCleared() {
+		_spec.ClearField(anonymousballot.FieldChoices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scores(); ok {
+		_spec.SetField(anonymousballot.FieldScores, field.TypeJSON, value)
+	}
+	if _u.mutation.ScoresCleared() {
+		_spec.ClearField(anonymousballot.FieldScores, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReceiptHash(); ok {
+		_spec.SetField(anonymousballot.FieldReceiptHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CastOn(); ok {
+		_spec.SetField(anonymousballot.FieldCastOn, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   anonymousballot.PollTable,
+			Columns: []string{anonymousballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   anonymousballot.PollTable,
+			Columns: []string{anonymousballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AnonymousBallot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{anonymousballot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Ballot is the model entity for the Ballot schema.
+type Ballot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID int `json:"poll_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// GuestVoterID holds the value of the "guest_voter_id" field.
+	GuestVoterID int `json:"guest_voter_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BallotQuery when eager-loading is set.
+	Edges        BallotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BallotEdges holds the relations/edges for other nodes in the graph.
+type BallotEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// GuestVoter holds the value of the guest_voter edge.
+	GuestVoter *GuestVoter `json:"guest_voter,omitempty"`
+	// Entries holds the value of the entries edge.
+	Entries []*BallotEntry `json:"entries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BallotEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BallotEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GuestVoterOrErr returns the GuestVoter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BallotEdges) GuestVoterOrErr() (*GuestVoter, error) {
+	if e.GuestVoter != nil {
+		return e.GuestVoter, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: guestvoter.Label}
+	}
+	return nil, &NotLoadedError{edge: "guest_voter"}
+}
+
+// EntriesOrErr returns the Entries value or an error if the edge
+// was not loaded in eager-loading.
+func (e BallotEdges) EntriesOrErr() ([]*BallotEntry, error) {
+	if e.loadedTypes[3] {
+		return e.Entries, nil
+	}
+	return nil, &NotLoadedError{edge: "entries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Ballot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ballot.FieldID, ballot.FieldPollID, ballot.FieldUserID, ballot.FieldGuestVoterID:
+			values[i] = new(sql.NullInt64)
+		case ballot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Ballot fields.
+func (_m *Ballot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ballot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ballot.FieldPollID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value.Valid {
+				_m.PollID = int(value.Int64)
+			}
+		case ballot.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case ballot.FieldGuestVoterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_voter_id", values[i])
+			} else if value.Valid {
+				_m.GuestVoterID = int(value.Int64)
+			}
+		case ballot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Ballot.
+// This includes values selected through modifiers, order, etc.
+func (_m *Ballot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the Ballot entity.
+func (_m *Ballot) QueryPoll() *PollQuery {
+	return NewBallotClient(_m.config).QueryPoll(_m)
+}
+
+// QueryUser queries the "user" edge of the Ballot entity.
+func (_m *Ballot) QueryUser() *UserQuery {
+	return NewBallotClient(_m.config).QueryUser(_m)
+}
+
+// QueryGuestVoter queries the "guest_voter" edge of the Ballot entity.
+func (_m *Ballot) QueryGuestVoter() *GuestVoterQuery {
+	return NewBallotClient(_m.config).QueryGuestVoter(_m)
+}
+
+// QueryEntries queries the "entries" edge of the Ballot entity.
+func (_m *Ballot) QueryEntries() *BallotEntryQuery {
+	return NewBallotClient(_m.config).QueryEntries(_m)
+}
+
+// Update returns a builder for updating this Ballot.
+// Note that you need to call Ballot.Unwrap() before calling this method if this Ballot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Ballot) Update() *BallotUpdateOne {
+	return NewBallotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Ballot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Ballot) Unwrap() *Ballot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Ballot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Ballot) String() string {
+	var builder strings.Builder
+	builder.WriteString("Ballot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("guest_voter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GuestVoterID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Ballots is a parsable slice of Ballot.
+type Ballots []*Ballot
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ballot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ballot type in the database.
+	Label = "ballot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPollID holds the string denoting the poll_id field in the database.
+	FieldPollID = "poll_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldGuestVoterID holds the string denoting the guest_voter_id field in the database.
+	FieldGuestVoterID = "guest_voter_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePoll holds the string denoting the poll edge name in mutations.
+	EdgePoll = "poll"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeGuestVoter holds the string denoting the guest_voter edge name in mutations.
+	EdgeGuestVoter = "guest_voter"
+	// EdgeEntries holds the string denoting the entries edge name in mutations.
+	EdgeEntries = "entries"
+	// Table holds the table name of the ballot in the database.
+	Table = "ballots"
+	// PollTable is the table that holds the poll relation/edge.
+	PollTable = "ballots"
+	// PollInverseTable is the table name for the Poll entity.
+	// It exists in this package in order to avoid circular dependency with the "poll" package.
+	PollInverseTable = "polls"
+	// PollColumn is the table column denoting the poll relation/edge.
+	PollColumn = "poll_id"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "ballots"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// GuestVoterTable is the table that holds the guest_voter relation/edge.
+	GuestVoterTable = "ballots"
+	// GuestVoterInverseTable is the table name for the GuestVoter entity.
+	// It exists in this package in order to avoid circular dependency with the "guestvoter" package.
+	GuestVoterInverseTable = "guest_voters"
+	// GuestVoterColumn is the table column denoting the guest_voter relation/edge.
+	GuestVoterColumn = "guest_voter_id"
+	// EntriesTable is the table that holds the entries relation/edge.
+	EntriesTable = "ballot_entries"
+	// EntriesInverseTable is the table name for the BallotEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ballotentry" package.
+	EntriesInverseTable = "ballot_entries"
+	// EntriesColumn is the table column denoting the entries relation/edge.
+	EntriesColumn = "ballot_id"
+)
+
+// Columns holds all SQL columns for ballot fields.
+var Columns = []string{
+	FieldID,
+	FieldPollID,
+	FieldUserID,
+	FieldGuestVoterID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Ballot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPollID orders the results by the poll_id field.
+func ByPollID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByGuestVoterID orders the results by the guest_voter_id field.
+func ByGuestVoterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestVoterID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPollField orders the results by poll field.
+func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGuestVoterField orders the results by guest_voter field.
+func ByGuestVoterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGuestVoterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEntriesCount orders the results by entries count.
+func ByEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEntriesStep(), opts...)
+	}
+}
+
+// ByEntries orders the results by entries terms.
+func ByEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPollStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PollTable, PollColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
+func newGuestVoterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GuestVoterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, GuestVoterTable, GuestVoterColumn),
+	)
+}
+func newEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EntriesTable, EntriesColumn),
+	)
+}
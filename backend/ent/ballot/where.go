@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ballot
+
+import (
+	"pollapp/backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldLTE(FieldID, id))
+}
+
+// PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
+func PollID(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldPollID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldUserID, v))
+}
+
+// GuestVoterID applies equality check predicate on the "guest_voter_id" field. It's identical to GuestVoterIDEQ.
+func GuestVoterID(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldGuestVoterID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PollIDEQ applies the EQ predicate on the "poll_id" field.
+func PollIDEQ(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollIDNEQ applies the NEQ predicate on the "poll_id" field.
+func PollIDNEQ(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNEQ(FieldPollID, v))
+}
+
+// PollIDIn applies the In predicate on the "poll_id" field.
+func PollIDIn(vs ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldIn(FieldPollID, vs...))
+}
+
+// PollIDNotIn applies the NotIn predicate on the "poll_id" field.
+func PollIDNotIn(vs ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNotIn(FieldPollID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Ballot {
+	return predicate.Ballot(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Ballot {
+	return predicate.Ballot(sql.FieldNotNull(FieldUserID))
+}
+
+// GuestVoterIDEQ applies the EQ predicate on the "guest_voter_id" field.
+func GuestVoterIDEQ(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldGuestVoterID, v))
+}
+
+// GuestVoterIDNEQ applies the NEQ predicate on the "guest_voter_id" field.
+func GuestVoterIDNEQ(v int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNEQ(FieldGuestVoterID, v))
+}
+
+// GuestVoterIDIn applies the In predicate on the "guest_voter_id" field.
+func GuestVoterIDIn(vs ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldIn(FieldGuestVoterID, vs...))
+}
+
+// GuestVoterIDNotIn applies the NotIn predicate on the "guest_voter_id" field.
+func GuestVoterIDNotIn(vs ...int) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNotIn(FieldGuestVoterID, vs...))
+}
+
+// GuestVoterIDIsNil applies the IsNil predicate on the "guest_voter_id" field.
+func GuestVoterIDIsNil() predicate.Ballot {
+	return predicate.Ballot(sql.FieldIsNull(FieldGuestVoterID))
+}
+
+// GuestVoterIDNotNil applies the NotNil predicate on the "guest_voter_id" field.
+func GuestVoterIDNotNil() predicate.Ballot {
+	return predicate.Ballot(sql.FieldNotNull(FieldGuestVoterID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Ballot {
+	return predicate.Ballot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGuestVoter applies the HasEdge predicate on the "guest_voter" edge.
+func HasGuestVoter() predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, GuestVoterTable, GuestVoterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGuestVoterWith applies the HasEdge predicate on the "guest_voter" edge with a given conditions (other predicates).
+func HasGuestVoterWith(preds ...predicate.GuestVoter) predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := newGuestVoterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntries applies the HasEdge predicate on the "entries" edge.
+func HasEntries() predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EntriesTable, EntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntriesWith applies the HasEdge predicate on the "entries" edge with a given conditions (other predicates).
+func HasEntriesWith(preds ...predicate.BallotEntry) predicate.Ballot {
+	return predicate.Ballot(func(s *sql.Selector) {
+		step := newEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Ballot) predicate.Ballot {
+	return predicate.Ballot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Ballot) predicate.Ballot {
+	return predicate.Ballot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Ballot) predicate.Ballot {
+	return predicate.Ballot(sql.NotPredicates(p))
+}
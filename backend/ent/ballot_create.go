@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BallotCreate is the builder for creating a Ballot entity.
+type BallotCreate struct {
+	config
+	mutation *BallotMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *BallotCreate) SetPollID(v int) *BallotCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BallotCreate) SetUserID(v int) *BallotCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *BallotCreate) SetNillableUserID(v *int) *BallotCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_c *BallotCreate) SetGuestVoterID(v int) *BallotCreate {
+	_c.mutation.SetGuestVoterID(v)
+	return _c
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_c *BallotCreate) SetNillableGuestVoterID(v *int) *BallotCreate {
+	if v != nil {
+		_c.SetGuestVoterID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BallotCreate) SetCreatedAt(v time.Time) *BallotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BallotCreate) SetNillableCreatedAt(v *time.Time) *BallotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *BallotCreate) SetPoll(v *Poll) *BallotCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *BallotCreate) SetUser(v *User) *BallotCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_c *BallotCreate) SetGuestVoter(v *GuestVoter) *BallotCreate {
+	return _c.SetGuestVoterID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the BallotEntry entity by IDs.
+func (_c *BallotCreate) AddEntryIDs(ids ...int) *BallotCreate {
+	_c.mutation.AddEntryIDs(ids...)
+	return _c
+}
+
+// AddEntries adds the "entries" edges to the BallotEntry entity.
+func (_c *BallotCreate) AddEntries(v ...*BallotEntry) *BallotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEntryIDs(ids...)
+}
+
+// Mutation returns the BallotMutation object of the builder.
+func (_c *BallotCreate) Mutation() *BallotMutation {
+	return _c.mutation
+}
+
+// Save creates the Ballot in the database.
+func (_c *BallotCreate) Save(ctx context.Context) (*Ballot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BallotCreate) SaveX(ctx context.Context) *Ballot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BallotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BallotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BallotCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ballot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BallotCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "Ballot.poll_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Ballot.created_at"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "Ballot.poll"`)}
+	}
+	return nil
+}
+
+func (_c *BallotCreate) sqlSave(ctx context.Context) (*Ballot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BallotCreate) createSpec() (*Ballot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Ballot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ballot.Table, sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ballot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.PollTable,
+			Columns: []string{ballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.UserTable,
+			Columns: []string{ballot.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.GuestVoterTable,
+			Columns: []string{ballot.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuestVoterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ballot.EntriesTable,
+			Columns: []string{ballot.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BallotCreateBulk is the builder for creating many Ballot entities in bulk.
+type BallotCreateBulk struct {
+	config
+	err      error
+	builders []*BallotCreate
+}
+
+// Save creates the Ballot entities in the database.
+func (_c *BallotCreateBulk) Save(ctx context.Context) ([]*Ballot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Ballot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BallotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BallotCreateBulk) SaveX(ctx context.Context) []*Ballot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BallotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BallotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
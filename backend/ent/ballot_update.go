@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BallotUpdate is the builder for updating Ballot entities.
+type BallotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BallotMutation
+}
+
+// Where appends a list predicates to the BallotUpdate builder.
+func (_u *BallotUpdate) Where(ps ...predicate.Ballot) *BallotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *BallotUpdate) SetPollID(v int) *BallotUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *BallotUpdate) SetNillablePollID(v *int) *BallotUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BallotUpdate) SetUserID(v int) *BallotUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BallotUpdate) SetNillableUserID(v *int) *BallotUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BallotUpdate) ClearUserID() *BallotUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_u *BallotUpdate) SetGuestVoterID(v int) *BallotUpdate {
+	_u.mutation.SetGuestVoterID(v)
+	return _u
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_u *BallotUpdate) SetNillableGuestVoterID(v *int) *BallotUpdate {
+	if v != nil {
+		_u.SetGuestVoterID(*v)
+	}
+	return _u
+}
+
+// ClearGuestVoterID clears the value of the "guest_voter_id" field.
+func (_u *BallotUpdate) ClearGuestVoterID() *BallotUpdate {
+	_u.mutation.ClearGuestVoterID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BallotUpdate) SetCreatedAt(v time.Time) *BallotUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BallotUpdate) SetNillableCreatedAt(v *time.Time) *BallotUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *BallotUpdate) SetPoll(v *Poll) *BallotUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *BallotUpdate) SetUser(v *User) *BallotUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_u *BallotUpdate) SetGuestVoter(v *GuestVoter) *BallotUpdate {
+	return _u.SetGuestVoterID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the BallotEntry entity by IDs.
+func (_u *BallotUpdate) AddEntryIDs(ids ...int) *BallotUpdate {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the BallotEntry entity.
+func (_u *BallotUpdate) AddEntries(v ...*BallotEntry) *BallotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
+// Mutation returns the BallotMutation object of the builder.
+func (_u *BallotUpdate) Mutation() *BallotMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *BallotUpdate) ClearPoll() *BallotUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *BallotUpdate) ClearUser() *BallotUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearGuestVoter clears the "guest_voter" edge to the GuestVoter entity.
+func (_u *BallotUpdate) ClearGuestVoter() *BallotUpdate {
+	_u.mutation.ClearGuestVoter()
+	return _u
+}
+
+// ClearEntries clears all "entries" edges to the BallotEntry entity.
+func (_u *BallotUpdate) ClearEntries() *BallotUpdate {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to BallotEntry entities by IDs.
+func (_u *BallotUpdate) RemoveEntryIDs(ids ...int) *BallotUpdate {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to BallotEntry entities.
+func (_u *BallotUpdate) RemoveEntries(v ...*BallotEntry) *BallotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BallotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BallotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BallotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BallotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BallotUpdate) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Ballot.poll"`)
+	}
+	return nil
+}
+
+func (_u *BallotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ballot.Table, ballot.Columns, sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ballot.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.PollTable,
+			Columns: []string{ballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.PollTable,
+			Columns: []string{ballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.UserTable,
+			Columns: []string{ballot.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.UserTable,
+			Columns: []string{ballot.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GuestVoterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.GuestVoterTable,
+			Columns: []string{ballot.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.GuestVoterTable,
+			Columns: []string{ballot.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ballot.EntriesTable,
+			Columns: []string{ballot.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ballot.EntriesTable,
+			Columns: []string{ballot.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ballot.EntriesTable,
+			Columns: []string{ballot.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ballot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BallotUpdateOne is the builder for updating a single Ballot entity.
+type BallotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BallotMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *BallotUpdateOne) SetPollID(v int) *BallotUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *BallotUpdateOne) SetNillablePollID(v *int) *BallotUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BallotUpdateOne) SetUserID(v int) *BallotUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BallotUpdateOne) SetNillableUserID(v *int) *BallotUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BallotUpdateOne) ClearUserID() *BallotUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_u *BallotUpdateOne) SetGuestVoterID(v int) *BallotUpdateOne {
+	_u.mutation.SetGuestVoterID(v)
+	return _u
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_u *BallotUpdateOne) SetNillableGuestVoterID(v *int) *BallotUpdateOne {
+	if v != nil {
+		_u.SetGuestVoterID(*v)
+	}
+	return _u
+}
+
+// ClearGuestVoterID clears the value of the "guest_voter_id" field.
+func (_u *BallotUpdateOne) ClearGuestVoterID() *BallotUpdateOne {
+	_u.mutation.ClearGuestVoterID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BallotUpdateOne) SetCreatedAt(v time.Time) *BallotUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BallotUpdateOne) SetNillableCreatedAt(v *time.Time) *BallotUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *BallotUpdateOne) SetPoll(v *Poll) *BallotUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *BallotUpdateOne) SetUser(v *User) *BallotUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_u *BallotUpdateOne) SetGuestVoter(v *GuestVoter) *BallotUpdateOne {
+	return _u.SetGuestVoterID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the BallotEntry entity by IDs.
+func (_u *BallotUpdateOne) AddEntryIDs(ids ...int) *BallotUpdateOne {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the BallotEntry entity.
+func (_u *BallotUpdateOne) AddEntries(v ...*BallotEntry) *BallotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
+// Mutation returns the BallotMutation object of the builder.
+func (_u *BallotUpdateOne) Mutation() *BallotMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *BallotUpdateOne) ClearPoll() *BallotUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *BallotUpdateOne) ClearUser() *BallotUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearGuestVoter clears the "guest_voter" edge to the GuestVoter entity.
+func (_u *BallotUpdateOne) ClearGuestVoter() *BallotUpdateOne {
+	_u.mutation.ClearGuestVoter()
+	return _u
+}
+
+// ClearEntries clears all "entries" edges to the BallotEntry entity.
+func (_u *BallotUpdateOne) ClearEntries() *BallotUpdateOne {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to BallotEntry entities by IDs.
+func (_u *BallotUpdateOne) RemoveEntryIDs(ids ...int) *BallotUpdateOne {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to BallotEntry entities.
+func (_u *BallotUpdateOne) RemoveEntries(v ...*BallotEntry) *BallotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
+// Where appends a list predicates to the BallotUpdate builder.
+func (_u *BallotUpdateOne) Where(ps ...predicate.Ballot) *BallotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BallotUpdateOne) Select(field string, fields ...string) *BallotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Ballot entity.
+func (_u *BallotUpdateOne) Save(ctx context.Context) (*Ballot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BallotUpdateOne) SaveX(ctx context.Context) *Ballot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BallotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BallotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BallotUpdateOne) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Ballot.poll"`)
+	}
+	return nil
+}
+
+func (_u *BallotUpdateOne) sqlSave(ctx context.Context) (_node *Ballot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ballot.Table, ballot.Columns, sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Ballot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ballot.FieldID)
+		for _, f := range fields {
+			if !ballot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ballot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ballot.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.PollTable,
+			Columns: []string{ballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.PollTable,
+			Columns: []string{ballot.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.UserTable,
+			Columns: []string{ballot.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.UserTable,
+			Columns: []string{ballot.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GuestVoterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.GuestVoterTable,
+			Columns: []string{ballot.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballot.GuestVoterTable,
+			Columns: []string{ballot.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ballot.EntriesTable,
+			Columns: []string{ballot.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ballot.EntriesTable,
+			Columns: []string{ballot.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ballot.EntriesTable,
+			Columns: []string{ballot.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Ballot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ballot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/polloption"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BallotEntry is the model entity for the BallotEntry schema.
+type BallotEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BallotID holds the value of the "ballot_id" field.
+	BallotID int `json:"ballot_id,omitempty"`
+	// PollOptionID holds the value of the "poll_option_id" field.
+	PollOptionID int `json:"poll_option_id,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank int `json:"rank,omitempty"`
+	// Score holds the value of the "score" field.
+	Score int `json:"score,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BallotEntryQuery when eager-loading is set.
+	Edges        BallotEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BallotEntryEdges holds the relations/edges for other nodes in the graph.
+type BallotEntryEdges struct {
+	// Ballot holds the value of the ballot edge.
+	Ballot *Ballot `json:"ballot,omitempty"`
+	// PollOption holds the value of the poll_option edge.
+	PollOption *PollOption `json:"poll_option,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BallotOrErr returns the Ballot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BallotEntryEdges) BallotOrErr() (*Ballot, error) {
+	if e.Ballot != nil {
+		return e.Ballot, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ballot.Label}
+	}
+	return nil, &NotLoadedError{edge: "ballot"}
+}
+
+// PollOptionOrErr returns the PollOption value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BallotEntryEdges) PollOptionOrErr() (*PollOption, error) {
+	if e.PollOption != nil {
+		return e.PollOption, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: polloption.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll_option"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BallotEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ballotentry.FieldID, ballotentry.FieldBallotID, ballotentry.FieldPollOptionID, ballotentry.FieldRank, ballotentry.FieldScore:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BallotEntry fields.
+func (_m *BallotEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ballotentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ballotentry.FieldBallotID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ballot_id", values[i])
+			} else if value.Valid {
+				_m.BallotID = int(value.Int64)
+			}
+		case ballotentry.FieldPollOptionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_option_id", values[i])
+			} else if value.Valid {
+				_m.PollOptionID = int(value.Int64)
+			}
+		case ballotentry.FieldRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				_m.Rank = int(value.Int64)
+			}
+		case ballotentry.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BallotEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *BallotEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBallot queries the "ballot" edge of the BallotEntry entity.
+func (_m *BallotEntry) QueryBallot() *BallotQuery {
+	return NewBallotEntryClient(_m.config).QueryBallot(_m)
+}
+
+// QueryPollOption queries the "poll_option" edge of the BallotEntry entity.
+func (_m *BallotEntry) QueryPollOption() *PollOptionQuery {
+	return NewBallotEntryClient(_m.config).QueryPollOption(_m)
+}
+
+// Update returns a builder for updating this BallotEntry.
+// Note that you need to call BallotEntry.Unwrap() before calling this method if this BallotEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BallotEntry) Update() *BallotEntryUpdateOne {
+	return NewBallotEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BallotEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BallotEntry) Unwrap() *BallotEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BallotEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BallotEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("BallotEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ballot_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BallotID))
+	builder.WriteString(", ")
+	builder.WriteString("poll_option_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollOptionID))
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rank))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BallotEntries is a parsable slice of BallotEntry.
+type BallotEntries []*BallotEntry
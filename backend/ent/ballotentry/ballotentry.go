@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ballotentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ballotentry type in the database.
+	Label = "ballot_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBallotID holds the string denoting the ballot_id field in the database.
+	FieldBallotID = "ballot_id"
+	// FieldPollOptionID holds the string denoting the poll_option_id field in the database.
+	FieldPollOptionID = "poll_option_id"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// EdgeBallot holds the string denoting the ballot edge name in mutations.
+	EdgeBallot = "ballot"
+	// EdgePollOption holds the string denoting the poll_option edge name in mutations.
+	EdgePollOption = "poll_option"
+	// Table holds the table name of the ballotentry in the database.
+	Table = "ballot_entries"
+	// BallotTable is the table that holds the ballot relation/edge.
+	BallotTable = "ballot_entries"
+	// BallotInverseTable is the table name for the Ballot entity.
+	// It exists in this package in order to avoid circular dependency with the "ballot" package.
+	BallotInverseTable = "ballots"
+	// BallotColumn is the table column denoting the ballot relation/edge.
+	BallotColumn = "ballot_id"
+	// PollOptionTable is the table that holds the poll_option relation/edge.
+	PollOptionTable = "ballot_entries"
+	// PollOptionInverseTable is the table name for the PollOption entity.
+	// It exists in this package in order to avoid circular dependency with the "polloption" package.
+	PollOptionInverseTable = "poll_options"
+	// PollOptionColumn is the table column denoting the poll_option relation/edge.
+	PollOptionColumn = "poll_option_id"
+)
+
+// Columns holds all SQL columns for ballotentry fields.
+var Columns = []string{
+	FieldID,
+	FieldBallotID,
+	FieldPollOptionID,
+	FieldRank,
+	FieldScore,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the BallotEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBallotID orders the results by the ballot_id field.
+func ByBallotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBallotID, opts...).ToFunc()
+}
+
+// ByPollOptionID orders the results by the poll_option_id field.
+func ByPollOptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollOptionID, opts...).ToFunc()
+}
+
+// ByRank orders the results by the rank field.
+func ByRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRank, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByBallotField orders the results by ballot field.
+func ByBallotField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBallotStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPollOptionField orders the results by poll_option field.
+func ByPollOptionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollOptionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBallotStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BallotInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BallotTable, BallotColumn),
+	)
+}
+func newPollOptionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollOptionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PollOptionTable, PollOptionColumn),
+	)
+}
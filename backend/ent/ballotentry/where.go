@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ballotentry
+
+import (
+	"pollapp/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldLTE(FieldID, id))
+}
+
+// BallotID applies equality check predicate on the "ballot_id" field. It's identical to BallotIDEQ.
+func BallotID(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldBallotID, v))
+}
+
+// PollOptionID applies equality check predicate on the "poll_option_id" field. It's identical to PollOptionIDEQ.
+func PollOptionID(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldPollOptionID, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldRank, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldScore, v))
+}
+
+// BallotIDEQ applies the EQ predicate on the "ballot_id" field.
+func BallotIDEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldBallotID, v))
+}
+
+// BallotIDNEQ applies the NEQ predicate on the "ballot_id" field.
+func BallotIDNEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNEQ(FieldBallotID, v))
+}
+
+// BallotIDIn applies the In predicate on the "ballot_id" field.
+func BallotIDIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldIn(FieldBallotID, vs...))
+}
+
+// BallotIDNotIn applies the NotIn predicate on the "ballot_id" field.
+func BallotIDNotIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNotIn(FieldBallotID, vs...))
+}
+
+// PollOptionIDEQ applies the EQ predicate on the "poll_option_id" field.
+func PollOptionIDEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldPollOptionID, v))
+}
+
+// PollOptionIDNEQ applies the NEQ predicate on the "poll_option_id" field.
+func PollOptionIDNEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNEQ(FieldPollOptionID, v))
+}
+
+// PollOptionIDIn applies the In predicate on the "poll_option_id" field.
+func PollOptionIDIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldIn(FieldPollOptionID, vs...))
+}
+
+// PollOptionIDNotIn applies the NotIn predicate on the "poll_option_id" field.
+func PollOptionIDNotIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNotIn(FieldPollOptionID, vs...))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldLTE(FieldRank, v))
+}
+
+// RankIsNil applies the IsNil predicate on the "rank" field.
+func RankIsNil() predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldIsNull(FieldRank))
+}
+
+// RankNotNil applies the NotNil predicate on the "rank" field.
+func RankNotNil() predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNotNull(FieldRank))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.BallotEntry {
+	return predicate.BallotEntry(sql.FieldNotNull(FieldScore))
+}
+
+// HasBallot applies the HasEdge predicate on the "ballot" edge.
+func HasBallot() predicate.BallotEntry {
+	return predicate.BallotEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BallotTable, BallotColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBallotWith applies the HasEdge predicate on the "ballot" edge with a given conditions (other predicates).
+func HasBallotWith(preds ...predicate.Ballot) predicate.BallotEntry {
+	return predicate.BallotEntry(func(s *sql.Selector) {
+		step := newBallotStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPollOption applies the HasEdge predicate on the "poll_option" edge.
+func HasPollOption() predicate.BallotEntry {
+	return predicate.BallotEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PollOptionTable, PollOptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollOptionWith applies the HasEdge predicate on the "poll_option" edge with a given conditions (other predicates).
+func HasPollOptionWith(preds ...predicate.PollOption) predicate.BallotEntry {
+	return predicate.BallotEntry(func(s *sql.Selector) {
+		step := newPollOptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BallotEntry) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BallotEntry) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BallotEntry) predicate.BallotEntry {
+	return predicate.BallotEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/polloption"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BallotEntryCreate is the builder for creating a BallotEntry entity.
+type BallotEntryCreate struct {
+	config
+	mutation *BallotEntryMutation
+	hooks    []Hook
+}
+
+// SetBallotID sets the "ballot_id" field.
+func (_c *BallotEntryCreate) SetBallotID(v int) *BallotEntryCreate {
+	_c.mutation.SetBallotID(v)
+	return _c
+}
+
+// SetPollOptionID sets the "poll_option_id" field.
+func (_c *BallotEntryCreate) SetPollOptionID(v int) *BallotEntryCreate {
+	_c.mutation.SetPollOptionID(v)
+	return _c
+}
+
+// SetRank sets the "rank" field.
+func (_c *BallotEntryCreate) SetRank(v int) *BallotEntryCreate {
+	_c.mutation.SetRank(v)
+	return _c
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_c *BallotEntryCreate) SetNillableRank(v *int) *BallotEntryCreate {
+	if v != nil {
+		_c.SetRank(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *BallotEntryCreate) SetScore(v int) *BallotEntryCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *BallotEntryCreate) SetNillableScore(v *int) *BallotEntryCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetBallot sets the "ballot" edge to the Ballot entity.
+func (_c *BallotEntryCreate) SetBallot(v *Ballot) *BallotEntryCreate {
+	return _c.SetBallotID(v.ID)
+}
+
+// SetPollOption sets the "poll_option" edge to the PollOption entity.
+func (_c *BallotEntryCreate) SetPollOption(v *PollOption) *BallotEntryCreate {
+	return _c.SetPollOptionID(v.ID)
+}
+
+// Mutation returns the BallotEntryMutation object of the builder.
+func (_c *BallotEntryCreate) Mutation() *BallotEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the BallotEntry in the database.
+func (_c *BallotEntryCreate) Save(ctx context.Context) (*BallotEntry, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BallotEntryCreate) SaveX(ctx context.Context) *BallotEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BallotEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BallotEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BallotEntryCreate) check() error {
+	if _, ok := _c.mutation.BallotID(); !ok {
+		return &ValidationError{Name: "ballot_id", err: errors.New(`ent: missing required field "BallotEntry.ballot_id"`)}
+	}
+	if _, ok := _c.mutation.PollOptionID(); !ok {
+		return &ValidationError{Name: "poll_option_id", err: errors.New(`ent: missing required field "BallotEntry.poll_option_id"`)}
+	}
+	if len(_c.mutation.BallotIDs()) == 0 {
+		return &ValidationError{Name: "ballot", err: errors.New(`ent: missing required edge "BallotEntry.ballot"`)}
+	}
+	if len(_c.mutation.PollOptionIDs()) == 0 {
+		return &ValidationError{Name: "poll_option", err: errors.New(`ent: missing required edge "BallotEntry.poll_option"`)}
+	}
+	return nil
+}
+
+func (_c *BallotEntryCreate) sqlSave(ctx context.Context) (*BallotEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BallotEntryCreate) createSpec() (*BallotEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BallotEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ballotentry.Table, sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Rank(); ok {
+		_spec.SetField(ballotentry.FieldRank, field.TypeInt, value)
+		_node.Rank = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(ballotentry.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if nodes := _c.mutation.BallotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.BallotTable,
+			Columns: []string{ballotentry.BallotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BallotID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PollOptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.PollOptionTable,
+			Columns: []string{ballotentry.PollOptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollOptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BallotEntryCreateBulk is the builder for creating many BallotEntry entities in bulk.
+type BallotEntryCreateBulk struct {
+	config
+	err      error
+	builders []*BallotEntryCreate
+}
+
+// Save creates the BallotEntry entities in the database.
+func (_c *BallotEntryCreateBulk) Save(ctx context.Context) ([]*BallotEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BallotEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BallotEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BallotEntryCreateBulk) SaveX(ctx context.Context) []*BallotEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BallotEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BallotEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
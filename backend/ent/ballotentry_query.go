@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BallotEntryQuery is the builder for querying BallotEntry entities.
+type BallotEntryQuery struct {
+	config
+	ctx            *QueryContext
+	order          []ballotentry.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.BallotEntry
+	withBallot     *BallotQuery
+	withPollOption *PollOptionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BallotEntryQuery builder.
+func (_q *BallotEntryQuery) Where(ps ...predicate.BallotEntry) *BallotEntryQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BallotEntryQuery) Limit(limit int) *BallotEntryQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BallotEntryQuery) Offset(offset int) *BallotEntryQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BallotEntryQuery) Unique(unique bool) *BallotEntryQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BallotEntryQuery) Order(o ...ballotentry.OrderOption) *BallotEntryQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryBallot chains the current query on the "ballot" edge.
+func (_q *BallotEntryQuery) QueryBallot() *BallotQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ballotentry.Table, ballotentry.FieldID, selector),
+			sqlgraph.To(ballot.Table, ballot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ballotentry.BallotTable, ballotentry.BallotColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPollOption chains the current query on the "poll_option" edge.
+func (_q *BallotEntryQuery) QueryPollOption() *PollOptionQuery {
+	query := (&PollOptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ballotentry.Table, ballotentry.FieldID, selector),
+			sqlgraph.To(polloption.Table, polloption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ballotentry.PollOptionTable, ballotentry.PollOptionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BallotEntry entity from the query.
+// Returns a *NotFoundError when no BallotEntry was found.
+func (_q *BallotEntryQuery) First(ctx context.Context) (*BallotEntry, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ballotentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BallotEntryQuery) FirstX(ctx context.Context) *BallotEntry {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BallotEntry ID from the query.
+// Returns a *NotFoundError when no BallotEntry ID was found.
+func (_q *BallotEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ballotentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BallotEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BallotEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BallotEntry entity is found.
+// Returns a *NotFoundError when no BallotEntry entities are found.
+func (_q *BallotEntryQuery) Only(ctx context.Context) (*BallotEntry, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ballotentry.Label}
+	default:
+		return nil, &NotSingularError{ballotentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BallotEntryQuery) OnlyX(ctx context.Context) *BallotEntry {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BallotEntry ID in the query.
+// Returns a *NotSingularError when more than one BallotEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BallotEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ballotentry.Label}
+	default:
+		err = &NotSingularError{ballotentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BallotEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BallotEntries.
+func (_q *BallotEntryQuery) All(ctx context.Context) ([]*BallotEntry, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BallotEntry, *BallotEntryQuery]()
+	return withInterceptors[[]*BallotEntry](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BallotEntryQuery) AllX(ctx context.Context) []*BallotEntry {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BallotEntry IDs.
+func (_q *BallotEntryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(ballotentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BallotEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BallotEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BallotEntryQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BallotEntryQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BallotEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BallotEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BallotEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BallotEntryQuery) Clone() *BallotEntryQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BallotEntryQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]ballotentry.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.BallotEntry{}, _q.predicates...),
+		withBallot:     _q.withBallot.Clone(),
+		withPollOption: _q.withPollOption.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithBallot tells the query-builder to eager-load the nodes that are connected to
+// the "ballot" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BallotEntryQuery) WithBallot(opts ...func(*BallotQuery)) *BallotEntryQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBallot = query
+	return _q
+}
+
+// WithPollOption tells the query-builder to eager-load the nodes that are connected to
+// the "poll_option" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BallotEntryQuery) WithPollOption(opts ...func(*PollOptionQuery)) *BallotEntryQuery {
+	query := (&PollOptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPollOption = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BallotID int `json:"ballot_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BallotEntry.Query().
+//		GroupBy(ballotentry.FieldBallotID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *BallotEntryQuery) GroupBy(field string, fields ...string) *BallotEntryGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BallotEntryGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = ballotentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BallotID int `json:"ballot_id,omitempty"`
+//	}
+//
+//	client.BallotEntry.Query().
+//		Select(ballotentry.FieldBallotID).
+//		Scan(ctx, &v)
+func (_q *BallotEntryQuery) Select(fields ...string) *BallotEntrySelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BallotEntrySelect{BallotEntryQuery: _q}
+	sbuild.label = ballotentry.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BallotEntrySelect configured with the given aggregations.
+func (_q *BallotEntryQuery) Aggregate(fns ...AggregateFunc) *BallotEntrySelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BallotEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !ballotentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BallotEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BallotEntry, error) {
+	var (
+		nodes       = []*BallotEntry{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withBallot != nil,
+			_q.withPollOption != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BallotEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BallotEntry{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withBallot; query != nil {
+		if err := _q.loadBallot(ctx, query, nodes, nil,
+			func(n *BallotEntry, e *Ballot) { n.Edges.Ballot = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPollOption; query != nil {
+		if err := _q.loadPollOption(ctx, query, nodes, nil,
+			func(n *BallotEntry, e *PollOption) { n.Edges.PollOption = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *BallotEntryQuery) loadBallot(ctx context.Context, query *BallotQuery, nodes []*BallotEntry, init func(*BallotEntry), assign func(*BallotEntry, *Ballot)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BallotEntry)
+	for i := range nodes {
+		fk := nodes[i].BallotID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ballot.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "ballot_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BallotEntryQuery) loadPollOption(ctx context.Context, query *PollOptionQuery, nodes []*BallotEntry, init func(*BallotEntry), assign func(*BallotEntry, *PollOption)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BallotEntry)
+	for i := range nodes {
+		fk := nodes[i].PollOptionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(polloption.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "poll_option_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *BallotEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BallotEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ballotentry.Table, ballotentry.Columns, sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ballotentry.FieldID)
+		for i := range fields {
+			if fields[i] != ballotentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withBallot != nil {
+			_spec.Node.AddColumnOnce(ballotentry.FieldBallotID)
+		}
+		if _q.withPollOption != nil {
+			_spec.Node.AddColumnOnce(ballotentry.FieldPollOptionID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BallotEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(ballotentry.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = ballotentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BallotEntryGroupBy is the group-by builder for BallotEntry entities.
+type BallotEntryGroupBy struct {
+	selector
+	build *BallotEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BallotEntryGroupBy) Aggregate(fns ...AggregateFunc) *BallotEntryGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BallotEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BallotEntryQuery, *BallotEntryGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BallotEntryGroupBy) sqlScan(ctx context.Context, root *BallotEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BallotEntrySelect is the builder for selecting fields of BallotEntry entities.
+type BallotEntrySelect struct {
+	*BallotEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BallotEntrySelect) Aggregate(fns ...AggregateFunc) *BallotEntrySelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BallotEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BallotEntryQuery, *BallotEntrySelect](ctx, _s.BallotEntryQuery, _s, _s.inters, v)
+}
+
+func (_s *BallotEntrySelect) sqlScan(ctx context.Context, root *BallotEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BallotEntryUpdate is the builder for updating BallotEntry entities.
+type BallotEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BallotEntryMutation
+}
+
+// Where appends a list predicates to the BallotEntryUpdate builder.
+func (_u *BallotEntryUpdate) Where(ps ...predicate.BallotEntry) *BallotEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBallotID sets the "ballot_id" field.
+func (_u *BallotEntryUpdate) SetBallotID(v int) *BallotEntryUpdate {
+	_u.mutation.SetBallotID(v)
+	return _u
+}
+
+// SetNillableBallotID sets the "ballot_id" field if the given value is not nil.
+func (_u *BallotEntryUpdate) SetNillableBallotID(v *int) *BallotEntryUpdate {
+	if v != nil {
+		_u.SetBallotID(*v)
+	}
+	return _u
+}
+
+// SetPollOptionID sets the "poll_option_id" field.
+func (_u *BallotEntryUpdate) SetPollOptionID(v int) *BallotEntryUpdate {
+	_u.mutation.SetPollOptionID(v)
+	return _u
+}
+
+// SetNillablePollOptionID sets the "poll_option_id" field if the given value is not nil.
+func (_u *BallotEntryUpdate) SetNillablePollOptionID(v *int) *BallotEntryUpdate {
+	if v != nil {
+		_u.SetPollOptionID(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *BallotEntryUpdate) SetRank(v int) *BallotEntryUpdate {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *BallotEntryUpdate) SetNillableRank(v *int) *BallotEntryUpdate {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *BallotEntryUpdate) AddRank(v int) *BallotEntryUpdate {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// ClearRank clears the value of the "rank" field.
+func (_u *BallotEntryUpdate) ClearRank() *BallotEntryUpdate {
+	_u.mutation.ClearRank()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *BallotEntryUpdate) SetScore(v int) *BallotEntryUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *BallotEntryUpdate) SetNillableScore(v *int) *BallotEntryUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *BallotEntryUpdate) AddScore(v int) *BallotEntryUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// ClearScore clears the value of the "score" field.
+func (_u *BallotEntryUpdate) ClearScore() *BallotEntryUpdate {
+	_u.mutation.ClearScore()
+	return _u
+}
+
+// SetBallot sets the "ballot" edge to the Ballot entity.
+func (_u *BallotEntryUpdate) SetBallot(v *Ballot) *BallotEntryUpdate {
+	return _u.SetBallotID(v.ID)
+}
+
+// SetPollOption sets the "poll_option" edge to the PollOption entity.
+func (_u *BallotEntryUpdate) SetPollOption(v *PollOption) *BallotEntryUpdate {
+	return _u.SetPollOptionID(v.ID)
+}
+
+// Mutation returns the BallotEntryMutation object of the builder.
+func (_u *BallotEntryUpdate) Mutation() *BallotEntryMutation {
+	return _u.mutation
+}
+
+// ClearBallot clears the "ballot" edge to the Ballot entity.
+func (_u *BallotEntryUpdate) ClearBallot() *BallotEntryUpdate {
+	_u.mutation.ClearBallot()
+	return _u
+}
+
+// ClearPollOption clears the "poll_option" edge to the PollOption entity.
+func (_u *BallotEntryUpdate) ClearPollOption() *BallotEntryUpdate {
+	_u.mutation.ClearPollOption()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BallotEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BallotEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BallotEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BallotEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BallotEntryUpdate) check() error {
+	if _u.mutation.BallotCleared() && len(_u.mutation.BallotIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BallotEntry.ballot"`)
+	}
+	if _u.mutation.PollOptionCleared() && len(_u.mutation.PollOptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BallotEntry.poll_option"`)
+	}
+	return nil
+}
+
+func (_u *BallotEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ballotentry.Table, ballotentry.Columns, sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(ballotentry.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(ballotentry.FieldRank, field.TypeInt, value)
+	}
+	if _u.mutation.RankCleared() {
+		_spec.ClearField(ballotentry.FieldRank, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(ballotentry.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(ballotentry.FieldScore, field.TypeInt, value)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(ballotentry.FieldScore, field.TypeInt)
+	}
+	if _u.mutation.BallotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.BallotTable,
+			Columns: []string{ballotentry.BallotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.BallotTable,
+			Columns: []string{ballotentry.BallotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollOptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.PollOptionTable,
+			Columns: []string{ballotentry.PollOptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollOptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.PollOptionTable,
+			Columns: []string{ballotentry.PollOptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ballotentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BallotEntryUpdateOne is the builder for updating a single BallotEntry entity.
+type BallotEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BallotEntryMutation
+}
+
+// SetBallotID sets the "ballot_id" field.
+func (_u *BallotEntryUpdateOne) SetBallotID(v int) *BallotEntryUpdateOne {
+	_u.mutation.SetBallotID(v)
+	return _u
+}
+
+// SetNillableBallotID sets the "ballot_id" field if the given value is not nil.
+func (_u *BallotEntryUpdateOne) SetNillableBallotID(v *int) *BallotEntryUpdateOne {
+	if v != nil {
+		_u.SetBallotID(*v)
+	}
+	return _u
+}
+
+// SetPollOptionID sets the "poll_option_id" field.
+func (_u *BallotEntryUpdateOne) SetPollOptionID(v int) *BallotEntryUpdateOne {
+	_u.mutation.SetPollOptionID(v)
+	return _u
+}
+
+// SetNillablePollOptionID sets the "poll_option_id" field if the given value is not nil.
+func (_u *BallotEntryUpdateOne) SetNillablePollOptionID(v *int) *BallotEntryUpdateOne {
+	if v != nil {
+		_u.SetPollOptionID(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *BallotEntryUpdateOne) SetRank(v int) *BallotEntryUpdateOne {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *BallotEntryUpdateOne) SetNillableRank(v *int) *BallotEntryUpdateOne {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *BallotEntryUpdateOne) AddRank(v int) *BallotEntryUpdateOne {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// ClearRank clears the value of the "rank" field.
+func (_u *BallotEntryUpdateOne) ClearRank() *BallotEntryUpdateOne {
+	_u.mutation.ClearRank()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *BallotEntryUpdateOne) SetScore(v int) *BallotEntryUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *BallotEntryUpdateOne) SetNillableScore(v *int) *BallotEntryUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *BallotEntryUpdateOne) AddScore(v int) *BallotEntryUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// ClearScore clears the value of the "score" field.
+func (_u *BallotEntryUpdateOne) ClearScore() *BallotEntryUpdateOne {
+	_u.mutation.ClearScore()
+	return _u
+}
+
+// SetBallot sets the "ballot" edge to the Ballot entity.
+func (_u *BallotEntryUpdateOne) SetBallot(v *Ballot) *BallotEntryUpdateOne {
+	return _u.SetBallotID(v.ID)
+}
+
+// SetPollOption sets the "poll_option" edge to the PollOption entity.
+func (_u *BallotEntryUpdateOne) SetPollOption(v *PollOption) *BallotEntryUpdateOne {
+	return _u.SetPollOptionID(v.ID)
+}
+
+// Mutation returns the BallotEntryMutation object of the builder.
+func (_u *BallotEntryUpdateOne) Mutation() *BallotEntryMutation {
+	return _u.mutation
+}
+
+// ClearBallot clears the "ballot" edge to the Ballot entity.
+func (_u *BallotEntryUpdateOne) ClearBallot() *BallotEntryUpdateOne {
+	_u.mutation.ClearBallot()
+	return _u
+}
+
+// ClearPollOption clears the "poll_option" edge to the PollOption entity.
+func (_u *BallotEntryUpdateOne) ClearPollOption() *BallotEntryUpdateOne {
+	_u.mutation.ClearPollOption()
+	return _u
+}
+
+// Where appends a list predicates to the BallotEntryUpdate builder.
+func (_u *BallotEntryUpdateOne) Where(ps ...predicate.BallotEntry) *BallotEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BallotEntryUpdateOne) Select(field string, fields ...string) *BallotEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BallotEntry entity.
+func (_u *BallotEntryUpdateOne) Save(ctx context.Context) (*BallotEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BallotEntryUpdateOne) SaveX(ctx context.Context) *BallotEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BallotEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BallotEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BallotEntryUpdateOne) check() error {
+	if _u.mutation.BallotCleared() && len(_u.mutation.BallotIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BallotEntry.ballot"`)
+	}
+	if _u.mutation.PollOptionCleared() && len(_u.mutation.PollOptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BallotEntry.poll_option"`)
+	}
+	return nil
+}
+
+func (_u *BallotEntryUpdateOne) sqlSave(ctx context.Context) (_node *BallotEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ballotentry.Table, ballotentry.Columns, sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BallotEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ballotentry.FieldID)
+		for _, f := range fields {
+			if !ballotentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ballotentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(ballotentry.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(ballotentry.FieldRank, field.TypeInt, value)
+	}
+	if _u.mutation.RankCleared() {
+		_spec.ClearField(ballotentry.FieldRank, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(ballotentry.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(ballotentry.FieldScore, field.TypeInt, value)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(ballotentry.FieldScore, field.TypeInt)
+	}
+	if _u.mutation.BallotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.BallotTable,
+			Columns: []string{ballotentry.BallotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.BallotTable,
+			Columns: []string{ballotentry.BallotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollOptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.PollOptionTable,
+			Columns: []string{ballotentry.PollOptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollOptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotentry.PollOptionTable,
+			Columns: []string{ballotentry.PollOptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BallotEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ballotentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
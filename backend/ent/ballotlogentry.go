@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/poll"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BallotLogEntry is the model entity for the BallotLogEntry schema.
+type BallotLogEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID int `json:"poll_id,omitempty"`
+	// Sequence holds the value of the "sequence" field.
+	Sequence int `json:"sequence,omitempty"`
+	// BallotHash holds the value of the "ballot_hash" field.
+	BallotHash string `json:"ballot_hash,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BallotLogEntryQuery when eager-loading is set.
+	Edges        BallotLogEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BallotLogEntryEdges holds the relations/edges for other nodes in the graph.
+type BallotLogEntryEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BallotLogEntryEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BallotLogEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ballotlogentry.FieldID, ballotlogentry.FieldPollID, ballotlogentry.FieldSequence:
+			values[i] = new(sql.NullInt64)
+		case ballotlogentry.FieldBallotHash, ballotlogentry.FieldHash:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BallotLogEntry fields.
+func (_m *BallotLogEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ballotlogentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ballotlogentry.FieldPollID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value.Valid {
+				_m.PollID = int(value.Int64)
+			}
+		case ballotlogentry.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				_m.Sequence = int(value.Int64)
+			}
+		case ballotlogentry.FieldBallotHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ballot_hash", values[i])
+			} else if value.Valid {
+				_m.BallotHash = value.String
+			}
+		case ballotlogentry.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BallotLogEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *BallotLogEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the BallotLogEntry entity.
+func (_m *BallotLogEntry) QueryPoll() *PollQuery {
+	return NewBallotLogEntryClient(_m.config).QueryPoll(_m)
+}
+
+// Update returns a builder for updating this BallotLogEntry.
+// Note that you need to call BallotLogEntry.Unwrap() before calling this method if this BallotLogEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BallotLogEntry) Update() *BallotLogEntryUpdateOne {
+	return NewBallotLogEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BallotLogEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BallotLogEntry) Unwrap() *BallotLogEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BallotLogEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BallotLogEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("BallotLogEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("ballot_hash=")
+	builder.WriteString(_m.BallotHash)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BallotLogEntries is a parsable slice of BallotLogEntry.
+type BallotLogEntries []*BallotLogEntry
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ballotlogentry
+
+import (
+	"pollapp/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLTE(FieldID, id))
+}
+
+// PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
+func PollID(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldPollID, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldSequence, v))
+}
+
+// BallotHash applies equality check predicate on the "ballot_hash" field. It's identical to BallotHashEQ.
+func BallotHash(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldBallotHash, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldHash, v))
+}
+
+// PollIDEQ applies the EQ predicate on the "poll_id" field.
+func PollIDEQ(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollIDNEQ applies the NEQ predicate on the "poll_id" field.
+func PollIDNEQ(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNEQ(FieldPollID, v))
+}
+
+// PollIDIn applies the In predicate on the "poll_id" field.
+func PollIDIn(vs ...int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldIn(FieldPollID, vs...))
+}
+
+// PollIDNotIn applies the NotIn predicate on the "poll_id" field.
+func PollIDNotIn(vs ...int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNotIn(FieldPollID, vs...))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLTE(FieldSequence, v))
+}
+
+// BallotHashEQ applies the EQ predicate on the "ballot_hash" field.
+func BallotHashEQ(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldBallotHash, v))
+}
+
+// BallotHashNEQ applies the NEQ predicate on the "ballot_hash" field.
+func BallotHashNEQ(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNEQ(FieldBallotHash, v))
+}
+
+// BallotHashIn applies the In predicate on the "ballot_hash" field.
+func BallotHashIn(vs ...string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldIn(FieldBallotHash, vs...))
+}
+
+// BallotHashNotIn applies the NotIn predicate on the "ballot_hash" field.
+func BallotHashNotIn(vs ...string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNotIn(FieldBallotHash, vs...))
+}
+
+// BallotHashGT applies the GT predicate on the "ballot_hash" field.
+func BallotHashGT(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGT(FieldBallotHash, v))
+}
+
+// BallotHashGTE applies the GTE predicate on the "ballot_hash" field.
+func BallotHashGTE(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGTE(FieldBallotHash, v))
+}
+
+// BallotHashLT applies the LT predicate on the "ballot_hash" field.
+func BallotHashLT(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLT(FieldBallotHash, v))
+}
+
+// BallotHashLTE applies the LTE predicate on the "ballot_hash" field.
+func BallotHashLTE(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLTE(FieldBallotHash, v))
+}
+
+// BallotHashContains applies the Contains predicate on the "ballot_hash" field.
+func BallotHashContains(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldContains(FieldBallotHash, v))
+}
+
+// BallotHashHasPrefix applies the HasPrefix predicate on the "ballot_hash" field.
+func BallotHashHasPrefix(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldHasPrefix(FieldBallotHash, v))
+}
+
+// BallotHashHasSuffix applies the HasSuffix predicate on the "ballot_hash" field.
+func BallotHashHasSuffix(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldHasSuffix(FieldBallotHash, v))
+}
+
+// BallotHashEqualFold applies the EqualFold predicate on the "ballot_hash" field.
+func BallotHashEqualFold(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEqualFold(FieldBallotHash, v))
+}
+
+// BallotHashContainsFold applies the ContainsFold predicate on the "ballot_hash" field.
+func BallotHashContainsFold(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldContainsFold(FieldBallotHash, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.FieldContainsFold(FieldHash, v))
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BallotLogEntry) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BallotLogEntry) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BallotLogEntry) predicate.BallotLogEntry {
+	return predicate.BallotLogEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/poll"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BallotLogEntryCreate is the builder for creating a BallotLogEntry entity.
+type BallotLogEntryCreate struct {
+	config
+	mutation *BallotLogEntryMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *BallotLogEntryCreate) SetPollID(v int) *BallotLogEntryCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetSequence sets the "sequence" field.
+func (_c *BallotLogEntryCreate) SetSequence(v int) *BallotLogEntryCreate {
+	_c.mutation.SetSequence(v)
+	return _c
+}
+
+// SetBallotHash sets the "ballot_hash" field.
+func (_c *BallotLogEntryCreate) SetBallotHash(v string) *BallotLogEntryCreate {
+	_c.mutation.SetBallotHash(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *BallotLogEntryCreate) SetHash(v string) *BallotLogEntryCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *BallotLogEntryCreate) SetPoll(v *Poll) *BallotLogEntryCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// Mutation returns the BallotLogEntryMutation object of the builder.
+func (_c *BallotLogEntryCreate) Mutation() *BallotLogEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the BallotLogEntry in the database.
+func (_c *BallotLogEntryCreate) Save(ctx context.Context) (*BallotLogEntry, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BallotLogEntryCreate) SaveX(ctx context.Context) *BallotLogEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BallotLogEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BallotLogEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BallotLogEntryCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "BallotLogEntry.poll_id"`)}
+	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "BallotLogEntry.sequence"`)}
+	}
+	if _, ok := _c.mutation.BallotHash(); !ok {
+		return &ValidationError{Name: "ballot_hash", err: errors.New(`ent: missing required field "BallotLogEntry.ballot_hash"`)}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "BallotLogEntry.hash"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "BallotLogEntry.poll"`)}
+	}
+	return nil
+}
+
+func (_c *BallotLogEntryCreate) sqlSave(ctx context.Context) (*BallotLogEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BallotLogEntryCreate) createSpec() (*BallotLogEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BallotLogEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ballotlogentry.Table, sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Sequence(); ok {
+		_spec.SetField(ballotlogentry.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
+	if value, ok := _c.mutation.BallotHash(); ok {
+		_spec.SetField(ballotlogentry.FieldBallotHash, field.TypeString, value)
+		_node.BallotHash = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(ballotlogentry.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotlogentry.PollTable,
+			Columns: []string{ballotlogentry.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BallotLogEntryCreateBulk is the builder for creating many BallotLogEntry entities in bulk.
+type BallotLogEntryCreateBulk struct {
+	config
+	err      error
+	builders []*BallotLogEntryCreate
+}
+
+// Save creates the BallotLogEntry entities in the database.
+func (_c *BallotLogEntryCreateBulk) Save(ctx context.Context) ([]*BallotLogEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BallotLogEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BallotLogEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BallotLogEntryCreateBulk) SaveX(ctx context.Context) []*BallotLogEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BallotLogEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BallotLogEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BallotLogEntryUpdate is the builder for updating BallotLogEntry entities.
+type BallotLogEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BallotLogEntryMutation
+}
+
+// Where appends a list predicates to the BallotLogEntryUpdate builder.
+func (_u *BallotLogEntryUpdate) Where(ps ...predicate.BallotLogEntry) *BallotLogEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *BallotLogEntryUpdate) SetPollID(v int) *BallotLogEntryUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *BallotLogEntryUpdate) SetNillablePollID(v *int) *BallotLogEntryUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *BallotLogEntryUpdate) SetSequence(v int) *BallotLogEntryUpdate {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *BallotLogEntryUpdate) SetNillableSequence(v *int) *BallotLogEntryUpdate {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *BallotLogEntryUpdate) AddSequence(v int) *BallotLogEntryUpdate {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetBallotHash sets the "ballot_hash" field.
+func (_u *BallotLogEntryUpdate) SetBallotHash(v string) *BallotLogEntryUpdate {
+	_u.mutation.SetBallotHash(v)
+	return _u
+}
+
+// SetNillableBallotHash sets the "ballot_hash" field if the given value is not nil.
+func (_u *BallotLogEntryUpdate) SetNillableBallotHash(v *string) *BallotLogEntryUpdate {
+	if v != nil {
+		_u.SetBallotHash(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BallotLogEntryUpdate) SetHash(v string) *BallotLogEntryUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BallotLogEntryUpdate) SetNillableHash(v *string) *BallotLogEntryUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *BallotLogEntryUpdate) SetPoll(v *Poll) *BallotLogEntryUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// Mutation returns the BallotLogEntryMutation object of the builder.
+func (_u *BallotLogEntryUpdate) Mutation() *BallotLogEntryMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *BallotLogEntryUpdate) ClearPoll() *BallotLogEntryUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BallotLogEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BallotLogEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BallotLogEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BallotLogEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BallotLogEntryUpdate) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BallotLogEntry.poll"`)
+	}
+	return nil
+}
+
+func (_u *BallotLogEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ballotlogentry.Table, ballotlogentry.Columns, sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(ballotlogentry.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(ballotlogentry.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BallotHash(); ok {
+		_spec.SetField(ballotlogentry.FieldBallotHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(ballotlogentry.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotlogentry.PollTable,
+			Columns: []string{ballotlogentry.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotlogentry.PollTable,
+			Columns: []string{ballotlogentry.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ballotlogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BallotLogEntryUpdateOne is the builder for updating a single BallotLogEntry entity.
+type BallotLogEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BallotLogEntryMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *BallotLogEntryUpdateOne) SetPollID(v int) *BallotLogEntryUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *BallotLogEntryUpdateOne) SetNillablePollID(v *int) *BallotLogEntryUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *BallotLogEntryUpdateOne) SetSequence(v int) *BallotLogEntryUpdateOne {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *BallotLogEntryUpdateOne) SetNillableSequence(v *int) *BallotLogEntryUpdateOne {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *BallotLogEntryUpdateOne) AddSequence(v int) *BallotLogEntryUpdateOne {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetBallotHash sets the "ballot_hash" field.
+func (_u *BallotLogEntryUpdateOne) SetBallotHash(v string) *BallotLogEntryUpdateOne {
+	_u.mutation.SetBallotHash(v)
+	return _u
+}
+
+// SetNillableBallotHash sets the "ballot_hash" field if the given value is not nil.
+func (_u *BallotLogEntryUpdateOne) SetNillableBallotHash(v *string) *BallotLogEntryUpdateOne {
+	if v != nil {
+		_u.SetBallotHash(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BallotLogEntryUpdateOne) SetHash(v string) *BallotLogEntryUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BallotLogEntryUpdateOne) SetNillableHash(v *string) *BallotLogEntryUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *BallotLogEntryUpdateOne) SetPoll(v *Poll) *BallotLogEntryUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// Mutation returns the BallotLogEntryMutation object of the builder.
+func (_u *BallotLogEntryUpdateOne) Mutation() *BallotLogEntryMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *BallotLogEntryUpdateOne) ClearPoll() *BallotLogEntryUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// Where appends a list predicates to the BallotLogEntryUpdate builder.
+func (_u *BallotLogEntryUpdateOne) Where(ps ...predicate.BallotLogEntry) *BallotLogEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BallotLogEntryUpdateOne) Select(field string, fields ...string) *BallotLogEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BallotLogEntry entity.
+func (_u *BallotLogEntryUpdateOne) Save(ctx context.Context) (*BallotLogEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BallotLogEntryUpdateOne) SaveX(ctx context.Context) *BallotLogEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BallotLogEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BallotLogEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BallotLogEntryUpdateOne) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BallotLogEntry.poll"`)
+	}
+	return nil
+}
+
+func (_u *BallotLogEntryUpdateOne) sqlSave(ctx context.Context) (_node *BallotLogEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ballotlogentry.Table, ballotlogentry.Columns, sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BallotLogEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ballotlogentry.FieldID)
+		for _, f := range fields {
+			if !ballotlogentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ballotlogentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(ballotlogentry.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(ballotlogentry.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BallotHash(); ok {
+		_spec.SetField(ballotlogentry.FieldBallotHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(ballotlogentry.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotlogentry.PollTable,
+			Columns: []string{ballotlogentry.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ballotlogentry.PollTable,
+			Columns: []string{ballotlogentry.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BallotLogEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ballotlogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,9 +6,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/apikey"
+	"pollapp/backend/ent/auditevent"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/emailtoken"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/organization"
+	"pollapp/backend/ent/organizationmember"
+	"pollapp/backend/ent/participation"
 	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+	"pollapp/backend/ent/pollmember"
 	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/pollrevision"
+	"pollapp/backend/ent/polltransition"
+	"pollapp/backend/ent/recoverycode"
+	"pollapp/backend/ent/refreshtoken"
+	"pollapp/backend/ent/session"
+	"pollapp/backend/ent/sharelink"
 	"pollapp/backend/ent/user"
+	"pollapp/backend/ent/useridentity"
 	"pollapp/backend/ent/vote"
 	"reflect"
 	"sync"
@@ -76,10 +96,30 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			poll.Table:       poll.ValidColumn,
-			polloption.Table: polloption.ValidColumn,
-			user.Table:       user.ValidColumn,
-			vote.Table:       vote.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			anonymousballot.Table:    anonymousballot.ValidColumn,
+			auditevent.Table:         auditevent.ValidColumn,
+			ballot.Table:             ballot.ValidColumn,
+			ballotentry.Table:        ballotentry.ValidColumn,
+			ballotlogentry.Table:     ballotlogentry.ValidColumn,
+			emailtoken.Table:         emailtoken.ValidColumn,
+			guestvoter.Table:         guestvoter.ValidColumn,
+			organization.Table:       organization.ValidColumn,
+			organizationmember.Table: organizationmember.ValidColumn,
+			participation.Table:      participation.ValidColumn,
+			poll.Table:               poll.ValidColumn,
+			pollcollaborator.Table:   pollcollaborator.ValidColumn,
+			pollmember.Table:         pollmember.ValidColumn,
+			polloption.Table:         polloption.ValidColumn,
+			pollrevision.Table:       pollrevision.ValidColumn,
+			polltransition.Table:     polltransition.ValidColumn,
+			recoverycode.Table:       recoverycode.ValidColumn,
+			refreshtoken.Table:       refreshtoken.ValidColumn,
+			session.Table:            session.ValidColumn,
+			sharelink.Table:          sharelink.ValidColumn,
+			user.Table:               user.ValidColumn,
+			useridentity.Table:       useridentity.ValidColumn,
+			vote.Table:               vote.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
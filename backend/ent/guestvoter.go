@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/poll"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GuestVoter is the model entity for the GuestVoter schema.
+type GuestVoter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID int `json:"poll_id,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// IPHash holds the value of the "ip_hash" field.
+	IPHash string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GuestVoterQuery when eager-loading is set.
+	Edges        GuestVoterEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GuestVoterEdges holds the relations/edges for other nodes in the graph.
+type GuestVoterEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// Votes holds the value of the votes edge.
+	Votes []*Vote `json:"votes,omitempty"`
+	// Ballots holds the value of the ballots edge.
+	Ballots []*Ballot `json:"ballots,omitempty"`
+	// Participations holds the value of the participations edge.
+	Participations []*Participation `json:"participations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GuestVoterEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// VotesOrErr returns the Votes value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuestVoterEdges) VotesOrErr() ([]*Vote, error) {
+	if e.loadedTypes[1] {
+		return e.Votes, nil
+	}
+	return nil, &NotLoadedError{edge: "votes"}
+}
+
+// BallotsOrErr returns the Ballots value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuestVoterEdges) BallotsOrErr() ([]*Ballot, error) {
+	if e.loadedTypes[2] {
+		return e.Ballots, nil
+	}
+	return nil, &NotLoadedError{edge: "ballots"}
+}
+
+// ParticipationsOrErr returns the Participations value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuestVoterEdges) ParticipationsOrErr() ([]*Participation, error) {
+	if e.loadedTypes[3] {
+		return e.Participations, nil
+	}
+	return nil, &NotLoadedError{edge: "participations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GuestVoter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case guestvoter.FieldID, guestvoter.FieldPollID:
+			values[i] = new(sql.NullInt64)
+		case guestvoter.FieldTokenHash, guestvoter.FieldIPHash:
+			values[i] = new(sql.NullString)
+		case guestvoter.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GuestVoter fields.
+func (_m *GuestVoter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case guestvoter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case guestvoter.FieldPollID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value.Valid {
+				_m.PollID = int(value.Int64)
+			}
+		case guestvoter.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case guestvoter.FieldIPHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_hash", values[i])
+			} else if value.Valid {
+				_m.IPHash = value.String
+			}
+		case guestvoter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GuestVoter.
+// This includes values selected through modifiers, order, etc.
+func (_m *GuestVoter) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the GuestVoter entity.
+func (_m *GuestVoter) QueryPoll() *PollQuery {
+	return NewGuestVoterClient(_m.config).QueryPoll(_m)
+}
+
+// QueryVotes queries the "votes" edge of the GuestVoter entity.
+func (_m *GuestVoter) QueryVotes() *VoteQuery {
+	return NewGuestVoterClient(_m.config).QueryVotes(_m)
+}
+
+// QueryBallots queries the "ballots" edge of the GuestVoter entity.
+func (_m *GuestVoter) QueryBallots() *BallotQuery {
+	return NewGuestVoterClient(_m.config).QueryBallots(_m)
+}
+
+// QueryParticipations queries the "participations" edge of the GuestVoter entity.
+func (_m *GuestVoter) QueryParticipations() *ParticipationQuery {
+	return NewGuestVoterClient(_m.config).QueryParticipations(_m)
+}
+
+// Update returns a builder for updating this GuestVoter.
+// Note that you need to call GuestVoter.Unwrap() before calling this method if this GuestVoter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GuestVoter) Update() *GuestVoterUpdateOne {
+	return NewGuestVoterClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GuestVoter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GuestVoter) Unwrap() *GuestVoter {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GuestVoter is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GuestVoter) String() string {
+	var builder strings.Builder
+	builder.WriteString("GuestVoter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("ip_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GuestVoters is a parsable slice of GuestVoter.
+type GuestVoters []*GuestVoter
@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guestvoter
+
+import (
+	"pollapp/backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLTE(FieldID, id))
+}
+
+// PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
+func PollID(v int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldPollID, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// IPHash applies equality check predicate on the "ip_hash" field. It's identical to IPHashEQ.
+func IPHash(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldIPHash, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PollIDEQ applies the EQ predicate on the "poll_id" field.
+func PollIDEQ(v int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollIDNEQ applies the NEQ predicate on the "poll_id" field.
+func PollIDNEQ(v int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNEQ(FieldPollID, v))
+}
+
+// PollIDIn applies the In predicate on the "poll_id" field.
+func PollIDIn(vs ...int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldIn(FieldPollID, vs...))
+}
+
+// PollIDNotIn applies the NotIn predicate on the "poll_id" field.
+func PollIDNotIn(vs ...int) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNotIn(FieldPollID, vs...))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// IPHashEQ applies the EQ predicate on the "ip_hash" field.
+func IPHashEQ(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldIPHash, v))
+}
+
+// IPHashNEQ applies the NEQ predicate on the "ip_hash" field.
+func IPHashNEQ(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNEQ(FieldIPHash, v))
+}
+
+// IPHashIn applies the In predicate on the "ip_hash" field.
+func IPHashIn(vs ...string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldIn(FieldIPHash, vs...))
+}
+
+// IPHashNotIn applies the NotIn predicate on the "ip_hash" field.
+func IPHashNotIn(vs ...string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNotIn(FieldIPHash, vs...))
+}
+
+// IPHashGT applies the GT predicate on the "ip_hash" field.
+func IPHashGT(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGT(FieldIPHash, v))
+}
+
+// IPHashGTE applies the GTE predicate on the "ip_hash" field.
+func IPHashGTE(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGTE(FieldIPHash, v))
+}
+
+// IPHashLT applies the LT predicate on the "ip_hash" field.
+func IPHashLT(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLT(FieldIPHash, v))
+}
+
+// IPHashLTE applies the LTE predicate on the "ip_hash" field.
+func IPHashLTE(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLTE(FieldIPHash, v))
+}
+
+// IPHashContains applies the Contains predicate on the "ip_hash" field.
+func IPHashContains(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldContains(FieldIPHash, v))
+}
+
+// IPHashHasPrefix applies the HasPrefix predicate on the "ip_hash" field.
+func IPHashHasPrefix(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldHasPrefix(FieldIPHash, v))
+}
+
+// IPHashHasSuffix applies the HasSuffix predicate on the "ip_hash" field.
+func IPHashHasSuffix(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldHasSuffix(FieldIPHash, v))
+}
+
+// IPHashIsNil applies the IsNil predicate on the "ip_hash" field.
+func IPHashIsNil() predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldIsNull(FieldIPHash))
+}
+
+// IPHashNotNil applies the NotNil predicate on the "ip_hash" field.
+func IPHashNotNil() predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNotNull(FieldIPHash))
+}
+
+// IPHashEqualFold applies the EqualFold predicate on the "ip_hash" field.
+func IPHashEqualFold(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEqualFold(FieldIPHash, v))
+}
+
+// IPHashContainsFold applies the ContainsFold predicate on the "ip_hash" field.
+func IPHashContainsFold(v string) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldContainsFold(FieldIPHash, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVotes applies the HasEdge predicate on the "votes" edge.
+func HasVotes() predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, VotesTable, VotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVotesWith applies the HasEdge predicate on the "votes" edge with a given conditions (other predicates).
+func HasVotesWith(preds ...predicate.Vote) predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := newVotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBallots applies the HasEdge predicate on the "ballots" edge.
+func HasBallots() predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, BallotsTable, BallotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBallotsWith applies the HasEdge predicate on the "ballots" edge with a given conditions (other predicates).
+func HasBallotsWith(preds ...predicate.Ballot) predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := newBallotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParticipations applies the HasEdge predicate on the "participations" edge.
+func HasParticipations() predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ParticipationsTable, ParticipationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipationsWith applies the HasEdge predicate on the "participations" edge with a given conditions (other predicates).
+func HasParticipationsWith(preds ...predicate.Participation) predicate.GuestVoter {
+	return predicate.GuestVoter(func(s *sql.Selector) {
+		step := newParticipationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GuestVoter) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GuestVoter) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GuestVoter) predicate.GuestVoter {
+	return predicate.GuestVoter(sql.NotPredicates(p))
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/participation"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/vote"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GuestVoterCreate is the builder for creating a GuestVoter entity.
+type GuestVoterCreate struct {
+	config
+	mutation *GuestVoterMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *GuestVoterCreate) SetPollID(v int) *GuestVoterCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *GuestVoterCreate) SetTokenHash(v string) *GuestVoterCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetIPHash sets the "ip_hash" field.
+func (_c *GuestVoterCreate) SetIPHash(v string) *GuestVoterCreate {
+	_c.mutation.SetIPHash(v)
+	return _c
+}
+
+// SetNillableIPHash sets the "ip_hash" field if the given value is not nil.
+func (_c *GuestVoterCreate) SetNillableIPHash(v *string) *GuestVoterCreate {
+	if v != nil {
+		_c.SetIPHash(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GuestVoterCreate) SetCreatedAt(v time.Time) *GuestVoterCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GuestVoterCreate) SetNillableCreatedAt(v *time.Time) *GuestVoterCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *GuestVoterCreate) SetPoll(v *Poll) *GuestVoterCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
+func (_c *GuestVoterCreate) AddVoteIDs(ids ...int) *GuestVoterCreate {
+	_c.mutation.AddVoteIDs(ids...)
+	return _c
+}
+
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_c *GuestVoterCreate) AddVotes(v ...*Vote) *GuestVoterCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVoteIDs(ids...)
+}
+
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_c *GuestVoterCreate) AddBallotIDs(ids ...int) *GuestVoterCreate {
+	_c.mutation.AddBallotIDs(ids...)
+	return _c
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_c *GuestVoterCreate) AddBallots(v ...*Ballot) *GuestVoterCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBallotIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_c *GuestVoterCreate) AddParticipationIDs(ids ...int) *GuestVoterCreate {
+	_c.mutation.AddParticipationIDs(ids...)
+	return _c
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_c *GuestVoterCreate) AddParticipations(v ...*Participation) *GuestVoterCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddParticipationIDs(ids...)
+}
+
+// Mutation returns the GuestVoterMutation object of the builder.
+func (_c *GuestVoterCreate) Mutation() *GuestVoterMutation {
+	return _c.mutation
+}
+
+// Save creates the GuestVoter in the database.
+func (_c *GuestVoterCreate) Save(ctx context.Context) (*GuestVoter, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GuestVoterCreate) SaveX(ctx context.Context) *GuestVoter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuestVoterCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuestVoterCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GuestVoterCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := guestvoter.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GuestVoterCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "GuestVoter.poll_id"`)}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "GuestVoter.token_hash"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GuestVoter.created_at"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "GuestVoter.poll"`)}
+	}
+	return nil
+}
+
+func (_c *GuestVoterCreate) sqlSave(ctx context.Context) (*GuestVoter, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GuestVoterCreate) createSpec() (*GuestVoter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GuestVoter{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(guestvoter.Table, sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(guestvoter.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.IPHash(); ok {
+		_spec.SetField(guestvoter.FieldIPHash, field.TypeString, value)
+		_node.IPHash = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(guestvoter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   guestvoter.PollTable,
+			Columns: []string{guestvoter.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.VotesTable,
+			Columns: []string{guestvoter.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.BallotsTable,
+			Columns: []string{guestvoter.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.ParticipationsTable,
+			Columns: []string{guestvoter.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GuestVoterCreateBulk is the builder for creating many GuestVoter entities in bulk.
+type GuestVoterCreateBulk struct {
+	config
+	err      error
+	builders []*GuestVoterCreate
+}
+
+// Save creates the GuestVoter entities in the database.
+func (_c *GuestVoterCreateBulk) Save(ctx context.Context) ([]*GuestVoter, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GuestVoter, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuestVoterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GuestVoterCreateBulk) SaveX(ctx context.Context) []*GuestVoter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuestVoterCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuestVoterCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
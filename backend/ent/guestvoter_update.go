@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/participation"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/vote"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GuestVoterUpdate is the builder for updating GuestVoter entities.
+type GuestVoterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuestVoterMutation
+}
+
+// Where appends a list predicates to the GuestVoterUpdate builder.
+func (_u *GuestVoterUpdate) Where(ps ...predicate.GuestVoter) *GuestVoterUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *GuestVoterUpdate) SetPollID(v int) *GuestVoterUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *GuestVoterUpdate) SetNillablePollID(v *int) *GuestVoterUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *GuestVoterUpdate) SetTokenHash(v string) *GuestVoterUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *GuestVoterUpdate) SetNillableTokenHash(v *string) *GuestVoterUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetIPHash sets the "ip_hash" field.
+func (_u *GuestVoterUpdate) SetIPHash(v string) *GuestVoterUpdate {
+	_u.mutation.SetIPHash(v)
+	return _u
+}
+
+// SetNillableIPHash sets the "ip_hash" field if the given value is not nil.
+func (_u *GuestVoterUpdate) SetNillableIPHash(v *string) *GuestVoterUpdate {
+	if v != nil {
+		_u.SetIPHash(*v)
+	}
+	return _u
+}
+
+// ClearIPHash clears the value of the "ip_hash" field.
+func (_u *GuestVoterUpdate) ClearIPHash() *GuestVoterUpdate {
+	_u.mutation.ClearIPHash()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *GuestVoterUpdate) SetCreatedAt(v time.Time) *GuestVoterUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *GuestVoterUpdate) SetNillableCreatedAt(v *time.Time) *GuestVoterUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *GuestVoterUpdate) SetPoll(v *Poll) *GuestVoterUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
+func (_u *GuestVoterUpdate) AddVoteIDs(ids ...int) *GuestVoterUpdate {
+	_u.mutation.AddVoteIDs(ids...)
+	return _u
+}
+
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_u *GuestVoterUpdate) AddVotes(v ...*Vote) *GuestVoterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
+}
+
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_u *GuestVoterUpdate) AddBallotIDs(ids ...int) *GuestVoterUpdate {
+	_u.mutation.AddBallotIDs(ids...)
+	return _u
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_u *GuestVoterUpdate) AddBallots(v ...*Ballot) *GuestVoterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_u *GuestVoterUpdate) AddParticipationIDs(ids ...int) *GuestVoterUpdate {
+	_u.mutation.AddParticipationIDs(ids...)
+	return _u
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_u *GuestVoterUpdate) AddParticipations(v ...*Participation) *GuestVoterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipationIDs(ids...)
+}
+
+// Mutation returns the GuestVoterMutation object of the builder.
+func (_u *GuestVoterUpdate) Mutation() *GuestVoterMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *GuestVoterUpdate) ClearPoll() *GuestVoterUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearVotes clears all "votes" edges to the Vote entity.
+func (_u *GuestVoterUpdate) ClearVotes() *GuestVoterUpdate {
+	_u.mutation.ClearVotes()
+	return _u
+}
+
+// RemoveVoteIDs removes the "votes" edge to Vote entities by IDs.
+func (_u *GuestVoterUpdate) RemoveVoteIDs(ids ...int) *GuestVoterUpdate {
+	_u.mutation.RemoveVoteIDs(ids...)
+	return _u
+}
+
+// RemoveVotes removes "votes" edges to Vote entities.
+func (_u *GuestVoterUpdate) RemoveVotes(v ...*Vote) *GuestVoterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteIDs(ids...)
+}
+
+// ClearBallots clears all "ballots" edges to the Ballot entity.
+func (_u *GuestVoterUpdate) ClearBallots() *GuestVoterUpdate {
+	_u.mutation.ClearBallots()
+	return _u
+}
+
+// RemoveBallotIDs removes the "ballots" edge to Ballot entities by IDs.
+func (_u *GuestVoterUpdate) RemoveBallotIDs(ids ...int) *GuestVoterUpdate {
+	_u.mutation.RemoveBallotIDs(ids...)
+	return _u
+}
+
+// RemoveBallots removes "ballots" edges to Ballot entities.
+func (_u *GuestVoterUpdate) RemoveBallots(v ...*Ballot) *GuestVoterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotIDs(ids...)
+}
+
+// ClearParticipations clears all "participations" edges to the Participation entity.
+func (_u *GuestVoterUpdate) ClearParticipations() *GuestVoterUpdate {
+	_u.mutation.ClearParticipations()
+	return _u
+}
+
+// RemoveParticipationIDs removes the "participations" edge to Participation entities by IDs.
+func (_u *GuestVoterUpdate) RemoveParticipationIDs(ids ...int) *GuestVoterUpdate {
+	_u.mutation.RemoveParticipationIDs(ids...)
+	return _u
+}
+
+// RemoveParticipations removes "participations" edges to Participation entities.
+func (_u *GuestVoterUpdate) RemoveParticipations(v ...*Participation) *GuestVoterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GuestVoterUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuestVoterUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GuestVoterUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuestVoterUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GuestVoterUpdate) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GuestVoter.poll"`)
+	}
+	return nil
+}
+
+func (_u *GuestVoterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guestvoter.Table, guestvoter.Columns, sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(guestvoter.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IPHash(); ok {
+		_spec.SetField(guestvoter.FieldIPHash, field.TypeString, value)
+	}
+	if _u.mutation.IPHashCleared() {
+		_spec.ClearField(guestvoter.FieldIPHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(guestvoter.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   guestvoter.PollTable,
+			Columns: []string{guestvoter.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   guestvoter.PollTable,
+			Columns: []string{guestvoter.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.VotesTable,
+			Columns: []string{guestvoter.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.VotesTable,
+			Columns: []string{guestvoter.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.VotesTable,
+			Columns: []string{guestvoter.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.BallotsTable,
+			Columns: []string{guestvoter.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotsIDs(); len(nodes) > 0 && !_u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.BallotsTable,
+			Columns: []string{guestvoter.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.BallotsTable,
+			Columns: []string{guestvoter.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.ParticipationsTable,
+			Columns: []string{guestvoter.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !_u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.ParticipationsTable,
+			Columns: []string{guestvoter.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.ParticipationsTable,
+			Columns: []string{guestvoter.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guestvoter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GuestVoterUpdateOne is the builder for updating a single GuestVoter entity.
+type GuestVoterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuestVoterMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *GuestVoterUpdateOne) SetPollID(v int) *GuestVoterUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *GuestVoterUpdateOne) SetNillablePollID(v *int) *GuestVoterUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *GuestVoterUpdateOne) SetTokenHash(v string) *GuestVoterUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *GuestVoterUpdateOne) SetNillableTokenHash(v *string) *GuestVoterUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetIPHash sets the "ip_hash" field.
+func (_u *GuestVoterUpdateOne) SetIPHash(v string) *GuestVoterUpdateOne {
+	_u.mutation.SetIPHash(v)
+	return _u
+}
+
+// SetNillableIPHash sets the "ip_hash" field if the given value is not nil.
+func (_u *GuestVoterUpdateOne) SetNillableIPHash(v *string) *GuestVoterUpdateOne {
+	if v != nil {
+		_u.SetIPHash(*v)
+	}
+	return _u
+}
+
+// ClearIPHash clears the value of the "ip_hash" field.
+func (_u *GuestVoterUpdateOne) ClearIPHash() *GuestVoterUpdateOne {
+	_u.mutation.ClearIPHash()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *GuestVoterUpdateOne) SetCreatedAt(v time.Time) *GuestVoterUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *GuestVoterUpdateOne) SetNillableCreatedAt(v *time.Time) *GuestVoterUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *GuestVoterUpdateOne) SetPoll(v *Poll) *GuestVoterUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
+func (_u *GuestVoterUpdateOne) AddVoteIDs(ids ...int) *GuestVoterUpdateOne {
+	_u.mutation.AddVoteIDs(ids...)
+	return _u
+}
+
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_u *GuestVoterUpdateOne) AddVotes(v ...*Vote) *GuestVoterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
+}
+
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_u *GuestVoterUpdateOne) AddBallotIDs(ids ...int) *GuestVoterUpdateOne {
+	_u.mutation.AddBallotIDs(ids...)
+	return _u
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_u *GuestVoterUpdateOne) AddBallots(v ...*Ballot) *GuestVoterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_u *GuestVoterUpdateOne) AddParticipationIDs(ids ...int) *GuestVoterUpdateOne {
+	_u.mutation.AddParticipationIDs(ids...)
+	return _u
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_u *GuestVoterUpdateOne) AddParticipations(v ...*Participation) *GuestVoterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipationIDs(ids...)
+}
+
+// Mutation returns the GuestVoterMutation object of the builder.
+func (_u *GuestVoterUpdateOne) Mutation() *GuestVoterMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *GuestVoterUpdateOne) ClearPoll() *GuestVoterUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearVotes clears all "votes" edges to the Vote entity.
+func (_u *GuestVoterUpdateOne) ClearVotes() *GuestVoterUpdateOne {
+	_u.mutation.ClearVotes()
+	return _u
+}
+
+// RemoveVoteIDs removes the "votes" edge to Vote entities by IDs.
+func (_u *GuestVoterUpdateOne) RemoveVoteIDs(ids ...int) *GuestVoterUpdateOne {
+	_u.mutation.RemoveVoteIDs(ids...)
+	return _u
+}
+
+// RemoveVotes removes "votes" edges to Vote entities.
+func (_u *GuestVoterUpdateOne) RemoveVotes(v ...*Vote) *GuestVoterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteIDs(ids...)
+}
+
+// ClearBallots clears all "ballots" edges to the Ballot entity.
+func (_u *GuestVoterUpdateOne) ClearBallots() *GuestVoterUpdateOne {
+	_u.mutation.ClearBallots()
+	return _u
+}
+
+// RemoveBallotIDs removes the "ballots" edge to Ballot entities by IDs.
+func (_u *GuestVoterUpdateOne) RemoveBallotIDs(ids ...int) *GuestVoterUpdateOne {
+	_u.mutation.RemoveBallotIDs(ids...)
+	return _u
+}
+
+// RemoveBallots removes "ballots" edges to Ballot entities.
+func (_u *GuestVoterUpdateOne) RemoveBallots(v ...*Ballot) *GuestVoterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotIDs(ids...)
+}
+
+// ClearParticipations clears all "participations" edges to the Participation entity.
+func (_u *GuestVoterUpdateOne) ClearParticipations() *GuestVoterUpdateOne {
+	_u.mutation.ClearParticipations()
+	return _u
+}
+
+// RemoveParticipationIDs removes the "participations" edge to Participation entities by IDs.
+func (_u *GuestVoterUpdateOne) RemoveParticipationIDs(ids ...int) *GuestVoterUpdateOne {
+	_u.mutation.RemoveParticipationIDs(ids...)
+	return _u
+}
+
+// RemoveParticipations removes "participations" edges to Participation entities.
+func (_u *GuestVoterUpdateOne) RemoveParticipations(v ...*Participation) *GuestVoterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipationIDs(ids...)
+}
+
+// Where appends a list predicates to the GuestVoterUpdate builder.
+func (_u *GuestVoterUpdateOne) Where(ps ...predicate.GuestVoter) *GuestVoterUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GuestVoterUpdateOne) Select(field string, fields ...string) *GuestVoterUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GuestVoter entity.
+func (_u *GuestVoterUpdateOne) Save(ctx context.Context) (*GuestVoter, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuestVoterUpdateOne) SaveX(ctx context.Context) *GuestVoter {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GuestVoterUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuestVoterUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GuestVoterUpdateOne) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GuestVoter.poll"`)
+	}
+	return nil
+}
+
+func (_u *GuestVoterUpdateOne) sqlSave(ctx context.Context) (_node *GuestVoter, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guestvoter.Table, guestvoter.Columns, sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GuestVoter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guestvoter.FieldID)
+		for _, f := range fields {
+			if !guestvoter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != guestvoter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(guestvoter.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IPHash(); ok {
+		_spec.SetField(guestvoter.FieldIPHash, field.TypeString, value)
+	}
+	if _u.mutation.IPHashCleared() {
+		_spec.ClearField(guestvoter.FieldIPHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(guestvoter.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   guestvoter.PollTable,
+			Columns: []string{guestvoter.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   guestvoter.PollTable,
+			Columns: []string{guestvoter.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.VotesTable,
+			Columns: []string{guestvoter.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.VotesTable,
+			Columns: []string{guestvoter.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.VotesTable,
+			Columns: []string{guestvoter.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.BallotsTable,
+			Columns: []string{guestvoter.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotsIDs(); len(nodes) > 0 && !_u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.BallotsTable,
+			Columns: []string{guestvoter.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.BallotsTable,
+			Columns: []string{guestvoter.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.ParticipationsTable,
+			Columns: []string{guestvoter.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !_u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.ParticipationsTable,
+			Columns: []string{guestvoter.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   guestvoter.ParticipationsTable,
+			Columns: []string{guestvoter.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GuestVoter{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guestvoter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
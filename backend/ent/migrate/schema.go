@@ -8,19 +8,445 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "org_id", Type: field.TypeInt, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_user",
+				Columns:    []*schema.Column{APIKeysColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AnonymousBallotsColumns holds the columns for the "anonymous_ballots" table.
+	AnonymousBallotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "choices", Type: field.TypeJSON, Nullable: true},
+		{Name: "scores", Type: field.TypeJSON, Nullable: true},
+		{Name: "receipt_hash", Type: field.TypeString, Unique: true},
+		{Name: "cast_on", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+	}
+	// AnonymousBallotsTable holds the schema information for the "anonymous_ballots" table.
+	AnonymousBallotsTable = &schema.Table{
+		Name:       "anonymous_ballots",
+		Columns:    AnonymousBallotsColumns,
+		PrimaryKey: []*schema.Column{AnonymousBallotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "anonymous_ballots_polls_poll",
+				Columns:    []*schema.Column{AnonymousBallotsColumns[5]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_event_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[6]},
+			},
+			{
+				Name:    "auditevent_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[2], AuditEventsColumns[6]},
+			},
+		},
+	}
+	// BallotsColumns holds the columns for the "ballots" table.
+	BallotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "guest_voter_id", Type: field.TypeInt, Nullable: true},
+	}
+	// BallotsTable holds the schema information for the "ballots" table.
+	BallotsTable = &schema.Table{
+		Name:       "ballots",
+		Columns:    BallotsColumns,
+		PrimaryKey: []*schema.Column{BallotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ballots_polls_poll",
+				Columns:    []*schema.Column{BallotsColumns[2]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "ballots_users_user",
+				Columns:    []*schema.Column{BallotsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "ballots_guest_voters_guest_voter",
+				Columns:    []*schema.Column{BallotsColumns[4]},
+				RefColumns: []*schema.Column{GuestVotersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ballot_poll_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{BallotsColumns[2], BallotsColumns[3]},
+			},
+			{
+				Name:    "ballot_poll_id_guest_voter_id",
+				Unique:  true,
+				Columns: []*schema.Column{BallotsColumns[2], BallotsColumns[4]},
+			},
+		},
+	}
+	// BallotEntriesColumns holds the columns for the "ballot_entries" table.
+	BallotEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rank", Type: field.TypeInt, Nullable: true},
+		{Name: "score", Type: field.TypeInt, Nullable: true},
+		{Name: "ballot_id", Type: field.TypeInt},
+		{Name: "poll_option_id", Type: field.TypeInt},
+	}
+	// BallotEntriesTable holds the schema information for the "ballot_entries" table.
+	BallotEntriesTable = &schema.Table{
+		Name:       "ballot_entries",
+		Columns:    BallotEntriesColumns,
+		PrimaryKey: []*schema.Column{BallotEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ballot_entries_ballots_ballot",
+				Columns:    []*schema.Column{BallotEntriesColumns[3]},
+				RefColumns: []*schema.Column{BallotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "ballot_entries_poll_options_poll_option",
+				Columns:    []*schema.Column{BallotEntriesColumns[4]},
+				RefColumns: []*schema.Column{PollOptionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ballotentry_ballot_id_poll_option_id",
+				Unique:  true,
+				Columns: []*schema.Column{BallotEntriesColumns[3], BallotEntriesColumns[4]},
+			},
+		},
+	}
+	// BallotLogEntriesColumns holds the columns for the "ballot_log_entries" table.
+	BallotLogEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sequence", Type: field.TypeInt},
+		{Name: "ballot_hash", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "poll_id", Type: field.TypeInt},
+	}
+	// BallotLogEntriesTable holds the schema information for the "ballot_log_entries" table.
+	BallotLogEntriesTable = &schema.Table{
+		Name:       "ballot_log_entries",
+		Columns:    BallotLogEntriesColumns,
+		PrimaryKey: []*schema.Column{BallotLogEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ballot_log_entries_polls_poll",
+				Columns:    []*schema.Column{BallotLogEntriesColumns[4]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ballotlogentry_poll_id_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{BallotLogEntriesColumns[4], BallotLogEntriesColumns[1]},
+			},
+		},
+	}
+	// EmailTokensColumns holds the columns for the "email_tokens" table.
+	EmailTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"reset_password", "verify_email"}},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// EmailTokensTable holds the schema information for the "email_tokens" table.
+	EmailTokensTable = &schema.Table{
+		Name:       "email_tokens",
+		Columns:    EmailTokensColumns,
+		PrimaryKey: []*schema.Column{EmailTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_tokens_users_user",
+				Columns:    []*schema.Column{EmailTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// GuestVotersColumns holds the columns for the "guest_voters" table.
+	GuestVotersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "ip_hash", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+	}
+	// GuestVotersTable holds the schema information for the "guest_voters" table.
+	GuestVotersTable = &schema.Table{
+		Name:       "guest_voters",
+		Columns:    GuestVotersColumns,
+		PrimaryKey: []*schema.Column{GuestVotersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "guest_voters_polls_poll",
+				Columns:    []*schema.Column{GuestVotersColumns[4]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
+	// OrganizationMembersColumns holds the columns for the "organization_members" table.
+	OrganizationMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "pending", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// OrganizationMembersTable holds the schema information for the "organization_members" table.
+	OrganizationMembersTable = &schema.Table{
+		Name:       "organization_members",
+		Columns:    OrganizationMembersColumns,
+		PrimaryKey: []*schema.Column{OrganizationMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_members_organizations_organization",
+				Columns:    []*schema.Column{OrganizationMembersColumns[4]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "organization_members_users_user",
+				Columns:    []*schema.Column{OrganizationMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationmember_organization_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{OrganizationMembersColumns[4], OrganizationMembersColumns[5]},
+			},
+		},
+	}
+	// ParticipationsColumns holds the columns for the "participations" table.
+	ParticipationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "guest_voter_id", Type: field.TypeInt, Nullable: true},
+	}
+	// ParticipationsTable holds the schema information for the "participations" table.
+	ParticipationsTable = &schema.Table{
+		Name:       "participations",
+		Columns:    ParticipationsColumns,
+		PrimaryKey: []*schema.Column{ParticipationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "participations_polls_poll",
+				Columns:    []*schema.Column{ParticipationsColumns[2]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "participations_users_user",
+				Columns:    []*schema.Column{ParticipationsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "participations_guest_voters_guest_voter",
+				Columns:    []*schema.Column{ParticipationsColumns[4]},
+				RefColumns: []*schema.Column{GuestVotersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "participation_poll_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ParticipationsColumns[2], ParticipationsColumns[3]},
+			},
+			{
+				Name:    "participation_poll_id_guest_voter_id",
+				Unique:  true,
+				Columns: []*schema.Column{ParticipationsColumns[2], ParticipationsColumns[4]},
+			},
+		},
+	}
 	// PollsColumns holds the columns for the "polls" table.
 	PollsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
 		{Name: "created_by", Type: field.TypeInt},
+		{Name: "min_choices", Type: field.TypeInt, Default: 1},
+		{Name: "max_choices", Type: field.TypeInt, Default: 1},
+		{Name: "voting_method", Type: field.TypeEnum, Enums: []string{"plurality", "ranked", "score", "star", "approval", "proposal"}, Default: "plurality"},
+		{Name: "tally_rule", Type: field.TypeEnum, Enums: []string{"instant_runoff", "condorcet"}, Default: "instant_runoff"},
+		{Name: "score_min", Type: field.TypeInt, Default: 0},
+		{Name: "score_max", Type: field.TypeInt, Default: 5},
+		{Name: "pass_threshold", Type: field.TypeEnum, Enums: []string{"simple_majority", "two_thirds", "percentage"}, Default: "simple_majority"},
+		{Name: "pass_percentage", Type: field.TypeInt, Default: 50},
+		{Name: "opens_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
+		{Name: "close_event_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "unlisted", "private"}, Default: "public"},
+		{Name: "results_visibility", Type: field.TypeEnum, Enums: []string{"always", "after_vote", "after_close", "owner_only"}, Default: "always"},
+		{Name: "anonymous", Type: field.TypeBool, Default: false},
+		{Name: "allow_guests", Type: field.TypeBool, Default: false},
+		{Name: "guest_dedupe", Type: field.TypeEnum, Enums: []string{"token", "token_ip"}, Default: "token"},
+		{Name: "revision", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 	}
 	// PollsTable holds the schema information for the "polls" table.
 	PollsTable = &schema.Table{
 		Name:       "polls",
 		Columns:    PollsColumns,
 		PrimaryKey: []*schema.Column{PollsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "polls_organizations_organization",
+				Columns:    []*schema.Column{PollsColumns[24]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PollCollaboratorsColumns holds the columns for the "poll_collaborators" table.
+	PollCollaboratorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"co_owner", "editor", "results_viewer"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PollCollaboratorsTable holds the schema information for the "poll_collaborators" table.
+	PollCollaboratorsTable = &schema.Table{
+		Name:       "poll_collaborators",
+		Columns:    PollCollaboratorsColumns,
+		PrimaryKey: []*schema.Column{PollCollaboratorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_collaborators_polls_poll",
+				Columns:    []*schema.Column{PollCollaboratorsColumns[3]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "poll_collaborators_users_user",
+				Columns:    []*schema.Column{PollCollaboratorsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pollcollaborator_poll_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PollCollaboratorsColumns[3], PollCollaboratorsColumns[4]},
+			},
+		},
+	}
+	// PollMembersColumns holds the columns for the "poll_members" table.
+	PollMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PollMembersTable holds the schema information for the "poll_members" table.
+	PollMembersTable = &schema.Table{
+		Name:       "poll_members",
+		Columns:    PollMembersColumns,
+		PrimaryKey: []*schema.Column{PollMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_members_polls_poll",
+				Columns:    []*schema.Column{PollMembersColumns[2]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "poll_members_users_user",
+				Columns:    []*schema.Column{PollMembersColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pollmember_poll_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PollMembersColumns[2], PollMembersColumns[3]},
+			},
+		},
 	}
 	// PollOptionsColumns holds the columns for the "poll_options" table.
 	PollOptionsColumns = []*schema.Column{
@@ -43,12 +469,174 @@ var (
 			},
 		},
 	}
+	// PollRevisionsColumns holds the columns for the "poll_revisions" table.
+	PollRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "entity", Type: field.TypeEnum, Enums: []string{"poll", "option"}},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "close", "reopen", "archive"}},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PollRevisionsTable holds the schema information for the "poll_revisions" table.
+	PollRevisionsTable = &schema.Table{
+		Name:       "poll_revisions",
+		Columns:    PollRevisionsColumns,
+		PrimaryKey: []*schema.Column{PollRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pollrevision_poll_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PollRevisionsColumns[1], PollRevisionsColumns[8]},
+			},
+		},
+	}
+	// PollTransitionsColumns holds the columns for the "poll_transitions" table.
+	PollTransitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"close", "reopen", "archive"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "actor_id", Type: field.TypeInt},
+	}
+	// PollTransitionsTable holds the schema information for the "poll_transitions" table.
+	PollTransitionsTable = &schema.Table{
+		Name:       "poll_transitions",
+		Columns:    PollTransitionsColumns,
+		PrimaryKey: []*schema.Column{PollTransitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_transitions_polls_poll",
+				Columns:    []*schema.Column{PollTransitionsColumns[3]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "poll_transitions_users_actor",
+				Columns:    []*schema.Column{PollTransitionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_user",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "session_id", Type: field.TypeInt},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_sessions_session",
+				Columns:    []*schema.Column{RefreshTokensColumns[5]},
+				RefColumns: []*schema.Column{SessionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_user",
+				Columns:    []*schema.Column{SessionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ShareLinksColumns holds the columns for the "share_links" table.
+	ShareLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "grant", Type: field.TypeEnum, Enums: []string{"view", "vote"}, Default: "vote"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "created_by", Type: field.TypeInt},
+	}
+	// ShareLinksTable holds the schema information for the "share_links" table.
+	ShareLinksTable = &schema.Table{
+		Name:       "share_links",
+		Columns:    ShareLinksColumns,
+		PrimaryKey: []*schema.Column{ShareLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_links_polls_poll",
+				Columns:    []*schema.Column{ShareLinksColumns[8]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "share_links_users_creator",
+				Columns:    []*schema.Column{ShareLinksColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Unique: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password_hash", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "moderator", "admin"}, Default: "user"},
+		{Name: "banned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_failures", Type: field.TypeInt, Default: 0},
+		{Name: "totp_failed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -57,13 +645,45 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_user",
+				Columns:    []*schema.Column{UserIdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_issuer_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[1], UserIdentitiesColumns[2]},
+			},
+		},
+	}
 	// VotesColumns holds the columns for the "votes" table.
 	VotesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "poll_id", Type: field.TypeInt},
 		{Name: "poll_option_id", Type: field.TypeInt},
-		{Name: "user_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "guest_voter_id", Type: field.TypeInt, Nullable: true},
 	}
 	// VotesTable holds the schema information for the "votes" table.
 	VotesTable = &schema.Table{
@@ -87,22 +707,89 @@ var (
 				Symbol:     "votes_users_user",
 				Columns:    []*schema.Column{VotesColumns[4]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "votes_guest_voters_guest_voter",
+				Columns:    []*schema.Column{VotesColumns[5]},
+				RefColumns: []*schema.Column{GuestVotersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vote_user_id_poll_id_poll_option_id",
+				Unique:  true,
+				Columns: []*schema.Column{VotesColumns[4], VotesColumns[2], VotesColumns[3]},
+			},
+			{
+				Name:    "vote_guest_voter_id_poll_id_poll_option_id",
+				Unique:  true,
+				Columns: []*schema.Column{VotesColumns[5], VotesColumns[2], VotesColumns[3]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AnonymousBallotsTable,
+		AuditEventsTable,
+		BallotsTable,
+		BallotEntriesTable,
+		BallotLogEntriesTable,
+		EmailTokensTable,
+		GuestVotersTable,
+		OrganizationsTable,
+		OrganizationMembersTable,
+		ParticipationsTable,
 		PollsTable,
+		PollCollaboratorsTable,
+		PollMembersTable,
 		PollOptionsTable,
+		PollRevisionsTable,
+		PollTransitionsTable,
+		RecoveryCodesTable,
+		RefreshTokensTable,
+		SessionsTable,
+		ShareLinksTable,
 		UsersTable,
+		UserIdentitiesTable,
 		VotesTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
+	AnonymousBallotsTable.ForeignKeys[0].RefTable = PollsTable
+	BallotsTable.ForeignKeys[0].RefTable = PollsTable
+	BallotsTable.ForeignKeys[1].RefTable = UsersTable
+	BallotsTable.ForeignKeys[2].RefTable = GuestVotersTable
+	BallotEntriesTable.ForeignKeys[0].RefTable = BallotsTable
+	BallotEntriesTable.ForeignKeys[1].RefTable = PollOptionsTable
+	BallotLogEntriesTable.ForeignKeys[0].RefTable = PollsTable
+	EmailTokensTable.ForeignKeys[0].RefTable = UsersTable
+	GuestVotersTable.ForeignKeys[0].RefTable = PollsTable
+	OrganizationMembersTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OrganizationMembersTable.ForeignKeys[1].RefTable = UsersTable
+	ParticipationsTable.ForeignKeys[0].RefTable = PollsTable
+	ParticipationsTable.ForeignKeys[1].RefTable = UsersTable
+	ParticipationsTable.ForeignKeys[2].RefTable = GuestVotersTable
+	PollsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	PollCollaboratorsTable.ForeignKeys[0].RefTable = PollsTable
+	PollCollaboratorsTable.ForeignKeys[1].RefTable = UsersTable
+	PollMembersTable.ForeignKeys[0].RefTable = PollsTable
+	PollMembersTable.ForeignKeys[1].RefTable = UsersTable
 	PollOptionsTable.ForeignKeys[0].RefTable = PollsTable
+	PollTransitionsTable.ForeignKeys[0].RefTable = PollsTable
+	PollTransitionsTable.ForeignKeys[1].RefTable = UsersTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = SessionsTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ShareLinksTable.ForeignKeys[0].RefTable = PollsTable
+	ShareLinksTable.ForeignKeys[1].RefTable = UsersTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	VotesTable.ForeignKeys[0].RefTable = PollsTable
 	VotesTable.ForeignKeys[1].RefTable = PollOptionsTable
 	VotesTable.ForeignKeys[2].RefTable = UsersTable
+	VotesTable.ForeignKeys[3].RefTable = GuestVotersTable
 }
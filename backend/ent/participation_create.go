@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/participation"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ParticipationCreate is the builder for creating a Participation entity.
+type ParticipationCreate struct {
+	config
+	mutation *ParticipationMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *ParticipationCreate) SetPollID(v int) *ParticipationCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ParticipationCreate) SetUserID(v int) *ParticipationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *ParticipationCreate) SetNillableUserID(v *int) *ParticipationCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_c *ParticipationCreate) SetGuestVoterID(v int) *ParticipationCreate {
+	_c.mutation.SetGuestVoterID(v)
+	return _c
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_c *ParticipationCreate) SetNillableGuestVoterID(v *int) *ParticipationCreate {
+	if v != nil {
+		_c.SetGuestVoterID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ParticipationCreate) SetCreatedAt(v time.Time) *ParticipationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ParticipationCreate) SetNillableCreatedAt(v *time.Time) *ParticipationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *ParticipationCreate) SetPoll(v *Poll) *ParticipationCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ParticipationCreate) SetUser(v *User) *ParticipationCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_c *ParticipationCreate) SetGuestVoter(v *GuestVoter) *ParticipationCreate {
+	return _c.SetGuestVoterID(v.ID)
+}
+
+// Mutation returns the ParticipationMutation object of the builder.
+func (_c *ParticipationCreate) Mutation() *ParticipationMutation {
+	return _c.mutation
+}
+
+// Save creates the Participation in the database.
+func (_c *ParticipationCreate) Save(ctx context.Context) (*Participation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ParticipationCreate) SaveX(ctx context.Context) *Participation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ParticipationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ParticipationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ParticipationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := participation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ParticipationCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "Participation.poll_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Participation.created_at"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "Participation.poll"`)}
+	}
+	return nil
+}
+
+func (_c *ParticipationCreate) sqlSave(ctx context.Context) (*Participation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ParticipationCreate) createSpec() (*Participation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Participation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(participation.Table, sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(participation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.PollTable,
+			Columns: []string{participation.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.UserTable,
+			Columns: []string{participation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.GuestVoterTable,
+			Columns: []string{participation.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuestVoterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ParticipationCreateBulk is the builder for creating many Participation entities in bulk.
+type ParticipationCreateBulk struct {
+	config
+	err      error
+	builders []*ParticipationCreate
+}
+
+// Save creates the Participation entities in the database.
+func (_c *ParticipationCreateBulk) Save(ctx context.Context) ([]*Participation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Participation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ParticipationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ParticipationCreateBulk) SaveX(ctx context.Context) []*Participation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ParticipationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ParticipationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/participation"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ParticipationUpdate is the builder for updating Participation entities.
+type ParticipationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ParticipationMutation
+}
+
+// Where appends a list predicates to the ParticipationUpdate builder.
+func (_u *ParticipationUpdate) Where(ps ...predicate.Participation) *ParticipationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *ParticipationUpdate) SetPollID(v int) *ParticipationUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *ParticipationUpdate) SetNillablePollID(v *int) *ParticipationUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ParticipationUpdate) SetUserID(v int) *ParticipationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ParticipationUpdate) SetNillableUserID(v *int) *ParticipationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ParticipationUpdate) ClearUserID() *ParticipationUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_u *ParticipationUpdate) SetGuestVoterID(v int) *ParticipationUpdate {
+	_u.mutation.SetGuestVoterID(v)
+	return _u
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_u *ParticipationUpdate) SetNillableGuestVoterID(v *int) *ParticipationUpdate {
+	if v != nil {
+		_u.SetGuestVoterID(*v)
+	}
+	return _u
+}
+
+// ClearGuestVoterID clears the value of the "guest_voter_id" field.
+func (_u *ParticipationUpdate) ClearGuestVoterID() *ParticipationUpdate {
+	_u.mutation.ClearGuestVoterID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ParticipationUpdate) SetCreatedAt(v time.Time) *ParticipationUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ParticipationUpdate) SetNillableCreatedAt(v *time.Time) *ParticipationUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *ParticipationUpdate) SetPoll(v *Poll) *ParticipationUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ParticipationUpdate) SetUser(v *User) *ParticipationUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_u *ParticipationUpdate) SetGuestVoter(v *GuestVoter) *ParticipationUpdate {
+	return _u.SetGuestVoterID(v.ID)
+}
+
+// Mutation returns the ParticipationMutation object of the builder.
+func (_u *ParticipationUpdate) Mutation() *ParticipationMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *ParticipationUpdate) ClearPoll() *ParticipationUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ParticipationUpdate) ClearUser() *ParticipationUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearGuestVoter clears the "guest_voter" edge to the GuestVoter entity.
+func (_u *ParticipationUpdate) ClearGuestVoter() *ParticipationUpdate {
+	_u.mutation.ClearGuestVoter()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ParticipationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ParticipationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ParticipationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ParticipationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ParticipationUpdate) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Participation.poll"`)
+	}
+	return nil
+}
+
+func (_u *ParticipationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(participation.Table, participation.Columns, sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(participation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.PollTable,
+			Columns: []string{participation.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.PollTable,
+			Columns: []string{participation.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.UserTable,
+			Columns: []string{participation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.UserTable,
+			Columns: []string{participation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GuestVoterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.GuestVoterTable,
+			Columns: []string{participation.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.GuestVoterTable,
+			Columns: []string{participation.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{participation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ParticipationUpdateOne is the builder for updating a single Participation entity.
+type ParticipationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ParticipationMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *ParticipationUpdateOne) SetPollID(v int) *ParticipationUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *ParticipationUpdateOne) SetNillablePollID(v *int) *ParticipationUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ParticipationUpdateOne) SetUserID(v int) *ParticipationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ParticipationUpdateOne) SetNillableUserID(v *int) *ParticipationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ParticipationUpdateOne) ClearUserID() *ParticipationUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_u *ParticipationUpdateOne) SetGuestVoterID(v int) *ParticipationUpdateOne {
+	_u.mutation.SetGuestVoterID(v)
+	return _u
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_u *ParticipationUpdateOne) SetNillableGuestVoterID(v *int) *ParticipationUpdateOne {
+	if v != nil {
+		_u.SetGuestVoterID(*v)
+	}
+	return _u
+}
+
+// ClearGuestVoterID clears the value of the "guest_voter_id" field.
+func (_u *ParticipationUpdateOne) ClearGuestVoterID() *ParticipationUpdateOne {
+	_u.mutation.ClearGuestVoterID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ParticipationUpdateOne) SetCreatedAt(v time.Time) *ParticipationUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ParticipationUpdateOne) SetNillableCreatedAt(v *time.Time) *ParticipationUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *ParticipationUpdateOne) SetPoll(v *Poll) *ParticipationUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ParticipationUpdateOne) SetUser(v *User) *ParticipationUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_u *ParticipationUpdateOne) SetGuestVoter(v *GuestVoter) *ParticipationUpdateOne {
+	return _u.SetGuestVoterID(v.ID)
+}
+
+// Mutation returns the ParticipationMutation object of the builder.
+func (_u *ParticipationUpdateOne) Mutation() *ParticipationMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *ParticipationUpdateOne) ClearPoll() *ParticipationUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ParticipationUpdateOne) ClearUser() *ParticipationUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearGuestVoter clears the "guest_voter" edge to the GuestVoter entity.
+func (_u *ParticipationUpdateOne) ClearGuestVoter() *ParticipationUpdateOne {
+	_u.mutation.ClearGuestVoter()
+	return _u
+}
+
+// Where appends a list predicates to the ParticipationUpdate builder.
+func (_u *ParticipationUpdateOne) Where(ps ...predicate.Participation) *ParticipationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ParticipationUpdateOne) Select(field string, fields ...string) *ParticipationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Participation entity.
+func (_u *ParticipationUpdateOne) Save(ctx context.Context) (*Participation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ParticipationUpdateOne) SaveX(ctx context.Context) *Participation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ParticipationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ParticipationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ParticipationUpdateOne) check() error {
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Participation.poll"`)
+	}
+	return nil
+}
+
+func (_u *ParticipationUpdateOne) sqlSave(ctx context.Context) (_node *Participation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(participation.Table, participation.Columns, sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Participation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, participation.FieldID)
+		for _, f := range fields {
+			if !participation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != participation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(participation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.PollTable,
+			Columns: []string{participation.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.PollTable,
+			Columns: []string{participation.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.UserTable,
+			Columns: []string{participation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.UserTable,
+			Columns: []string{participation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GuestVoterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.GuestVoterTable,
+			Columns: []string{participation.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   participation.GuestVoterTable,
+			Columns: []string{participation.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Participation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{participation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"pollapp/backend/ent/organization"
 	"pollapp/backend/ent/poll"
 	"strings"
 	"time"
@@ -23,6 +24,46 @@ type Poll struct {
 	Description string `json:"description,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
 	CreatedBy int `json:"created_by,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *int `json:"organization_id,omitempty"`
+	// MinChoices holds the value of the "min_choices" field.
+	MinChoices int `json:"min_choices,omitempty"`
+	// MaxChoices holds the value of the "max_choices" field.
+	MaxChoices int `json:"max_choices,omitempty"`
+	// VotingMethod holds the value of the "voting_method" field.
+	VotingMethod poll.VotingMethod `json:"voting_method,omitempty"`
+	// TallyRule holds the value of the "tally_rule" field.
+	TallyRule poll.TallyRule `json:"tally_rule,omitempty"`
+	// ScoreMin holds the value of the "score_min" field.
+	ScoreMin int `json:"score_min,omitempty"`
+	// ScoreMax holds the value of the "score_max" field.
+	ScoreMax int `json:"score_max,omitempty"`
+	// PassThreshold holds the value of the "pass_threshold" field.
+	PassThreshold poll.PassThreshold `json:"pass_threshold,omitempty"`
+	// PassPercentage holds the value of the "pass_percentage" field.
+	PassPercentage int `json:"pass_percentage,omitempty"`
+	// OpensAt holds the value of the "opens_at" field.
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// ClosesAt holds the value of the "closes_at" field.
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	// CloseEventAt holds the value of the "close_event_at" field.
+	CloseEventAt *time.Time `json:"-"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility poll.Visibility `json:"visibility,omitempty"`
+	// ResultsVisibility holds the value of the "results_visibility" field.
+	ResultsVisibility poll.ResultsVisibility `json:"results_visibility,omitempty"`
+	// Anonymous holds the value of the "anonymous" field.
+	Anonymous bool `json:"anonymous,omitempty"`
+	// AllowGuests holds the value of the "allow_guests" field.
+	AllowGuests bool `json:"allow_guests,omitempty"`
+	// GuestDedupe holds the value of the "guest_dedupe" field.
+	GuestDedupe poll.GuestDedupe `json:"guest_dedupe,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -33,19 +74,50 @@ type Poll struct {
 
 // PollEdges holds the relations/edges for other nodes in the graph.
 type PollEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Options holds the value of the options edge.
 	Options []*PollOption `json:"options,omitempty"`
 	// Votes holds the value of the votes edge.
 	Votes []*Vote `json:"votes,omitempty"`
+	// Ballots holds the value of the ballots edge.
+	Ballots []*Ballot `json:"ballots,omitempty"`
+	// Transitions holds the value of the transitions edge.
+	Transitions []*PollTransition `json:"transitions,omitempty"`
+	// Participations holds the value of the participations edge.
+	Participations []*Participation `json:"participations,omitempty"`
+	// AnonymousBallots holds the value of the anonymous_ballots edge.
+	AnonymousBallots []*AnonymousBallot `json:"anonymous_ballots,omitempty"`
+	// BallotLog holds the value of the ballot_log edge.
+	BallotLog []*BallotLogEntry `json:"ballot_log,omitempty"`
+	// GuestVoters holds the value of the guest_voters edge.
+	GuestVoters []*GuestVoter `json:"guest_voters,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*PollMember `json:"members,omitempty"`
+	// Collaborators holds the value of the collaborators edge.
+	Collaborators []*PollCollaborator `json:"collaborators,omitempty"`
+	// ShareLinks holds the value of the share_links edge.
+	ShareLinks []*ShareLink `json:"share_links,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [12]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // OptionsOrErr returns the Options value or an error if the edge
 // was not loaded in eager-loading.
 func (e PollEdges) OptionsOrErr() ([]*PollOption, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Options, nil
 	}
 	return nil, &NotLoadedError{edge: "options"}
@@ -54,22 +126,105 @@ func (e PollEdges) OptionsOrErr() ([]*PollOption, error) {
 // VotesOrErr returns the Votes value or an error if the edge
 // was not loaded in eager-loading.
 func (e PollEdges) VotesOrErr() ([]*Vote, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Votes, nil
 	}
 	return nil, &NotLoadedError{edge: "votes"}
 }
 
+// BallotsOrErr returns the Ballots value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) BallotsOrErr() ([]*Ballot, error) {
+	if e.loadedTypes[3] {
+		return e.Ballots, nil
+	}
+	return nil, &NotLoadedError{edge: "ballots"}
+}
+
+// TransitionsOrErr returns the Transitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) TransitionsOrErr() ([]*PollTransition, error) {
+	if e.loadedTypes[4] {
+		return e.Transitions, nil
+	}
+	return nil, &NotLoadedError{edge: "transitions"}
+}
+
+// ParticipationsOrErr returns the Participations value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) ParticipationsOrErr() ([]*Participation, error) {
+	if e.loadedTypes[5] {
+		return e.Participations, nil
+	}
+	return nil, &NotLoadedError{edge: "participations"}
+}
+
+// AnonymousBallotsOrErr returns the AnonymousBallots value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) AnonymousBallotsOrErr() ([]*AnonymousBallot, error) {
+	if e.loadedTypes[6] {
+		return e.AnonymousBallots, nil
+	}
+	return nil, &NotLoadedError{edge: "anonymous_ballots"}
+}
+
+// BallotLogOrErr returns the BallotLog value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) BallotLogOrErr() ([]*BallotLogEntry, error) {
+	if e.loadedTypes[7] {
+		return e.BallotLog, nil
+	}
+	return nil, &NotLoadedError{edge: "ballot_log"}
+}
+
+// GuestVotersOrErr returns the GuestVoters value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) GuestVotersOrErr() ([]*GuestVoter, error) {
+	if e.loadedTypes[8] {
+		return e.GuestVoters, nil
+	}
+	return nil, &NotLoadedError{edge: "guest_voters"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) MembersOrErr() ([]*PollMember, error) {
+	if e.loadedTypes[9] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// CollaboratorsOrErr returns the Collaborators value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) CollaboratorsOrErr() ([]*PollCollaborator, error) {
+	if e.loadedTypes[10] {
+		return e.Collaborators, nil
+	}
+	return nil, &NotLoadedError{edge: "collaborators"}
+}
+
+// ShareLinksOrErr returns the ShareLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) ShareLinksOrErr() ([]*ShareLink, error) {
+	if e.loadedTypes[11] {
+		return e.ShareLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "share_links"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Poll) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case poll.FieldID, poll.FieldCreatedBy:
+		case poll.FieldAnonymous, poll.FieldAllowGuests:
+			values[i] = new(sql.NullBool)
+		case poll.FieldID, poll.FieldCreatedBy, poll.FieldOrganizationID, poll.FieldMinChoices, poll.FieldMaxChoices, poll.FieldScoreMin, poll.FieldScoreMax, poll.FieldPassPercentage, poll.FieldRevision:
 			values[i] = new(sql.NullInt64)
-		case poll.FieldTitle, poll.FieldDescription:
+		case poll.FieldTitle, poll.FieldDescription, poll.FieldVotingMethod, poll.FieldTallyRule, poll.FieldPassThreshold, poll.FieldVisibility, poll.FieldResultsVisibility, poll.FieldGuestDedupe:
 			values[i] = new(sql.NullString)
-		case poll.FieldCreatedAt:
+		case poll.FieldOpensAt, poll.FieldClosesAt, poll.FieldCloseEventAt, poll.FieldClosedAt, poll.FieldArchivedAt, poll.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -110,6 +265,132 @@ func (_m *Poll) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedBy = int(value.Int64)
 			}
+		case poll.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int)
+				*_m.OrganizationID = int(value.Int64)
+			}
+		case poll.FieldMinChoices:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_choices", values[i])
+			} else if value.Valid {
+				_m.MinChoices = int(value.Int64)
+			}
+		case poll.FieldMaxChoices:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_choices", values[i])
+			} else if value.Valid {
+				_m.MaxChoices = int(value.Int64)
+			}
+		case poll.FieldVotingMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field voting_method", values[i])
+			} else if value.Valid {
+				_m.VotingMethod = poll.VotingMethod(value.String)
+			}
+		case poll.FieldTallyRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tally_rule", values[i])
+			} else if value.Valid {
+				_m.TallyRule = poll.TallyRule(value.String)
+			}
+		case poll.FieldScoreMin:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score_min", values[i])
+			} else if value.Valid {
+				_m.ScoreMin = int(value.Int64)
+			}
+		case poll.FieldScoreMax:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score_max", values[i])
+			} else if value.Valid {
+				_m.ScoreMax = int(value.Int64)
+			}
+		case poll.FieldPassThreshold:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pass_threshold", values[i])
+			} else if value.Valid {
+				_m.PassThreshold = poll.PassThreshold(value.String)
+			}
+		case poll.FieldPassPercentage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pass_percentage", values[i])
+			} else if value.Valid {
+				_m.PassPercentage = int(value.Int64)
+			}
+		case poll.FieldOpensAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opens_at", values[i])
+			} else if value.Valid {
+				_m.OpensAt = new(time.Time)
+				*_m.OpensAt = value.Time
+			}
+		case poll.FieldClosesAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closes_at", values[i])
+			} else if value.Valid {
+				_m.ClosesAt = new(time.Time)
+				*_m.ClosesAt = value.Time
+			}
+		case poll.FieldCloseEventAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field close_event_at", values[i])
+			} else if value.Valid {
+				_m.CloseEventAt = new(time.Time)
+				*_m.CloseEventAt = value.Time
+			}
+		case poll.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case poll.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
+		case poll.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = poll.Visibility(value.String)
+			}
+		case poll.FieldResultsVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field results_visibility", values[i])
+			} else if value.Valid {
+				_m.ResultsVisibility = poll.ResultsVisibility(value.String)
+			}
+		case poll.FieldAnonymous:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anonymous", values[i])
+			} else if value.Valid {
+				_m.Anonymous = value.Bool
+			}
+		case poll.FieldAllowGuests:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allow_guests", values[i])
+			} else if value.Valid {
+				_m.AllowGuests = value.Bool
+			}
+		case poll.FieldGuestDedupe:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_dedupe", values[i])
+			} else if value.Valid {
+				_m.GuestDedupe = poll.GuestDedupe(value.String)
+			}
+		case poll.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
 		case poll.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -129,6 +410,11 @@ func (_m *Poll) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the Poll entity.
+func (_m *Poll) QueryOrganization() *OrganizationQuery {
+	return NewPollClient(_m.config).QueryOrganization(_m)
+}
+
 // QueryOptions queries the "options" edge of the Poll entity.
 func (_m *Poll) QueryOptions() *PollOptionQuery {
 	return NewPollClient(_m.config).QueryOptions(_m)
@@ -139,6 +425,51 @@ func (_m *Poll) QueryVotes() *VoteQuery {
 	return NewPollClient(_m.config).QueryVotes(_m)
 }
 
+// QueryBallots queries the "ballots" edge of the Poll entity.
+func (_m *Poll) QueryBallots() *BallotQuery {
+	return NewPollClient(_m.config).QueryBallots(_m)
+}
+
+// QueryTransitions queries the "transitions" edge of the Poll entity.
+func (_m *Poll) QueryTransitions() *PollTransitionQuery {
+	return NewPollClient(_m.config).QueryTransitions(_m)
+}
+
+// QueryParticipations queries the "participations" edge of the Poll entity.
+func (_m *Poll) QueryParticipations() *ParticipationQuery {
+	return NewPollClient(_m.config).QueryParticipations(_m)
+}
+
+// QueryAnonymousBallots queries the "anonymous_ballots" edge of the Poll entity.
+func (_m *Poll) QueryAnonymousBallots() *AnonymousBallotQuery {
+	return NewPollClient(_m.config).QueryAnonymousBallots(_m)
+}
+
+// QueryBallotLog queries the "ballot_log" edge of the Poll entity.
+func (_m *Poll) QueryBallotLog() *BallotLogEntryQuery {
+	return NewPollClient(_m.config).QueryBallotLog(_m)
+}
+
+// QueryGuestVoters queries the "guest_voters" edge of the Poll entity.
+func (_m *Poll) QueryGuestVoters() *GuestVoterQuery {
+	return NewPollClient(_m.config).QueryGuestVoters(_m)
+}
+
+// QueryMembers queries the "members" edge of the Poll entity.
+func (_m *Poll) QueryMembers() *PollMemberQuery {
+	return NewPollClient(_m.config).QueryMembers(_m)
+}
+
+// QueryCollaborators queries the "collaborators" edge of the Poll entity.
+func (_m *Poll) QueryCollaborators() *PollCollaboratorQuery {
+	return NewPollClient(_m.config).QueryCollaborators(_m)
+}
+
+// QueryShareLinks queries the "share_links" edge of the Poll entity.
+func (_m *Poll) QueryShareLinks() *ShareLinkQuery {
+	return NewPollClient(_m.config).QueryShareLinks(_m)
+}
+
 // Update returns a builder for updating this Poll.
 // Note that you need to call Poll.Unwrap() before calling this method if this Poll
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,6 +502,78 @@ func (_m *Poll) String() string {
 	builder.WriteString("created_by=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
 	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("min_choices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinChoices))
+	builder.WriteString(", ")
+	builder.WriteString("max_choices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxChoices))
+	builder.WriteString(", ")
+	builder.WriteString("voting_method=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VotingMethod))
+	builder.WriteString(", ")
+	builder.WriteString("tally_rule=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TallyRule))
+	builder.WriteString(", ")
+	builder.WriteString("score_min=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScoreMin))
+	builder.WriteString(", ")
+	builder.WriteString("score_max=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScoreMax))
+	builder.WriteString(", ")
+	builder.WriteString("pass_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PassThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("pass_percentage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PassPercentage))
+	builder.WriteString(", ")
+	if v := _m.OpensAt; v != nil {
+		builder.WriteString("opens_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosesAt; v != nil {
+		builder.WriteString("closes_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CloseEventAt; v != nil {
+		builder.WriteString("close_event_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("results_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResultsVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("anonymous=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Anonymous))
+	builder.WriteString(", ")
+	builder.WriteString("allow_guests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowGuests))
+	builder.WriteString(", ")
+	builder.WriteString("guest_dedupe=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GuestDedupe))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -3,6 +3,7 @@
 package poll
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -20,14 +21,81 @@ const (
 	FieldDescription = "description"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldMinChoices holds the string denoting the min_choices field in the database.
+	FieldMinChoices = "min_choices"
+	// FieldMaxChoices holds the string denoting the max_choices field in the database.
+	FieldMaxChoices = "max_choices"
+	// FieldVotingMethod holds the string denoting the voting_method field in the database.
+	FieldVotingMethod = "voting_method"
+	// FieldTallyRule holds the string denoting the tally_rule field in the database.
+	FieldTallyRule = "tally_rule"
+	// FieldScoreMin holds the string denoting the score_min field in the database.
+	FieldScoreMin = "score_min"
+	// FieldScoreMax holds the string denoting the score_max field in the database.
+	FieldScoreMax = "score_max"
+	// FieldPassThreshold holds the string denoting the pass_threshold field in the database.
+	FieldPassThreshold = "pass_threshold"
+	// FieldPassPercentage holds the string denoting the pass_percentage field in the database.
+	FieldPassPercentage = "pass_percentage"
+	// FieldOpensAt holds the string denoting the opens_at field in the database.
+	FieldOpensAt = "opens_at"
+	// FieldClosesAt holds the string denoting the closes_at field in the database.
+	FieldClosesAt = "closes_at"
+	// FieldCloseEventAt holds the string denoting the close_event_at field in the database.
+	FieldCloseEventAt = "close_event_at"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldResultsVisibility holds the string denoting the results_visibility field in the database.
+	FieldResultsVisibility = "results_visibility"
+	// FieldAnonymous holds the string denoting the anonymous field in the database.
+	FieldAnonymous = "anonymous"
+	// FieldAllowGuests holds the string denoting the allow_guests field in the database.
+	FieldAllowGuests = "allow_guests"
+	// FieldGuestDedupe holds the string denoting the guest_dedupe field in the database.
+	FieldGuestDedupe = "guest_dedupe"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
 	// EdgeOptions holds the string denoting the options edge name in mutations.
 	EdgeOptions = "options"
 	// EdgeVotes holds the string denoting the votes edge name in mutations.
 	EdgeVotes = "votes"
+	// EdgeBallots holds the string denoting the ballots edge name in mutations.
+	EdgeBallots = "ballots"
+	// EdgeTransitions holds the string denoting the transitions edge name in mutations.
+	EdgeTransitions = "transitions"
+	// EdgeParticipations holds the string denoting the participations edge name in mutations.
+	EdgeParticipations = "participations"
+	// EdgeAnonymousBallots holds the string denoting the anonymous_ballots edge name in mutations.
+	EdgeAnonymousBallots = "anonymous_ballots"
+	// EdgeBallotLog holds the string denoting the ballot_log edge name in mutations.
+	EdgeBallotLog = "ballot_log"
+	// EdgeGuestVoters holds the string denoting the guest_voters edge name in mutations.
+	EdgeGuestVoters = "guest_voters"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeCollaborators holds the string denoting the collaborators edge name in mutations.
+	EdgeCollaborators = "collaborators"
+	// EdgeShareLinks holds the string denoting the share_links edge name in mutations.
+	EdgeShareLinks = "share_links"
 	// Table holds the table name of the poll in the database.
 	Table = "polls"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "polls"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
 	// OptionsTable is the table that holds the options relation/edge.
 	OptionsTable = "poll_options"
 	// OptionsInverseTable is the table name for the PollOption entity.
@@ -42,6 +110,69 @@ const (
 	VotesInverseTable = "votes"
 	// VotesColumn is the table column denoting the votes relation/edge.
 	VotesColumn = "poll_id"
+	// BallotsTable is the table that holds the ballots relation/edge.
+	BallotsTable = "ballots"
+	// BallotsInverseTable is the table name for the Ballot entity.
+	// It exists in this package in order to avoid circular dependency with the "ballot" package.
+	BallotsInverseTable = "ballots"
+	// BallotsColumn is the table column denoting the ballots relation/edge.
+	BallotsColumn = "poll_id"
+	// TransitionsTable is the table that holds the transitions relation/edge.
+	TransitionsTable = "poll_transitions"
+	// TransitionsInverseTable is the table name for the PollTransition entity.
+	// It exists in this package in order to avoid circular dependency with the "polltransition" package.
+	TransitionsInverseTable = "poll_transitions"
+	// TransitionsColumn is the table column denoting the transitions relation/edge.
+	TransitionsColumn = "poll_id"
+	// ParticipationsTable is the table that holds the participations relation/edge.
+	ParticipationsTable = "participations"
+	// ParticipationsInverseTable is the table name for the Participation entity.
+	// It exists in this package in order to avoid circular dependency with the "participation" package.
+	ParticipationsInverseTable = "participations"
+	// ParticipationsColumn is the table column denoting the participations relation/edge.
+	ParticipationsColumn = "poll_id"
+	// AnonymousBallotsTable is the table that holds the anonymous_ballots relation/edge.
+	AnonymousBallotsTable = "anonymous_ballots"
+	// AnonymousBallotsInverseTable is the table name for the AnonymousBallot entity.
+	// It exists in this package in order to avoid circular dependency with the "anonymousballot" package.
+	AnonymousBallotsInverseTable = "anonymous_ballots"
+	// AnonymousBallotsColumn is the table column denoting the anonymous_ballots relation/edge.
+	AnonymousBallotsColumn = "poll_id"
+	// BallotLogTable is the table that holds the ballot_log relation/edge.
+	BallotLogTable = "ballot_log_entries"
+	// BallotLogInverseTable is the table name for the BallotLogEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ballotlogentry" package.
+	BallotLogInverseTable = "ballot_log_entries"
+	// BallotLogColumn is the table column denoting the ballot_log relation/edge.
+	BallotLogColumn = "poll_id"
+	// GuestVotersTable is the table that holds the guest_voters relation/edge.
+	GuestVotersTable = "guest_voters"
+	// GuestVotersInverseTable is the table name for the GuestVoter entity.
+	// It exists in this package in order to avoid circular dependency with the "guestvoter" package.
+	GuestVotersInverseTable = "guest_voters"
+	// GuestVotersColumn is the table column denoting the guest_voters relation/edge.
+	GuestVotersColumn = "poll_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "poll_members"
+	// MembersInverseTable is the table name for the PollMember entity.
+	// It exists in this package in order to avoid circular dependency with the "pollmember" package.
+	MembersInverseTable = "poll_members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "poll_id"
+	// CollaboratorsTable is the table that holds the collaborators relation/edge.
+	CollaboratorsTable = "poll_collaborators"
+	// CollaboratorsInverseTable is the table name for the PollCollaborator entity.
+	// It exists in this package in order to avoid circular dependency with the "pollcollaborator" package.
+	CollaboratorsInverseTable = "poll_collaborators"
+	// CollaboratorsColumn is the table column denoting the collaborators relation/edge.
+	CollaboratorsColumn = "poll_id"
+	// ShareLinksTable is the table that holds the share_links relation/edge.
+	ShareLinksTable = "share_links"
+	// ShareLinksInverseTable is the table name for the ShareLink entity.
+	// It exists in this package in order to avoid circular dependency with the "sharelink" package.
+	ShareLinksInverseTable = "share_links"
+	// ShareLinksColumn is the table column denoting the share_links relation/edge.
+	ShareLinksColumn = "poll_id"
 )
 
 // Columns holds all SQL columns for poll fields.
@@ -50,6 +181,26 @@ var Columns = []string{
 	FieldTitle,
 	FieldDescription,
 	FieldCreatedBy,
+	FieldOrganizationID,
+	FieldMinChoices,
+	FieldMaxChoices,
+	FieldVotingMethod,
+	FieldTallyRule,
+	FieldScoreMin,
+	FieldScoreMax,
+	FieldPassThreshold,
+	FieldPassPercentage,
+	FieldOpensAt,
+	FieldClosesAt,
+	FieldCloseEventAt,
+	FieldClosedAt,
+	FieldArchivedAt,
+	FieldVisibility,
+	FieldResultsVisibility,
+	FieldAnonymous,
+	FieldAllowGuests,
+	FieldGuestDedupe,
+	FieldRevision,
 	FieldCreatedAt,
 }
 
@@ -64,10 +215,190 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultMinChoices holds the default value on creation for the "min_choices" field.
+	DefaultMinChoices int
+	// DefaultMaxChoices holds the default value on creation for the "max_choices" field.
+	DefaultMaxChoices int
+	// DefaultScoreMin holds the default value on creation for the "score_min" field.
+	DefaultScoreMin int
+	// DefaultScoreMax holds the default value on creation for the "score_max" field.
+	DefaultScoreMax int
+	// DefaultPassPercentage holds the default value on creation for the "pass_percentage" field.
+	DefaultPassPercentage int
+	// DefaultAnonymous holds the default value on creation for the "anonymous" field.
+	DefaultAnonymous bool
+	// DefaultAllowGuests holds the default value on creation for the "allow_guests" field.
+	DefaultAllowGuests bool
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
 
+// VotingMethod defines the type for the "voting_method" enum field.
+type VotingMethod string
+
+// VotingMethodPlurality is the default value of the VotingMethod enum.
+const DefaultVotingMethod = VotingMethodPlurality
+
+// VotingMethod values.
+const (
+	VotingMethodPlurality VotingMethod = "plurality"
+	VotingMethodRanked    VotingMethod = "ranked"
+	VotingMethodScore     VotingMethod = "score"
+	VotingMethodStar      VotingMethod = "star"
+	VotingMethodApproval  VotingMethod = "approval"
+	VotingMethodProposal  VotingMethod = "proposal"
+)
+
+func (vm VotingMethod) String() string {
+	return string(vm)
+}
+
+// VotingMethodValidator is a validator for the "voting_method" field enum values. It is called by the builders before save.
+func VotingMethodValidator(vm VotingMethod) error {
+	switch vm {
+	case VotingMethodPlurality, VotingMethodRanked, VotingMethodScore, VotingMethodStar, VotingMethodApproval, VotingMethodProposal:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for voting_method field: %q", vm)
+	}
+}
+
+// TallyRule defines the type for the "tally_rule" enum field.
+type TallyRule string
+
+// TallyRuleInstantRunoff is the default value of the TallyRule enum.
+const DefaultTallyRule = TallyRuleInstantRunoff
+
+// TallyRule values.
+const (
+	TallyRuleInstantRunoff TallyRule = "instant_runoff"
+	TallyRuleCondorcet     TallyRule = "condorcet"
+)
+
+func (tr TallyRule) String() string {
+	return string(tr)
+}
+
+// TallyRuleValidator is a validator for the "tally_rule" field enum values. It is called by the builders before save.
+func TallyRuleValidator(tr TallyRule) error {
+	switch tr {
+	case TallyRuleInstantRunoff, TallyRuleCondorcet:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for tally_rule field: %q", tr)
+	}
+}
+
+// PassThreshold defines the type for the "pass_threshold" enum field.
+type PassThreshold string
+
+// PassThresholdSimpleMajority is the default value of the PassThreshold enum.
+const DefaultPassThreshold = PassThresholdSimpleMajority
+
+// PassThreshold values.
+const (
+	PassThresholdSimpleMajority PassThreshold = "simple_majority"
+	PassThresholdTwoThirds      PassThreshold = "two_thirds"
+	PassThresholdPercentage     PassThreshold = "percentage"
+)
+
+func (pt PassThreshold) String() string {
+	return string(pt)
+}
+
+// PassThresholdValidator is a validator for the "pass_threshold" field enum values. It is called by the builders before save.
+func PassThresholdValidator(pt PassThreshold) error {
+	switch pt {
+	case PassThresholdSimpleMajority, PassThresholdTwoThirds, PassThresholdPercentage:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for pass_threshold field: %q", pt)
+	}
+}
+
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityPublic is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityPublic
+
+// Visibility values.
+const (
+	VisibilityPublic   Visibility = "public"
+	VisibilityUnlisted Visibility = "unlisted"
+	VisibilityPrivate  Visibility = "private"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for visibility field: %q", v)
+	}
+}
+
+// ResultsVisibility defines the type for the "results_visibility" enum field.
+type ResultsVisibility string
+
+// ResultsVisibilityAlways is the default value of the ResultsVisibility enum.
+const DefaultResultsVisibility = ResultsVisibilityAlways
+
+// ResultsVisibility values.
+const (
+	ResultsVisibilityAlways     ResultsVisibility = "always"
+	ResultsVisibilityAfterVote  ResultsVisibility = "after_vote"
+	ResultsVisibilityAfterClose ResultsVisibility = "after_close"
+	ResultsVisibilityOwnerOnly  ResultsVisibility = "owner_only"
+)
+
+func (rv ResultsVisibility) String() string {
+	return string(rv)
+}
+
+// ResultsVisibilityValidator is a validator for the "results_visibility" field enum values. It is called by the builders before save.
+func ResultsVisibilityValidator(rv ResultsVisibility) error {
+	switch rv {
+	case ResultsVisibilityAlways, ResultsVisibilityAfterVote, ResultsVisibilityAfterClose, ResultsVisibilityOwnerOnly:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for results_visibility field: %q", rv)
+	}
+}
+
+// GuestDedupe defines the type for the "guest_dedupe" enum field.
+type GuestDedupe string
+
+// GuestDedupeToken is the default value of the GuestDedupe enum.
+const DefaultGuestDedupe = GuestDedupeToken
+
+// GuestDedupe values.
+const (
+	GuestDedupeToken   GuestDedupe = "token"
+	GuestDedupeTokenIP GuestDedupe = "token_ip"
+)
+
+func (gd GuestDedupe) String() string {
+	return string(gd)
+}
+
+// GuestDedupeValidator is a validator for the "guest_dedupe" field enum values. It is called by the builders before save.
+func GuestDedupeValidator(gd GuestDedupe) error {
+	switch gd {
+	case GuestDedupeToken, GuestDedupeTokenIP:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for guest_dedupe field: %q", gd)
+	}
+}
+
 // OrderOption defines the ordering options for the Poll queries.
 type OrderOption func(*sql.Selector)
 
@@ -91,11 +422,118 @@ func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
 }
 
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByMinChoices orders the results by the min_choices field.
+func ByMinChoices(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinChoices, opts...).ToFunc()
+}
+
+// ByMaxChoices orders the results by the max_choices field.
+func ByMaxChoices(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxChoices, opts...).ToFunc()
+}
+
+// ByVotingMethod orders the results by the voting_method field.
+func ByVotingMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVotingMethod, opts...).ToFunc()
+}
+
+// ByTallyRule orders the results by the tally_rule field.
+func ByTallyRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTallyRule, opts...).ToFunc()
+}
+
+// ByScoreMin orders the results by the score_min field.
+func ByScoreMin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScoreMin, opts...).ToFunc()
+}
+
+// ByScoreMax orders the results by the score_max field.
+func ByScoreMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScoreMax, opts...).ToFunc()
+}
+
+// ByPassThreshold orders the results by the pass_threshold field.
+func ByPassThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassThreshold, opts...).ToFunc()
+}
+
+// ByPassPercentage orders the results by the pass_percentage field.
+func ByPassPercentage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassPercentage, opts...).ToFunc()
+}
+
+// ByOpensAt orders the results by the opens_at field.
+func ByOpensAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpensAt, opts...).ToFunc()
+}
+
+// ByClosesAt orders the results by the closes_at field.
+func ByClosesAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosesAt, opts...).ToFunc()
+}
+
+// ByCloseEventAt orders the results by the close_event_at field.
+func ByCloseEventAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloseEventAt, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByResultsVisibility orders the results by the results_visibility field.
+func ByResultsVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResultsVisibility, opts...).ToFunc()
+}
+
+// ByAnonymous orders the results by the anonymous field.
+func ByAnonymous(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnonymous, opts...).ToFunc()
+}
+
+// ByAllowGuests orders the results by the allow_guests field.
+func ByAllowGuests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowGuests, opts...).ToFunc()
+}
+
+// ByGuestDedupe orders the results by the guest_dedupe field.
+func ByGuestDedupe(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestDedupe, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByOptionsCount orders the results by options count.
 func ByOptionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -123,6 +561,139 @@ func ByVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBallotsCount orders the results by ballots count.
+func ByBallotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBallotsStep(), opts...)
+	}
+}
+
+// ByBallots orders the results by ballots terms.
+func ByBallots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBallotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransitionsCount orders the results by transitions count.
+func ByTransitionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransitionsStep(), opts...)
+	}
+}
+
+// ByTransitions orders the results by transitions terms.
+func ByTransitions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransitionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParticipationsCount orders the results by participations count.
+func ByParticipationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newParticipationsStep(), opts...)
+	}
+}
+
+// ByParticipations orders the results by participations terms.
+func ByParticipations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParticipationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAnonymousBallotsCount orders the results by anonymous_ballots count.
+func ByAnonymousBallotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnonymousBallotsStep(), opts...)
+	}
+}
+
+// ByAnonymousBallots orders the results by anonymous_ballots terms.
+func ByAnonymousBallots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnonymousBallotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBallotLogCount orders the results by ballot_log count.
+func ByBallotLogCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBallotLogStep(), opts...)
+	}
+}
+
+// ByBallotLog orders the results by ballot_log terms.
+func ByBallotLog(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBallotLogStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGuestVotersCount orders the results by guest_voters count.
+func ByGuestVotersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGuestVotersStep(), opts...)
+	}
+}
+
+// ByGuestVoters orders the results by guest_voters terms.
+func ByGuestVoters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGuestVotersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCollaboratorsCount orders the results by collaborators count.
+func ByCollaboratorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCollaboratorsStep(), opts...)
+	}
+}
+
+// ByCollaborators orders the results by collaborators terms.
+func ByCollaborators(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollaboratorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShareLinksCount orders the results by share_links count.
+func ByShareLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareLinksStep(), opts...)
+	}
+}
+
+// ByShareLinks orders the results by share_links terms.
+func ByShareLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+	)
+}
 func newOptionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -137,3 +708,66 @@ func newVotesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, VotesTable, VotesColumn),
 	)
 }
+func newBallotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BallotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BallotsTable, BallotsColumn),
+	)
+}
+func newTransitionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransitionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, TransitionsTable, TransitionsColumn),
+	)
+}
+func newParticipationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParticipationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ParticipationsTable, ParticipationsColumn),
+	)
+}
+func newAnonymousBallotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnonymousBallotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, AnonymousBallotsTable, AnonymousBallotsColumn),
+	)
+}
+func newBallotLogStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BallotLogInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BallotLogTable, BallotLogColumn),
+	)
+}
+func newGuestVotersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GuestVotersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, GuestVotersTable, GuestVotersColumn),
+	)
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MembersTable, MembersColumn),
+	)
+}
+func newCollaboratorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollaboratorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, CollaboratorsTable, CollaboratorsColumn),
+	)
+}
+func newShareLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ShareLinksTable, ShareLinksColumn),
+	)
+}
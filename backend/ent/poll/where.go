@@ -70,6 +70,76 @@ func CreatedBy(v int) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldCreatedBy, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// MinChoices applies equality check predicate on the "min_choices" field. It's identical to MinChoicesEQ.
+func MinChoices(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMinChoices, v))
+}
+
+// MaxChoices applies equality check predicate on the "max_choices" field. It's identical to MaxChoicesEQ.
+func MaxChoices(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMaxChoices, v))
+}
+
+// ScoreMin applies equality check predicate on the "score_min" field. It's identical to ScoreMinEQ.
+func ScoreMin(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldScoreMin, v))
+}
+
+// ScoreMax applies equality check predicate on the "score_max" field. It's identical to ScoreMaxEQ.
+func ScoreMax(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldScoreMax, v))
+}
+
+// PassPercentage applies equality check predicate on the "pass_percentage" field. It's identical to PassPercentageEQ.
+func PassPercentage(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldPassPercentage, v))
+}
+
+// OpensAt applies equality check predicate on the "opens_at" field. It's identical to OpensAtEQ.
+func OpensAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOpensAt, v))
+}
+
+// ClosesAt applies equality check predicate on the "closes_at" field. It's identical to ClosesAtEQ.
+func ClosesAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosesAt, v))
+}
+
+// CloseEventAt applies equality check predicate on the "close_event_at" field. It's identical to CloseEventAtEQ.
+func CloseEventAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldCloseEventAt, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// Anonymous applies equality check predicate on the "anonymous" field. It's identical to AnonymousEQ.
+func Anonymous(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldAnonymous, v))
+}
+
+// AllowGuests applies equality check predicate on the "allow_guests" field. It's identical to AllowGuestsEQ.
+func AllowGuests(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldAllowGuests, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldRevision, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldCreatedAt, v))
@@ -245,6 +315,666 @@ func CreatedByLTE(v int) predicate.Poll {
 	return predicate.Poll(sql.FieldLTE(FieldCreatedBy, v))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// MinChoicesEQ applies the EQ predicate on the "min_choices" field.
+func MinChoicesEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMinChoices, v))
+}
+
+// MinChoicesNEQ applies the NEQ predicate on the "min_choices" field.
+func MinChoicesNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldMinChoices, v))
+}
+
+// MinChoicesIn applies the In predicate on the "min_choices" field.
+func MinChoicesIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldMinChoices, vs...))
+}
+
+// MinChoicesNotIn applies the NotIn predicate on the "min_choices" field.
+func MinChoicesNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldMinChoices, vs...))
+}
+
+// MinChoicesGT applies the GT predicate on the "min_choices" field.
+func MinChoicesGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldMinChoices, v))
+}
+
+// MinChoicesGTE applies the GTE predicate on the "min_choices" field.
+func MinChoicesGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldMinChoices, v))
+}
+
+// MinChoicesLT applies the LT predicate on the "min_choices" field.
+func MinChoicesLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldMinChoices, v))
+}
+
+// MinChoicesLTE applies the LTE predicate on the "min_choices" field.
+func MinChoicesLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldMinChoices, v))
+}
+
+// MaxChoicesEQ applies the EQ predicate on the "max_choices" field.
+func MaxChoicesEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMaxChoices, v))
+}
+
+// MaxChoicesNEQ applies the NEQ predicate on the "max_choices" field.
+func MaxChoicesNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldMaxChoices, v))
+}
+
+// MaxChoicesIn applies the In predicate on the "max_choices" field.
+func MaxChoicesIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldMaxChoices, vs...))
+}
+
+// MaxChoicesNotIn applies the NotIn predicate on the "max_choices" field.
+func MaxChoicesNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldMaxChoices, vs...))
+}
+
+// MaxChoicesGT applies the GT predicate on the "max_choices" field.
+func MaxChoicesGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldMaxChoices, v))
+}
+
+// MaxChoicesGTE applies the GTE predicate on the "max_choices" field.
+func MaxChoicesGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldMaxChoices, v))
+}
+
+// MaxChoicesLT applies the LT predicate on the "max_choices" field.
+func MaxChoicesLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldMaxChoices, v))
+}
+
+// MaxChoicesLTE applies the LTE predicate on the "max_choices" field.
+func MaxChoicesLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldMaxChoices, v))
+}
+
+// VotingMethodEQ applies the EQ predicate on the "voting_method" field.
+func VotingMethodEQ(v VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldVotingMethod, v))
+}
+
+// VotingMethodNEQ applies the NEQ predicate on the "voting_method" field.
+func VotingMethodNEQ(v VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldVotingMethod, v))
+}
+
+// VotingMethodIn applies the In predicate on the "voting_method" field.
+func VotingMethodIn(vs ...VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldVotingMethod, vs...))
+}
+
+// VotingMethodNotIn applies the NotIn predicate on the "voting_method" field.
+func VotingMethodNotIn(vs ...VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldVotingMethod, vs...))
+}
+
+// TallyRuleEQ applies the EQ predicate on the "tally_rule" field.
+func TallyRuleEQ(v TallyRule) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldTallyRule, v))
+}
+
+// TallyRuleNEQ applies the NEQ predicate on the "tally_rule" field.
+func TallyRuleNEQ(v TallyRule) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldTallyRule, v))
+}
+
+// TallyRuleIn applies the In predicate on the "tally_rule" field.
+func TallyRuleIn(vs ...TallyRule) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldTallyRule, vs...))
+}
+
+// TallyRuleNotIn applies the NotIn predicate on the "tally_rule" field.
+func TallyRuleNotIn(vs ...TallyRule) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldTallyRule, vs...))
+}
+
+// ScoreMinEQ applies the EQ predicate on the "score_min" field.
+func ScoreMinEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldScoreMin, v))
+}
+
+// ScoreMinNEQ applies the NEQ predicate on the "score_min" field.
+func ScoreMinNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldScoreMin, v))
+}
+
+// ScoreMinIn applies the In predicate on the "score_min" field.
+func ScoreMinIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldScoreMin, vs...))
+}
+
+// ScoreMinNotIn applies the NotIn predicate on the "score_min" field.
+func ScoreMinNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldScoreMin, vs...))
+}
+
+// ScoreMinGT applies the GT predicate on the "score_min" field.
+func ScoreMinGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldScoreMin, v))
+}
+
+// ScoreMinGTE applies the GTE predicate on the "score_min" field.
+func ScoreMinGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldScoreMin, v))
+}
+
+// ScoreMinLT applies the LT predicate on the "score_min" field.
+func ScoreMinLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldScoreMin, v))
+}
+
+// ScoreMinLTE applies the LTE predicate on the "score_min" field.
+func ScoreMinLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldScoreMin, v))
+}
+
+// ScoreMaxEQ applies the EQ predicate on the "score_max" field.
+func ScoreMaxEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldScoreMax, v))
+}
+
+// ScoreMaxNEQ applies the NEQ predicate on the "score_max" field.
+func ScoreMaxNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldScoreMax, v))
+}
+
+// ScoreMaxIn applies the In predicate on the "score_max" field.
+func ScoreMaxIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldScoreMax, vs...))
+}
+
+// ScoreMaxNotIn applies the NotIn predicate on the "score_max" field.
+func ScoreMaxNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldScoreMax, vs...))
+}
+
+// ScoreMaxGT applies the GT predicate on the "score_max" field.
+func ScoreMaxGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldScoreMax, v))
+}
+
+// ScoreMaxGTE applies the GTE predicate on the "score_max" field.
+func ScoreMaxGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldScoreMax, v))
+}
+
+// ScoreMaxLT applies the LT predicate on the "score_max" field.
+func ScoreMaxLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldScoreMax, v))
+}
+
+// ScoreMaxLTE applies the LTE predicate on the "score_max" field.
+func ScoreMaxLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldScoreMax, v))
+}
+
+// PassThresholdEQ applies the EQ predicate on the "pass_threshold" field.
+func PassThresholdEQ(v PassThreshold) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldPassThreshold, v))
+}
+
+// PassThresholdNEQ applies the NEQ predicate on the "pass_threshold" field.
+func PassThresholdNEQ(v PassThreshold) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldPassThreshold, v))
+}
+
+// PassThresholdIn applies the In predicate on the "pass_threshold" field.
+func PassThresholdIn(vs ...PassThreshold) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldPassThreshold, vs...))
+}
+
+// PassThresholdNotIn applies the NotIn predicate on the "pass_threshold" field.
+func PassThresholdNotIn(vs ...PassThreshold) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldPassThreshold, vs...))
+}
+
+// PassPercentageEQ applies the EQ predicate on the "pass_percentage" field.
+func PassPercentageEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldPassPercentage, v))
+}
+
+// PassPercentageNEQ applies the NEQ predicate on the "pass_percentage" field.
+func PassPercentageNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldPassPercentage, v))
+}
+
+// PassPercentageIn applies the In predicate on the "pass_percentage" field.
+func PassPercentageIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldPassPercentage, vs...))
+}
+
+// PassPercentageNotIn applies the NotIn predicate on the "pass_percentage" field.
+func PassPercentageNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldPassPercentage, vs...))
+}
+
+// PassPercentageGT applies the GT predicate on the "pass_percentage" field.
+func PassPercentageGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldPassPercentage, v))
+}
+
+// PassPercentageGTE applies the GTE predicate on the "pass_percentage" field.
+func PassPercentageGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldPassPercentage, v))
+}
+
+// PassPercentageLT applies the LT predicate on the "pass_percentage" field.
+func PassPercentageLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldPassPercentage, v))
+}
+
+// PassPercentageLTE applies the LTE predicate on the "pass_percentage" field.
+func PassPercentageLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldPassPercentage, v))
+}
+
+// OpensAtEQ applies the EQ predicate on the "opens_at" field.
+func OpensAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOpensAt, v))
+}
+
+// OpensAtNEQ applies the NEQ predicate on the "opens_at" field.
+func OpensAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldOpensAt, v))
+}
+
+// OpensAtIn applies the In predicate on the "opens_at" field.
+func OpensAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldOpensAt, vs...))
+}
+
+// OpensAtNotIn applies the NotIn predicate on the "opens_at" field.
+func OpensAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldOpensAt, vs...))
+}
+
+// OpensAtGT applies the GT predicate on the "opens_at" field.
+func OpensAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldOpensAt, v))
+}
+
+// OpensAtGTE applies the GTE predicate on the "opens_at" field.
+func OpensAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldOpensAt, v))
+}
+
+// OpensAtLT applies the LT predicate on the "opens_at" field.
+func OpensAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldOpensAt, v))
+}
+
+// OpensAtLTE applies the LTE predicate on the "opens_at" field.
+func OpensAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldOpensAt, v))
+}
+
+// OpensAtIsNil applies the IsNil predicate on the "opens_at" field.
+func OpensAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldOpensAt))
+}
+
+// OpensAtNotNil applies the NotNil predicate on the "opens_at" field.
+func OpensAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldOpensAt))
+}
+
+// ClosesAtEQ applies the EQ predicate on the "closes_at" field.
+func ClosesAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosesAt, v))
+}
+
+// ClosesAtNEQ applies the NEQ predicate on the "closes_at" field.
+func ClosesAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldClosesAt, v))
+}
+
+// ClosesAtIn applies the In predicate on the "closes_at" field.
+func ClosesAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldClosesAt, vs...))
+}
+
+// ClosesAtNotIn applies the NotIn predicate on the "closes_at" field.
+func ClosesAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldClosesAt, vs...))
+}
+
+// ClosesAtGT applies the GT predicate on the "closes_at" field.
+func ClosesAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldClosesAt, v))
+}
+
+// ClosesAtGTE applies the GTE predicate on the "closes_at" field.
+func ClosesAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldClosesAt, v))
+}
+
+// ClosesAtLT applies the LT predicate on the "closes_at" field.
+func ClosesAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldClosesAt, v))
+}
+
+// ClosesAtLTE applies the LTE predicate on the "closes_at" field.
+func ClosesAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldClosesAt, v))
+}
+
+// ClosesAtIsNil applies the IsNil predicate on the "closes_at" field.
+func ClosesAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldClosesAt))
+}
+
+// ClosesAtNotNil applies the NotNil predicate on the "closes_at" field.
+func ClosesAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldClosesAt))
+}
+
+// CloseEventAtEQ applies the EQ predicate on the "close_event_at" field.
+func CloseEventAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldCloseEventAt, v))
+}
+
+// CloseEventAtNEQ applies the NEQ predicate on the "close_event_at" field.
+func CloseEventAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldCloseEventAt, v))
+}
+
+// CloseEventAtIn applies the In predicate on the "close_event_at" field.
+func CloseEventAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldCloseEventAt, vs...))
+}
+
+// CloseEventAtNotIn applies the NotIn predicate on the "close_event_at" field.
+func CloseEventAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldCloseEventAt, vs...))
+}
+
+// CloseEventAtGT applies the GT predicate on the "close_event_at" field.
+func CloseEventAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldCloseEventAt, v))
+}
+
+// CloseEventAtGTE applies the GTE predicate on the "close_event_at" field.
+func CloseEventAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldCloseEventAt, v))
+}
+
+// CloseEventAtLT applies the LT predicate on the "close_event_at" field.
+func CloseEventAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldCloseEventAt, v))
+}
+
+// CloseEventAtLTE applies the LTE predicate on the "close_event_at" field.
+func CloseEventAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldCloseEventAt, v))
+}
+
+// CloseEventAtIsNil applies the IsNil predicate on the "close_event_at" field.
+func CloseEventAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldCloseEventAt))
+}
+
+// CloseEventAtNotNil applies the NotNil predicate on the "close_event_at" field.
+func CloseEventAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldCloseEventAt))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldClosedAt))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// ResultsVisibilityEQ applies the EQ predicate on the "results_visibility" field.
+func ResultsVisibilityEQ(v ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldResultsVisibility, v))
+}
+
+// ResultsVisibilityNEQ applies the NEQ predicate on the "results_visibility" field.
+func ResultsVisibilityNEQ(v ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldResultsVisibility, v))
+}
+
+// ResultsVisibilityIn applies the In predicate on the "results_visibility" field.
+func ResultsVisibilityIn(vs ...ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldResultsVisibility, vs...))
+}
+
+// ResultsVisibilityNotIn applies the NotIn predicate on the "results_visibility" field.
+func ResultsVisibilityNotIn(vs ...ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldResultsVisibility, vs...))
+}
+
+// AnonymousEQ applies the EQ predicate on the "anonymous" field.
+func AnonymousEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldAnonymous, v))
+}
+
+// AnonymousNEQ applies the NEQ predicate on the "anonymous" field.
+func AnonymousNEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldAnonymous, v))
+}
+
+// AllowGuestsEQ applies the EQ predicate on the "allow_guests" field.
+func AllowGuestsEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldAllowGuests, v))
+}
+
+// AllowGuestsNEQ applies the NEQ predicate on the "allow_guests" field.
+func AllowGuestsNEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldAllowGuests, v))
+}
+
+// GuestDedupeEQ applies the EQ predicate on the "guest_dedupe" field.
+func GuestDedupeEQ(v GuestDedupe) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldGuestDedupe, v))
+}
+
+// GuestDedupeNEQ applies the NEQ predicate on the "guest_dedupe" field.
+func GuestDedupeNEQ(v GuestDedupe) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldGuestDedupe, v))
+}
+
+// GuestDedupeIn applies the In predicate on the "guest_dedupe" field.
+func GuestDedupeIn(vs ...GuestDedupe) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldGuestDedupe, vs...))
+}
+
+// GuestDedupeNotIn applies the NotIn predicate on the "guest_dedupe" field.
+func GuestDedupeNotIn(vs ...GuestDedupe) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldGuestDedupe, vs...))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldRevision, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldCreatedAt, v))
@@ -285,6 +1015,29 @@ func CreatedAtLTE(v time.Time) predicate.Poll {
 	return predicate.Poll(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasOptions applies the HasEdge predicate on the "options" edge.
 func HasOptions() predicate.Poll {
 	return predicate.Poll(func(s *sql.Selector) {
@@ -331,6 +1084,213 @@ func HasVotesWith(preds ...predicate.Vote) predicate.Poll {
 	})
 }
 
+// HasBallots applies the HasEdge predicate on the "ballots" edge.
+func HasBallots() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, BallotsTable, BallotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBallotsWith applies the HasEdge predicate on the "ballots" edge with a given conditions (other predicates).
+func HasBallotsWith(preds ...predicate.Ballot) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newBallotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransitions applies the HasEdge predicate on the "transitions" edge.
+func HasTransitions() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, TransitionsTable, TransitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransitionsWith applies the HasEdge predicate on the "transitions" edge with a given conditions (other predicates).
+func HasTransitionsWith(preds ...predicate.PollTransition) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newTransitionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParticipations applies the HasEdge predicate on the "participations" edge.
+func HasParticipations() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ParticipationsTable, ParticipationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipationsWith applies the HasEdge predicate on the "participations" edge with a given conditions (other predicates).
+func HasParticipationsWith(preds ...predicate.Participation) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newParticipationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnonymousBallots applies the HasEdge predicate on the "anonymous_ballots" edge.
+func HasAnonymousBallots() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, AnonymousBallotsTable, AnonymousBallotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnonymousBallotsWith applies the HasEdge predicate on the "anonymous_ballots" edge with a given conditions (other predicates).
+func HasAnonymousBallotsWith(preds ...predicate.AnonymousBallot) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newAnonymousBallotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBallotLog applies the HasEdge predicate on the "ballot_log" edge.
+func HasBallotLog() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, BallotLogTable, BallotLogColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBallotLogWith applies the HasEdge predicate on the "ballot_log" edge with a given conditions (other predicates).
+func HasBallotLogWith(preds ...predicate.BallotLogEntry) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newBallotLogStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGuestVoters applies the HasEdge predicate on the "guest_voters" edge.
+func HasGuestVoters() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, GuestVotersTable, GuestVotersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGuestVotersWith applies the HasEdge predicate on the "guest_voters" edge with a given conditions (other predicates).
+func HasGuestVotersWith(preds ...predicate.GuestVoter) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newGuestVotersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.PollMember) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCollaborators applies the HasEdge predicate on the "collaborators" edge.
+func HasCollaborators() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, CollaboratorsTable, CollaboratorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollaboratorsWith applies the HasEdge predicate on the "collaborators" edge with a given conditions (other predicates).
+func HasCollaboratorsWith(preds ...predicate.PollCollaborator) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newCollaboratorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShareLinks applies the HasEdge predicate on the "share_links" edge.
+func HasShareLinks() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ShareLinksTable, ShareLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareLinksWith applies the HasEdge predicate on the "share_links" edge with a given conditions (other predicates).
+func HasShareLinksWith(preds ...predicate.ShareLink) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newShareLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Poll) predicate.Poll {
 	return predicate.Poll(sql.AndPredicates(predicates...))
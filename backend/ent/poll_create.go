@@ -6,8 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/organization"
+	"pollapp/backend/ent/participation"
 	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+	"pollapp/backend/ent/pollmember"
 	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/polltransition"
+	"pollapp/backend/ent/sharelink"
 	"pollapp/backend/ent/vote"
 	"time"
 
@@ -40,6 +50,286 @@ func (_c *PollCreate) SetCreatedBy(v int) *PollCreate {
 	return _c
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_c *PollCreate) SetOrganizationID(v int) *PollCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *PollCreate) SetNillableOrganizationID(v *int) *PollCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetMinChoices sets the "min_choices" field.
+func (_c *PollCreate) SetMinChoices(v int) *PollCreate {
+	_c.mutation.SetMinChoices(v)
+	return _c
+}
+
+// SetNillableMinChoices sets the "min_choices" field if the given value is not nil.
+func (_c *PollCreate) SetNillableMinChoices(v *int) *PollCreate {
+	if v != nil {
+		_c.SetMinChoices(*v)
+	}
+	return _c
+}
+
+// SetMaxChoices sets the "max_choices" field.
+func (_c *PollCreate) SetMaxChoices(v int) *PollCreate {
+	_c.mutation.SetMaxChoices(v)
+	return _c
+}
+
+// SetNillableMaxChoices sets the "max_choices" field if the given value is not nil.
+func (_c *PollCreate) SetNillableMaxChoices(v *int) *PollCreate {
+	if v != nil {
+		_c.SetMaxChoices(*v)
+	}
+	return _c
+}
+
+// SetVotingMethod sets the "voting_method" field.
+func (_c *PollCreate) SetVotingMethod(v poll.VotingMethod) *PollCreate {
+	_c.mutation.SetVotingMethod(v)
+	return _c
+}
+
+// SetNillableVotingMethod sets the "voting_method" field if the given value is not nil.
+func (_c *PollCreate) SetNillableVotingMethod(v *poll.VotingMethod) *PollCreate {
+	if v != nil {
+		_c.SetVotingMethod(*v)
+	}
+	return _c
+}
+
+// SetTallyRule sets the "tally_rule" field.
+func (_c *PollCreate) SetTallyRule(v poll.TallyRule) *PollCreate {
+	_c.mutation.SetTallyRule(v)
+	return _c
+}
+
+// SetNillableTallyRule sets the "tally_rule" field if the given value is not nil.
+func (_c *PollCreate) SetNillableTallyRule(v *poll.TallyRule) *PollCreate {
+	if v != nil {
+		_c.SetTallyRule(*v)
+	}
+	return _c
+}
+
+// SetScoreMin sets the "score_min" field.
+func (_c *PollCreate) SetScoreMin(v int) *PollCreate {
+	_c.mutation.SetScoreMin(v)
+	return _c
+}
+
+// SetNillableScoreMin sets the "score_min" field if the given value is not nil.
+func (_c *PollCreate) SetNillableScoreMin(v *int) *PollCreate {
+	if v != nil {
+		_c.SetScoreMin(*v)
+	}
+	return _c
+}
+
+// SetScoreMax sets the "score_max" field.
+func (_c *PollCreate) SetScoreMax(v int) *PollCreate {
+	_c.mutation.SetScoreMax(v)
+	return _c
+}
+
+// SetNillableScoreMax sets the "score_max" field if the given value is not nil.
+func (_c *PollCreate) SetNillableScoreMax(v *int) *PollCreate {
+	if v != nil {
+		_c.SetScoreMax(*v)
+	}
+	return _c
+}
+
+// SetPassThreshold sets the "pass_threshold" field.
+func (_c *PollCreate) SetPassThreshold(v poll.PassThreshold) *PollCreate {
+	_c.mutation.SetPassThreshold(v)
+	return _c
+}
+
+// SetNillablePassThreshold sets the "pass_threshold" field if the given value is not nil.
+func (_c *PollCreate) SetNillablePassThreshold(v *poll.PassThreshold) *PollCreate {
+	if v != nil {
+		_c.SetPassThreshold(*v)
+	}
+	return _c
+}
+
+// SetPassPercentage sets the "pass_percentage" field.
+func (_c *PollCreate) SetPassPercentage(v int) *PollCreate {
+	_c.mutation.SetPassPercentage(v)
+	return _c
+}
+
+// SetNillablePassPercentage sets the "pass_percentage" field if the given value is not nil.
+func (_c *PollCreate) SetNillablePassPercentage(v *int) *PollCreate {
+	if v != nil {
+		_c.SetPassPercentage(*v)
+	}
+	return _c
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_c *PollCreate) SetOpensAt(v time.Time) *PollCreate {
+	_c.mutation.SetOpensAt(v)
+	return _c
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableOpensAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetOpensAt(*v)
+	}
+	return _c
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_c *PollCreate) SetClosesAt(v time.Time) *PollCreate {
+	_c.mutation.SetClosesAt(v)
+	return _c
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableClosesAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetClosesAt(*v)
+	}
+	return _c
+}
+
+// SetCloseEventAt sets the "close_event_at" field.
+func (_c *PollCreate) SetCloseEventAt(v time.Time) *PollCreate {
+	_c.mutation.SetCloseEventAt(v)
+	return _c
+}
+
+// SetNillableCloseEventAt sets the "close_event_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableCloseEventAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetCloseEventAt(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *PollCreate) SetClosedAt(v time.Time) *PollCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableClosedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *PollCreate) SetArchivedAt(v time.Time) *PollCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableArchivedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *PollCreate) SetVisibility(v poll.Visibility) *PollCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *PollCreate) SetNillableVisibility(v *poll.Visibility) *PollCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetResultsVisibility sets the "results_visibility" field.
+func (_c *PollCreate) SetResultsVisibility(v poll.ResultsVisibility) *PollCreate {
+	_c.mutation.SetResultsVisibility(v)
+	return _c
+}
+
+// SetNillableResultsVisibility sets the "results_visibility" field if the given value is not nil.
+func (_c *PollCreate) SetNillableResultsVisibility(v *poll.ResultsVisibility) *PollCreate {
+	if v != nil {
+		_c.SetResultsVisibility(*v)
+	}
+	return _c
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_c *PollCreate) SetAnonymous(v bool) *PollCreate {
+	_c.mutation.SetAnonymous(v)
+	return _c
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_c *PollCreate) SetNillableAnonymous(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetAnonymous(*v)
+	}
+	return _c
+}
+
+// SetAllowGuests sets the "allow_guests" field.
+func (_c *PollCreate) SetAllowGuests(v bool) *PollCreate {
+	_c.mutation.SetAllowGuests(v)
+	return _c
+}
+
+// SetNillableAllowGuests sets the "allow_guests" field if the given value is not nil.
+func (_c *PollCreate) SetNillableAllowGuests(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetAllowGuests(*v)
+	}
+	return _c
+}
+
+// SetGuestDedupe sets the "guest_dedupe" field.
+func (_c *PollCreate) SetGuestDedupe(v poll.GuestDedupe) *PollCreate {
+	_c.mutation.SetGuestDedupe(v)
+	return _c
+}
+
+// SetNillableGuestDedupe sets the "guest_dedupe" field if the given value is not nil.
+func (_c *PollCreate) SetNillableGuestDedupe(v *poll.GuestDedupe) *PollCreate {
+	if v != nil {
+		_c.SetGuestDedupe(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *PollCreate) SetRevision(v int) *PollCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *PollCreate) SetNillableRevision(v *int) *PollCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PollCreate) SetCreatedAt(v time.Time) *PollCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -54,6 +344,11 @@ func (_c *PollCreate) SetNillableCreatedAt(v *time.Time) *PollCreate {
 	return _c
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *PollCreate) SetOrganization(v *Organization) *PollCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
 // AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
 func (_c *PollCreate) AddOptionIDs(ids ...int) *PollCreate {
 	_c.mutation.AddOptionIDs(ids...)
@@ -84,6 +379,141 @@ func (_c *PollCreate) AddVotes(v ...*Vote) *PollCreate {
 	return _c.AddVoteIDs(ids...)
 }
 
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_c *PollCreate) AddBallotIDs(ids ...int) *PollCreate {
+	_c.mutation.AddBallotIDs(ids...)
+	return _c
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_c *PollCreate) AddBallots(v ...*Ballot) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBallotIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the PollTransition entity by IDs.
+func (_c *PollCreate) AddTransitionIDs(ids ...int) *PollCreate {
+	_c.mutation.AddTransitionIDs(ids...)
+	return _c
+}
+
+// AddTransitions adds the "transitions" edges to the PollTransition entity.
+func (_c *PollCreate) AddTransitions(v ...*PollTransition) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransitionIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_c *PollCreate) AddParticipationIDs(ids ...int) *PollCreate {
+	_c.mutation.AddParticipationIDs(ids...)
+	return _c
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_c *PollCreate) AddParticipations(v ...*Participation) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddParticipationIDs(ids...)
+}
+
+// AddAnonymousBallotIDs adds the "anonymous_ballots" edge to the AnonymousBallot entity by IDs.
+func (_c *PollCreate) AddAnonymousBallotIDs(ids ...string) *PollCreate {
+	_c.mutation.AddAnonymousBallotIDs(ids...)
+	return _c
+}
+
+// AddAnonymousBallots adds the "anonymous_ballots" edges to the AnonymousBallot entity.
+func (_c *PollCreate) AddAnonymousBallots(v ...*AnonymousBallot) *PollCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnonymousBallotIDs(ids...)
+}
+
+// AddBallotLogIDs adds the "ballot_log" edge to the BallotLogEntry entity by IDs.
+func (_c *PollCreate) AddBallotLogIDs(ids ...int) *PollCreate {
+	_c.mutation.AddBallotLogIDs(ids...)
+	return _c
+}
+
+// AddBallotLog adds the "ballot_log" edges to the BallotLogEntry entity.
+func (_c *PollCreate) AddBallotLog(v ...*BallotLogEntry) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBallotLogIDs(ids...)
+}
+
+// AddGuestVoterIDs adds the "guest_voters" edge to the GuestVoter entity by IDs.
+func (_c *PollCreate) AddGuestVoterIDs(ids ...int) *PollCreate {
+	_c.mutation.AddGuestVoterIDs(ids...)
+	return _c
+}
+
+// AddGuestVoters adds the "guest_voters" edges to the GuestVoter entity.
+func (_c *PollCreate) AddGuestVoters(v ...*GuestVoter) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGuestVoterIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the PollMember entity by IDs.
+func (_c *PollCreate) AddMemberIDs(ids ...int) *PollCreate {
+	_c.mutation.AddMemberIDs(ids...)
+	return _c
+}
+
+// AddMembers adds the "members" edges to the PollMember entity.
+func (_c *PollCreate) AddMembers(v ...*PollMember) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberIDs(ids...)
+}
+
+// AddCollaboratorIDs adds the "collaborators" edge to the PollCollaborator entity by IDs.
+func (_c *PollCreate) AddCollaboratorIDs(ids ...int) *PollCreate {
+	_c.mutation.AddCollaboratorIDs(ids...)
+	return _c
+}
+
+// AddCollaborators adds the "collaborators" edges to the PollCollaborator entity.
+func (_c *PollCreate) AddCollaborators(v ...*PollCollaborator) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCollaboratorIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_c *PollCreate) AddShareLinkIDs(ids ...int) *PollCreate {
+	_c.mutation.AddShareLinkIDs(ids...)
+	return _c
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_c *PollCreate) AddShareLinks(v ...*ShareLink) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareLinkIDs(ids...)
+}
+
 // Mutation returns the PollMutation object of the builder.
 func (_c *PollCreate) Mutation() *PollMutation {
 	return _c.mutation
@@ -119,6 +549,62 @@ func (_c *PollCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PollCreate) defaults() {
+	if _, ok := _c.mutation.MinChoices(); !ok {
+		v := poll.DefaultMinChoices
+		_c.mutation.SetMinChoices(v)
+	}
+	if _, ok := _c.mutation.MaxChoices(); !ok {
+		v := poll.DefaultMaxChoices
+		_c.mutation.SetMaxChoices(v)
+	}
+	if _, ok := _c.mutation.VotingMethod(); !ok {
+		v := poll.DefaultVotingMethod
+		_c.mutation.SetVotingMethod(v)
+	}
+	if _, ok := _c.mutation.TallyRule(); !ok {
+		v := poll.DefaultTallyRule
+		_c.mutation.SetTallyRule(v)
+	}
+	if _, ok := _c.mutation.ScoreMin(); !ok {
+		v := poll.DefaultScoreMin
+		_c.mutation.SetScoreMin(v)
+	}
+	if _, ok := _c.mutation.ScoreMax(); !ok {
+		v := poll.DefaultScoreMax
+		_c.mutation.SetScoreMax(v)
+	}
+	if _, ok := _c.mutation.PassThreshold(); !ok {
+		v := poll.DefaultPassThreshold
+		_c.mutation.SetPassThreshold(v)
+	}
+	if _, ok := _c.mutation.PassPercentage(); !ok {
+		v := poll.DefaultPassPercentage
+		_c.mutation.SetPassPercentage(v)
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := poll.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	if _, ok := _c.mutation.ResultsVisibility(); !ok {
+		v := poll.DefaultResultsVisibility
+		_c.mutation.SetResultsVisibility(v)
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		v := poll.DefaultAnonymous
+		_c.mutation.SetAnonymous(v)
+	}
+	if _, ok := _c.mutation.AllowGuests(); !ok {
+		v := poll.DefaultAllowGuests
+		_c.mutation.SetAllowGuests(v)
+	}
+	if _, ok := _c.mutation.GuestDedupe(); !ok {
+		v := poll.DefaultGuestDedupe
+		_c.mutation.SetGuestDedupe(v)
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := poll.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := poll.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -136,6 +622,78 @@ func (_c *PollCreate) check() error {
 	if _, ok := _c.mutation.CreatedBy(); !ok {
 		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Poll.created_by"`)}
 	}
+	if _, ok := _c.mutation.MinChoices(); !ok {
+		return &ValidationError{Name: "min_choices", err: errors.New(`ent: missing required field "Poll.min_choices"`)}
+	}
+	if _, ok := _c.mutation.MaxChoices(); !ok {
+		return &ValidationError{Name: "max_choices", err: errors.New(`ent: missing required field "Poll.max_choices"`)}
+	}
+	if _, ok := _c.mutation.VotingMethod(); !ok {
+		return &ValidationError{Name: "voting_method", err: errors.New(`ent: missing required field "Poll.voting_method"`)}
+	}
+	if v, ok := _c.mutation.VotingMethod(); ok {
+		if err := poll.VotingMethodValidator(v); err != nil {
+			return &ValidationError{Name: "voting_method", err: fmt.Errorf(`ent: validator failed for field "Poll.voting_method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TallyRule(); !ok {
+		return &ValidationError{Name: "tally_rule", err: errors.New(`ent: missing required field "Poll.tally_rule"`)}
+	}
+	if v, ok := _c.mutation.TallyRule(); ok {
+		if err := poll.TallyRuleValidator(v); err != nil {
+			return &ValidationError{Name: "tally_rule", err: fmt.Errorf(`ent: validator failed for field "Poll.tally_rule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScoreMin(); !ok {
+		return &ValidationError{Name: "score_min", err: errors.New(`ent: missing required field "Poll.score_min"`)}
+	}
+	if _, ok := _c.mutation.ScoreMax(); !ok {
+		return &ValidationError{Name: "score_max", err: errors.New(`ent: missing required field "Poll.score_max"`)}
+	}
+	if _, ok := _c.mutation.PassThreshold(); !ok {
+		return &ValidationError{Name: "pass_threshold", err: errors.New(`ent: missing required field "Poll.pass_threshold"`)}
+	}
+	if v, ok := _c.mutation.PassThreshold(); ok {
+		if err := poll.PassThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "pass_threshold", err: fmt.Errorf(`ent: validator failed for field "Poll.pass_threshold": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PassPercentage(); !ok {
+		return &ValidationError{Name: "pass_percentage", err: errors.New(`ent: missing required field "Poll.pass_percentage"`)}
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Poll.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := poll.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResultsVisibility(); !ok {
+		return &ValidationError{Name: "results_visibility", err: errors.New(`ent: missing required field "Poll.results_visibility"`)}
+	}
+	if v, ok := _c.mutation.ResultsVisibility(); ok {
+		if err := poll.ResultsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "results_visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.results_visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		return &ValidationError{Name: "anonymous", err: errors.New(`ent: missing required field "Poll.anonymous"`)}
+	}
+	if _, ok := _c.mutation.AllowGuests(); !ok {
+		return &ValidationError{Name: "allow_guests", err: errors.New(`ent: missing required field "Poll.allow_guests"`)}
+	}
+	if _, ok := _c.mutation.GuestDedupe(); !ok {
+		return &ValidationError{Name: "guest_dedupe", err: errors.New(`ent: missing required field "Poll.guest_dedupe"`)}
+	}
+	if v, ok := _c.mutation.GuestDedupe(); ok {
+		if err := poll.GuestDedupeValidator(v); err != nil {
+			return &ValidationError{Name: "guest_dedupe", err: fmt.Errorf(`ent: validator failed for field "Poll.guest_dedupe": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "Poll.revision"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Poll.created_at"`)}
 	}
@@ -177,10 +735,103 @@ func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
 		_spec.SetField(poll.FieldCreatedBy, field.TypeInt, value)
 		_node.CreatedBy = value
 	}
+	if value, ok := _c.mutation.MinChoices(); ok {
+		_spec.SetField(poll.FieldMinChoices, field.TypeInt, value)
+		_node.MinChoices = value
+	}
+	if value, ok := _c.mutation.MaxChoices(); ok {
+		_spec.SetField(poll.FieldMaxChoices, field.TypeInt, value)
+		_node.MaxChoices = value
+	}
+	if value, ok := _c.mutation.VotingMethod(); ok {
+		_spec.SetField(poll.FieldVotingMethod, field.TypeEnum, value)
+		_node.VotingMethod = value
+	}
+	if value, ok := _c.mutation.TallyRule(); ok {
+		_spec.SetField(poll.FieldTallyRule, field.TypeEnum, value)
+		_node.TallyRule = value
+	}
+	if value, ok := _c.mutation.ScoreMin(); ok {
+		_spec.SetField(poll.FieldScoreMin, field.TypeInt, value)
+		_node.ScoreMin = value
+	}
+	if value, ok := _c.mutation.ScoreMax(); ok {
+		_spec.SetField(poll.FieldScoreMax, field.TypeInt, value)
+		_node.ScoreMax = value
+	}
+	if value, ok := _c.mutation.PassThreshold(); ok {
+		_spec.SetField(poll.FieldPassThreshold, field.TypeEnum, value)
+		_node.PassThreshold = value
+	}
+	if value, ok := _c.mutation.PassPercentage(); ok {
+		_spec.SetField(poll.FieldPassPercentage, field.TypeInt, value)
+		_node.PassPercentage = value
+	}
+	if value, ok := _c.mutation.OpensAt(); ok {
+		_spec.SetField(poll.FieldOpensAt, field.TypeTime, value)
+		_node.OpensAt = &value
+	}
+	if value, ok := _c.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+		_node.ClosesAt = &value
+	}
+	if value, ok := _c.mutation.CloseEventAt(); ok {
+		_spec.SetField(poll.FieldCloseEventAt, field.TypeTime, value)
+		_node.CloseEventAt = &value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(poll.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(poll.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.ResultsVisibility(); ok {
+		_spec.SetField(poll.FieldResultsVisibility, field.TypeEnum, value)
+		_node.ResultsVisibility = value
+	}
+	if value, ok := _c.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+		_node.Anonymous = value
+	}
+	if value, ok := _c.mutation.AllowGuests(); ok {
+		_spec.SetField(poll.FieldAllowGuests, field.TypeBool, value)
+		_node.AllowGuests = value
+	}
+	if value, ok := _c.mutation.GuestDedupe(); ok {
+		_spec.SetField(poll.FieldGuestDedupe, field.TypeEnum, value)
+		_node.GuestDedupe = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(poll.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poll.OrganizationTable,
+			Columns: []string{poll.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.OptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -213,6 +864,150 @@ func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.TransitionsTable,
+			Columns: []string{poll.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AnonymousBallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.AnonymousBallotsTable,
+			Columns: []string{poll.AnonymousBallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BallotLogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotLogTable,
+			Columns: []string{poll.BallotLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GuestVotersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.GuestVotersTable,
+			Columns: []string{poll.GuestVotersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.MembersTable,
+			Columns: []string{poll.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CollaboratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.CollaboratorsTable,
+			Columns: []string{poll.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ShareLinksTable,
+			Columns: []string{poll.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,9 +7,19 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/organization"
+	"pollapp/backend/ent/participation"
 	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+	"pollapp/backend/ent/pollmember"
 	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/polltransition"
 	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/sharelink"
 	"pollapp/backend/ent/vote"
 
 	"entgo.io/ent"
@@ -21,12 +31,22 @@ import (
 // PollQuery is the builder for querying Poll entities.
 type PollQuery struct {
 	config
-	ctx         *QueryContext
-	order       []poll.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Poll
-	withOptions *PollOptionQuery
-	withVotes   *VoteQuery
+	ctx                  *QueryContext
+	order                []poll.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Poll
+	withOrganization     *OrganizationQuery
+	withOptions          *PollOptionQuery
+	withVotes            *VoteQuery
+	withBallots          *BallotQuery
+	withTransitions      *PollTransitionQuery
+	withParticipations   *ParticipationQuery
+	withAnonymousBallots *AnonymousBallotQuery
+	withBallotLog        *BallotLogEntryQuery
+	withGuestVoters      *GuestVoterQuery
+	withMembers          *PollMemberQuery
+	withCollaborators    *PollCollaboratorQuery
+	withShareLinks       *ShareLinkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -63,6 +83,28 @@ func (_q *PollQuery) Order(o ...poll.OrderOption) *PollQuery {
 	return _q
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (_q *PollQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, poll.OrganizationTable, poll.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryOptions chains the current query on the "options" edge.
 func (_q *PollQuery) QueryOptions() *PollOptionQuery {
 	query := (&PollOptionClient{config: _q.config}).Query()
@@ -107,6 +149,204 @@ func (_q *PollQuery) QueryVotes() *VoteQuery {
 	return query
 }
 
+// QueryBallots chains the current query on the "ballots" edge.
+func (_q *PollQuery) QueryBallots() *BallotQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(ballot.Table, ballot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.BallotsTable, poll.BallotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransitions chains the current query on the "transitions" edge.
+func (_q *PollQuery) QueryTransitions() *PollTransitionQuery {
+	query := (&PollTransitionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(polltransition.Table, polltransition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.TransitionsTable, poll.TransitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParticipations chains the current query on the "participations" edge.
+func (_q *PollQuery) QueryParticipations() *ParticipationQuery {
+	query := (&ParticipationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(participation.Table, participation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.ParticipationsTable, poll.ParticipationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAnonymousBallots chains the current query on the "anonymous_ballots" edge.
+func (_q *PollQuery) QueryAnonymousBallots() *AnonymousBallotQuery {
+	query := (&AnonymousBallotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(anonymousballot.Table, anonymousballot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.AnonymousBallotsTable, poll.AnonymousBallotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBallotLog chains the current query on the "ballot_log" edge.
+func (_q *PollQuery) QueryBallotLog() *BallotLogEntryQuery {
+	query := (&BallotLogEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(ballotlogentry.Table, ballotlogentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.BallotLogTable, poll.BallotLogColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGuestVoters chains the current query on the "guest_voters" edge.
+func (_q *PollQuery) QueryGuestVoters() *GuestVoterQuery {
+	query := (&GuestVoterClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(guestvoter.Table, guestvoter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.GuestVotersTable, poll.GuestVotersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMembers chains the current query on the "members" edge.
+func (_q *PollQuery) QueryMembers() *PollMemberQuery {
+	query := (&PollMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(pollmember.Table, pollmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.MembersTable, poll.MembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCollaborators chains the current query on the "collaborators" edge.
+func (_q *PollQuery) QueryCollaborators() *PollCollaboratorQuery {
+	query := (&PollCollaboratorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(pollcollaborator.Table, pollcollaborator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.CollaboratorsTable, poll.CollaboratorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShareLinks chains the current query on the "share_links" edge.
+func (_q *PollQuery) QueryShareLinks() *ShareLinkQuery {
+	query := (&ShareLinkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, poll.ShareLinksTable, poll.ShareLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Poll entity from the query.
 // Returns a *NotFoundError when no Poll was found.
 func (_q *PollQuery) First(ctx context.Context) (*Poll, error) {
@@ -294,19 +534,40 @@ func (_q *PollQuery) Clone() *PollQuery {
 		return nil
 	}
 	return &PollQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]poll.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Poll{}, _q.predicates...),
-		withOptions: _q.withOptions.Clone(),
-		withVotes:   _q.withVotes.Clone(),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]poll.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.Poll{}, _q.predicates...),
+		withOrganization:     _q.withOrganization.Clone(),
+		withOptions:          _q.withOptions.Clone(),
+		withVotes:            _q.withVotes.Clone(),
+		withBallots:          _q.withBallots.Clone(),
+		withTransitions:      _q.withTransitions.Clone(),
+		withParticipations:   _q.withParticipations.Clone(),
+		withAnonymousBallots: _q.withAnonymousBallots.Clone(),
+		withBallotLog:        _q.withBallotLog.Clone(),
+		withGuestVoters:      _q.withGuestVoters.Clone(),
+		withMembers:          _q.withMembers.Clone(),
+		withCollaborators:    _q.withCollaborators.Clone(),
+		withShareLinks:       _q.withShareLinks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithOrganization(opts ...func(*OrganizationQuery)) *PollQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrganization = query
+	return _q
+}
+
 // WithOptions tells the query-builder to eager-load the nodes that are connected to
 // the "options" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PollQuery) WithOptions(opts ...func(*PollOptionQuery)) *PollQuery {
@@ -329,6 +590,105 @@ func (_q *PollQuery) WithVotes(opts ...func(*VoteQuery)) *PollQuery {
 	return _q
 }
 
+// WithBallots tells the query-builder to eager-load the nodes that are connected to
+// the "ballots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithBallots(opts ...func(*BallotQuery)) *PollQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBallots = query
+	return _q
+}
+
+// WithTransitions tells the query-builder to eager-load the nodes that are connected to
+// the "transitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithTransitions(opts ...func(*PollTransitionQuery)) *PollQuery {
+	query := (&PollTransitionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransitions = query
+	return _q
+}
+
+// WithParticipations tells the query-builder to eager-load the nodes that are connected to
+// the "participations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithParticipations(opts ...func(*ParticipationQuery)) *PollQuery {
+	query := (&ParticipationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParticipations = query
+	return _q
+}
+
+// WithAnonymousBallots tells the query-builder to eager-load the nodes that are connected to
+// the "anonymous_ballots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithAnonymousBallots(opts ...func(*AnonymousBallotQuery)) *PollQuery {
+	query := (&AnonymousBallotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnonymousBallots = query
+	return _q
+}
+
+// WithBallotLog tells the query-builder to eager-load the nodes that are connected to
+// the "ballot_log" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithBallotLog(opts ...func(*BallotLogEntryQuery)) *PollQuery {
+	query := (&BallotLogEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBallotLog = query
+	return _q
+}
+
+// WithGuestVoters tells the query-builder to eager-load the nodes that are connected to
+// the "guest_voters" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithGuestVoters(opts ...func(*GuestVoterQuery)) *PollQuery {
+	query := (&GuestVoterClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGuestVoters = query
+	return _q
+}
+
+// WithMembers tells the query-builder to eager-load the nodes that are connected to
+// the "members" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithMembers(opts ...func(*PollMemberQuery)) *PollQuery {
+	query := (&PollMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMembers = query
+	return _q
+}
+
+// WithCollaborators tells the query-builder to eager-load the nodes that are connected to
+// the "collaborators" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithCollaborators(opts ...func(*PollCollaboratorQuery)) *PollQuery {
+	query := (&PollCollaboratorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCollaborators = query
+	return _q
+}
+
+// WithShareLinks tells the query-builder to eager-load the nodes that are connected to
+// the "share_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithShareLinks(opts ...func(*ShareLinkQuery)) *PollQuery {
+	query := (&ShareLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShareLinks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +767,19 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 	var (
 		nodes       = []*Poll{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [12]bool{
+			_q.withOrganization != nil,
 			_q.withOptions != nil,
 			_q.withVotes != nil,
+			_q.withBallots != nil,
+			_q.withTransitions != nil,
+			_q.withParticipations != nil,
+			_q.withAnonymousBallots != nil,
+			_q.withBallotLog != nil,
+			_q.withGuestVoters != nil,
+			_q.withMembers != nil,
+			_q.withCollaborators != nil,
+			_q.withShareLinks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -430,6 +800,12 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withOrganization; query != nil {
+		if err := _q.loadOrganization(ctx, query, nodes, nil,
+			func(n *Poll, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withOptions; query != nil {
 		if err := _q.loadOptions(ctx, query, nodes,
 			func(n *Poll) { n.Edges.Options = []*PollOption{} },
@@ -444,28 +820,123 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 			return nil, err
 		}
 	}
-	return nodes, nil
-}
-
-func (_q *PollQuery) loadOptions(ctx context.Context, query *PollOptionQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *PollOption)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Poll)
-	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+	if query := _q.withBallots; query != nil {
+		if err := _q.loadBallots(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Ballots = []*Ballot{} },
+			func(n *Poll, e *Ballot) { n.Edges.Ballots = append(n.Edges.Ballots, e) }); err != nil {
+			return nil, err
 		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(polloption.FieldPollID)
-	}
-	query.Where(predicate.PollOption(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(poll.OptionsColumn), fks...))
-	}))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
+	if query := _q.withTransitions; query != nil {
+		if err := _q.loadTransitions(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Transitions = []*PollTransition{} },
+			func(n *Poll, e *PollTransition) { n.Edges.Transitions = append(n.Edges.Transitions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withParticipations; query != nil {
+		if err := _q.loadParticipations(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Participations = []*Participation{} },
+			func(n *Poll, e *Participation) { n.Edges.Participations = append(n.Edges.Participations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAnonymousBallots; query != nil {
+		if err := _q.loadAnonymousBallots(ctx, query, nodes,
+			func(n *Poll) { n.Edges.AnonymousBallots = []*AnonymousBallot{} },
+			func(n *Poll, e *AnonymousBallot) { n.Edges.AnonymousBallots = append(n.Edges.AnonymousBallots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBallotLog; query != nil {
+		if err := _q.loadBallotLog(ctx, query, nodes,
+			func(n *Poll) { n.Edges.BallotLog = []*BallotLogEntry{} },
+			func(n *Poll, e *BallotLogEntry) { n.Edges.BallotLog = append(n.Edges.BallotLog, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGuestVoters; query != nil {
+		if err := _q.loadGuestVoters(ctx, query, nodes,
+			func(n *Poll) { n.Edges.GuestVoters = []*GuestVoter{} },
+			func(n *Poll, e *GuestVoter) { n.Edges.GuestVoters = append(n.Edges.GuestVoters, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMembers; query != nil {
+		if err := _q.loadMembers(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Members = []*PollMember{} },
+			func(n *Poll, e *PollMember) { n.Edges.Members = append(n.Edges.Members, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCollaborators; query != nil {
+		if err := _q.loadCollaborators(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Collaborators = []*PollCollaborator{} },
+			func(n *Poll, e *PollCollaborator) { n.Edges.Collaborators = append(n.Edges.Collaborators, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShareLinks; query != nil {
+		if err := _q.loadShareLinks(ctx, query, nodes,
+			func(n *Poll) { n.Edges.ShareLinks = []*ShareLink{} },
+			func(n *Poll, e *ShareLink) { n.Edges.ShareLinks = append(n.Edges.ShareLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *PollQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *Organization)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Poll)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PollQuery) loadOptions(ctx context.Context, query *PollOptionQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *PollOption)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(polloption.FieldPollID)
+	}
+	query.Where(predicate.PollOption(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.OptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
 	for _, n := range neighbors {
 		fk := n.PollID
@@ -507,6 +978,276 @@ func (_q *PollQuery) loadVotes(ctx context.Context, query *VoteQuery, nodes []*P
 	}
 	return nil
 }
+func (_q *PollQuery) loadBallots(ctx context.Context, query *BallotQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *Ballot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ballot.FieldPollID)
+	}
+	query.Where(predicate.Ballot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.BallotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadTransitions(ctx context.Context, query *PollTransitionQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *PollTransition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(polltransition.FieldPollID)
+	}
+	query.Where(predicate.PollTransition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.TransitionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadParticipations(ctx context.Context, query *ParticipationQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *Participation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(participation.FieldPollID)
+	}
+	query.Where(predicate.Participation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.ParticipationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadAnonymousBallots(ctx context.Context, query *AnonymousBallotQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *AnonymousBallot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(anonymousballot.FieldPollID)
+	}
+	query.Where(predicate.AnonymousBallot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.AnonymousBallotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadBallotLog(ctx context.Context, query *BallotLogEntryQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *BallotLogEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ballotlogentry.FieldPollID)
+	}
+	query.Where(predicate.BallotLogEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.BallotLogColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadGuestVoters(ctx context.Context, query *GuestVoterQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *GuestVoter)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(guestvoter.FieldPollID)
+	}
+	query.Where(predicate.GuestVoter(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.GuestVotersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadMembers(ctx context.Context, query *PollMemberQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *PollMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pollmember.FieldPollID)
+	}
+	query.Where(predicate.PollMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.MembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadCollaborators(ctx context.Context, query *PollCollaboratorQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *PollCollaborator)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pollcollaborator.FieldPollID)
+	}
+	query.Where(predicate.PollCollaborator(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.CollaboratorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadShareLinks(ctx context.Context, query *ShareLinkQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *ShareLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sharelink.FieldPollID)
+	}
+	query.Where(predicate.ShareLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.ShareLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PollQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -533,6 +1274,9 @@ func (_q *PollQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withOrganization != nil {
+			_spec.Node.AddColumnOnce(poll.FieldOrganizationID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
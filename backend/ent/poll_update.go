@@ -6,9 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/organization"
+	"pollapp/backend/ent/participation"
 	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+	"pollapp/backend/ent/pollmember"
 	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/polltransition"
 	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/sharelink"
 	"pollapp/backend/ent/vote"
 	"time"
 
@@ -79,329 +89,391 @@ func (_u *PollUpdate) AddCreatedBy(v int) *PollUpdate {
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *PollUpdate) SetCreatedAt(v time.Time) *PollUpdate {
-	_u.mutation.SetCreatedAt(v)
+// SetOrganizationID sets the "organization_id" field.
+func (_u *PollUpdate) SetOrganizationID(v int) *PollUpdate {
+	_u.mutation.SetOrganizationID(v)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *PollUpdate) SetNillableCreatedAt(v *time.Time) *PollUpdate {
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableOrganizationID(v *int) *PollUpdate {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetOrganizationID(*v)
 	}
 	return _u
 }
 
-// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
-func (_u *PollUpdate) AddOptionIDs(ids ...int) *PollUpdate {
-	_u.mutation.AddOptionIDs(ids...)
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *PollUpdate) ClearOrganizationID() *PollUpdate {
+	_u.mutation.ClearOrganizationID()
 	return _u
 }
 
-// AddOptions adds the "options" edges to the PollOption entity.
-func (_u *PollUpdate) AddOptions(v ...*PollOption) *PollUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddOptionIDs(ids...)
+// SetMinChoices sets the "min_choices" field.
+func (_u *PollUpdate) SetMinChoices(v int) *PollUpdate {
+	_u.mutation.ResetMinChoices()
+	_u.mutation.SetMinChoices(v)
+	return _u
 }
 
-// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
-func (_u *PollUpdate) AddVoteIDs(ids ...int) *PollUpdate {
-	_u.mutation.AddVoteIDs(ids...)
+// SetNillableMinChoices sets the "min_choices" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableMinChoices(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetMinChoices(*v)
+	}
 	return _u
 }
 
-// AddVotes adds the "votes" edges to the Vote entity.
-func (_u *PollUpdate) AddVotes(v ...*Vote) *PollUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddVoteIDs(ids...)
+// AddMinChoices adds value to the "min_choices" field.
+func (_u *PollUpdate) AddMinChoices(v int) *PollUpdate {
+	_u.mutation.AddMinChoices(v)
+	return _u
 }
 
-// Mutation returns the PollMutation object of the builder.
-func (_u *PollUpdate) Mutation() *PollMutation {
-	return _u.mutation
+// SetMaxChoices sets the "max_choices" field.
+func (_u *PollUpdate) SetMaxChoices(v int) *PollUpdate {
+	_u.mutation.ResetMaxChoices()
+	_u.mutation.SetMaxChoices(v)
+	return _u
 }
 
-// ClearOptions clears all "options" edges to the PollOption entity.
-func (_u *PollUpdate) ClearOptions() *PollUpdate {
-	_u.mutation.ClearOptions()
+// SetNillableMaxChoices sets the "max_choices" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableMaxChoices(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetMaxChoices(*v)
+	}
 	return _u
 }
 
-// RemoveOptionIDs removes the "options" edge to PollOption entities by IDs.
-func (_u *PollUpdate) RemoveOptionIDs(ids ...int) *PollUpdate {
-	_u.mutation.RemoveOptionIDs(ids...)
+// AddMaxChoices adds value to the "max_choices" field.
+func (_u *PollUpdate) AddMaxChoices(v int) *PollUpdate {
+	_u.mutation.AddMaxChoices(v)
 	return _u
 }
 
-// RemoveOptions removes "options" edges to PollOption entities.
-func (_u *PollUpdate) RemoveOptions(v ...*PollOption) *PollUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.RemoveOptionIDs(ids...)
+// SetVotingMethod sets the "voting_method" field.
+func (_u *PollUpdate) SetVotingMethod(v poll.VotingMethod) *PollUpdate {
+	_u.mutation.SetVotingMethod(v)
+	return _u
 }
 
-// ClearVotes clears all "votes" edges to the Vote entity.
-func (_u *PollUpdate) ClearVotes() *PollUpdate {
-	_u.mutation.ClearVotes()
+// SetNillableVotingMethod sets the "voting_method" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableVotingMethod(v *poll.VotingMethod) *PollUpdate {
+	if v != nil {
+		_u.SetVotingMethod(*v)
+	}
 	return _u
 }
 
-// RemoveVoteIDs removes the "votes" edge to Vote entities by IDs.
-func (_u *PollUpdate) RemoveVoteIDs(ids ...int) *PollUpdate {
-	_u.mutation.RemoveVoteIDs(ids...)
+// SetTallyRule sets the "tally_rule" field.
+func (_u *PollUpdate) SetTallyRule(v poll.TallyRule) *PollUpdate {
+	_u.mutation.SetTallyRule(v)
 	return _u
 }
 
-// RemoveVotes removes "votes" edges to Vote entities.
-func (_u *PollUpdate) RemoveVotes(v ...*Vote) *PollUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableTallyRule sets the "tally_rule" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableTallyRule(v *poll.TallyRule) *PollUpdate {
+	if v != nil {
+		_u.SetTallyRule(*v)
 	}
-	return _u.RemoveVoteIDs(ids...)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *PollUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetScoreMin sets the "score_min" field.
+func (_u *PollUpdate) SetScoreMin(v int) *PollUpdate {
+	_u.mutation.ResetScoreMin()
+	_u.mutation.SetScoreMin(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *PollUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableScoreMin sets the "score_min" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableScoreMin(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetScoreMin(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (_u *PollUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// AddScoreMin adds value to the "score_min" field.
+func (_u *PollUpdate) AddScoreMin(v int) *PollUpdate {
+	_u.mutation.AddScoreMin(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *PollUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetScoreMax sets the "score_max" field.
+func (_u *PollUpdate) SetScoreMax(v int) *PollUpdate {
+	_u.mutation.ResetScoreMax()
+	_u.mutation.SetScoreMax(v)
+	return _u
 }
 
-func (_u *PollUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Title(); ok {
-		_spec.SetField(poll.FieldTitle, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Description(); ok {
-		_spec.SetField(poll.FieldDescription, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.CreatedBy(); ok {
-		_spec.SetField(poll.FieldCreatedBy, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedCreatedBy(); ok {
-		_spec.AddField(poll.FieldCreatedBy, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
-	}
-	if _u.mutation.OptionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   poll.OptionsTable,
-			Columns: []string{poll.OptionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !_u.mutation.OptionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   poll.OptionsTable,
-			Columns: []string{poll.OptionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.OptionsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   poll.OptionsTable,
-			Columns: []string{poll.OptionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _u.mutation.VotesCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   poll.VotesTable,
-			Columns: []string{poll.VotesColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   poll.VotesTable,
-			Columns: []string{poll.VotesColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   poll.VotesTable,
-			Columns: []string{poll.VotesColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{poll.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+// SetNillableScoreMax sets the "score_max" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableScoreMax(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetScoreMax(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// PollUpdateOne is the builder for updating a single Poll entity.
-type PollUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *PollMutation
+// AddScoreMax adds value to the "score_max" field.
+func (_u *PollUpdate) AddScoreMax(v int) *PollUpdate {
+	_u.mutation.AddScoreMax(v)
+	return _u
 }
 
-// SetTitle sets the "title" field.
-func (_u *PollUpdateOne) SetTitle(v string) *PollUpdateOne {
-	_u.mutation.SetTitle(v)
+// SetPassThreshold sets the "pass_threshold" field.
+func (_u *PollUpdate) SetPassThreshold(v poll.PassThreshold) *PollUpdate {
+	_u.mutation.SetPassThreshold(v)
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *PollUpdateOne) SetNillableTitle(v *string) *PollUpdateOne {
+// SetNillablePassThreshold sets the "pass_threshold" field if the given value is not nil.
+func (_u *PollUpdate) SetNillablePassThreshold(v *poll.PassThreshold) *PollUpdate {
 	if v != nil {
-		_u.SetTitle(*v)
+		_u.SetPassThreshold(*v)
 	}
 	return _u
 }
 
-// SetDescription sets the "description" field.
-func (_u *PollUpdateOne) SetDescription(v string) *PollUpdateOne {
-	_u.mutation.SetDescription(v)
+// SetPassPercentage sets the "pass_percentage" field.
+func (_u *PollUpdate) SetPassPercentage(v int) *PollUpdate {
+	_u.mutation.ResetPassPercentage()
+	_u.mutation.SetPassPercentage(v)
 	return _u
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *PollUpdateOne) SetNillableDescription(v *string) *PollUpdateOne {
+// SetNillablePassPercentage sets the "pass_percentage" field if the given value is not nil.
+func (_u *PollUpdate) SetNillablePassPercentage(v *int) *PollUpdate {
 	if v != nil {
-		_u.SetDescription(*v)
+		_u.SetPassPercentage(*v)
 	}
 	return _u
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (_u *PollUpdateOne) SetCreatedBy(v int) *PollUpdateOne {
-	_u.mutation.ResetCreatedBy()
-	_u.mutation.SetCreatedBy(v)
+// AddPassPercentage adds value to the "pass_percentage" field.
+func (_u *PollUpdate) AddPassPercentage(v int) *PollUpdate {
+	_u.mutation.AddPassPercentage(v)
 	return _u
 }
 
-// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
-func (_u *PollUpdateOne) SetNillableCreatedBy(v *int) *PollUpdateOne {
+// SetOpensAt sets the "opens_at" field.
+func (_u *PollUpdate) SetOpensAt(v time.Time) *PollUpdate {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableOpensAt(v *time.Time) *PollUpdate {
 	if v != nil {
-		_u.SetCreatedBy(*v)
+		_u.SetOpensAt(*v)
 	}
 	return _u
 }
 
-// AddCreatedBy adds value to the "created_by" field.
-func (_u *PollUpdateOne) AddCreatedBy(v int) *PollUpdateOne {
-	_u.mutation.AddCreatedBy(v)
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *PollUpdate) ClearOpensAt() *PollUpdate {
+	_u.mutation.ClearOpensAt()
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *PollUpdateOne) SetCreatedAt(v time.Time) *PollUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// SetClosesAt sets the "closes_at" field.
+func (_u *PollUpdate) SetClosesAt(v time.Time) *PollUpdate {
+	_u.mutation.SetClosesAt(v)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *PollUpdateOne) SetNillableCreatedAt(v *time.Time) *PollUpdateOne {
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableClosesAt(v *time.Time) *PollUpdate {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetClosesAt(*v)
 	}
 	return _u
 }
 
-// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
-func (_u *PollUpdateOne) AddOptionIDs(ids ...int) *PollUpdateOne {
-	_u.mutation.AddOptionIDs(ids...)
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *PollUpdate) ClearClosesAt() *PollUpdate {
+	_u.mutation.ClearClosesAt()
 	return _u
 }
 
-// AddOptions adds the "options" edges to the PollOption entity.
-func (_u *PollUpdateOne) AddOptions(v ...*PollOption) *PollUpdateOne {
+// SetCloseEventAt sets the "close_event_at" field.
+func (_u *PollUpdate) SetCloseEventAt(v time.Time) *PollUpdate {
+	_u.mutation.SetCloseEventAt(v)
+	return _u
+}
+
+// SetNillableCloseEventAt sets the "close_event_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableCloseEventAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetCloseEventAt(*v)
+	}
+	return _u
+}
+
+// ClearCloseEventAt clears the value of the "close_event_at" field.
+func (_u *PollUpdate) ClearCloseEventAt() *PollUpdate {
+	_u.mutation.ClearCloseEventAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PollUpdate) SetClosedAt(v time.Time) *PollUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableClosedAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PollUpdate) ClearClosedAt() *PollUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *PollUpdate) SetArchivedAt(v time.Time) *PollUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableArchivedAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *PollUpdate) ClearArchivedAt() *PollUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *PollUpdate) SetVisibility(v poll.Visibility) *PollUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableVisibility(v *poll.Visibility) *PollUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetResultsVisibility sets the "results_visibility" field.
+func (_u *PollUpdate) SetResultsVisibility(v poll.ResultsVisibility) *PollUpdate {
+	_u.mutation.SetResultsVisibility(v)
+	return _u
+}
+
+// SetNillableResultsVisibility sets the "results_visibility" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableResultsVisibility(v *poll.ResultsVisibility) *PollUpdate {
+	if v != nil {
+		_u.SetResultsVisibility(*v)
+	}
+	return _u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_u *PollUpdate) SetAnonymous(v bool) *PollUpdate {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableAnonymous(v *bool) *PollUpdate {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
+// SetAllowGuests sets the "allow_guests" field.
+func (_u *PollUpdate) SetAllowGuests(v bool) *PollUpdate {
+	_u.mutation.SetAllowGuests(v)
+	return _u
+}
+
+// SetNillableAllowGuests sets the "allow_guests" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableAllowGuests(v *bool) *PollUpdate {
+	if v != nil {
+		_u.SetAllowGuests(*v)
+	}
+	return _u
+}
+
+// SetGuestDedupe sets the "guest_dedupe" field.
+func (_u *PollUpdate) SetGuestDedupe(v poll.GuestDedupe) *PollUpdate {
+	_u.mutation.SetGuestDedupe(v)
+	return _u
+}
+
+// SetNillableGuestDedupe sets the "guest_dedupe" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableGuestDedupe(v *poll.GuestDedupe) *PollUpdate {
+	if v != nil {
+		_u.SetGuestDedupe(*v)
+	}
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *PollUpdate) SetRevision(v int) *PollUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableRevision(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *PollUpdate) AddRevision(v int) *PollUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollUpdate) SetCreatedAt(v time.Time) *PollUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableCreatedAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *PollUpdate) SetOrganization(v *Organization) *PollUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
+func (_u *PollUpdate) AddOptionIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddOptionIDs(ids...)
+	return _u
+}
+
+// AddOptions adds the "options" edges to the PollOption entity.
+func (_u *PollUpdate) AddOptions(v ...*PollOption) *PollUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
@@ -410,13 +482,13 @@ func (_u *PollUpdateOne) AddOptions(v ...*PollOption) *PollUpdateOne {
 }
 
 // AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
-func (_u *PollUpdateOne) AddVoteIDs(ids ...int) *PollUpdateOne {
+func (_u *PollUpdate) AddVoteIDs(ids ...int) *PollUpdate {
 	_u.mutation.AddVoteIDs(ids...)
 	return _u
 }
 
 // AddVotes adds the "votes" edges to the Vote entity.
-func (_u *PollUpdateOne) AddVotes(v ...*Vote) *PollUpdateOne {
+func (_u *PollUpdate) AddVotes(v ...*Vote) *PollUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
@@ -424,25 +496,166 @@ func (_u *PollUpdateOne) AddVotes(v ...*Vote) *PollUpdateOne {
 	return _u.AddVoteIDs(ids...)
 }
 
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_u *PollUpdate) AddBallotIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddBallotIDs(ids...)
+	return _u
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_u *PollUpdate) AddBallots(v ...*Ballot) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the PollTransition entity by IDs.
+func (_u *PollUpdate) AddTransitionIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddTransitionIDs(ids...)
+	return _u
+}
+
+// AddTransitions adds the "transitions" edges to the PollTransition entity.
+func (_u *PollUpdate) AddTransitions(v ...*PollTransition) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransitionIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_u *PollUpdate) AddParticipationIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddParticipationIDs(ids...)
+	return _u
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_u *PollUpdate) AddParticipations(v ...*Participation) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipationIDs(ids...)
+}
+
+// AddAnonymousBallotIDs adds the "anonymous_ballots" edge to the AnonymousBallot entity by IDs.
+func (_u *PollUpdate) AddAnonymousBallotIDs(ids ...string) *PollUpdate {
+	_u.mutation.AddAnonymousBallotIDs(ids...)
+	return _u
+}
+
+// AddAnonymousBallots adds the "anonymous_ballots" edges to the AnonymousBallot entity.
+func (_u *PollUpdate) AddAnonymousBallots(v ...*AnonymousBallot) *PollUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnonymousBallotIDs(ids...)
+}
+
+// AddBallotLogIDs adds the "ballot_log" edge to the BallotLogEntry entity by IDs.
+func (_u *PollUpdate) AddBallotLogIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddBallotLogIDs(ids...)
+	return _u
+}
+
+// AddBallotLog adds the "ballot_log" edges to the BallotLogEntry entity.
+func (_u *PollUpdate) AddBallotLog(v ...*BallotLogEntry) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotLogIDs(ids...)
+}
+
+// AddGuestVoterIDs adds the "guest_voters" edge to the GuestVoter entity by IDs.
+func (_u *PollUpdate) AddGuestVoterIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddGuestVoterIDs(ids...)
+	return _u
+}
+
+// AddGuestVoters adds the "guest_voters" edges to the GuestVoter entity.
+func (_u *PollUpdate) AddGuestVoters(v ...*GuestVoter) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGuestVoterIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the PollMember entity by IDs.
+func (_u *PollUpdate) AddMemberIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the PollMember entity.
+func (_u *PollUpdate) AddMembers(v ...*PollMember) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// AddCollaboratorIDs adds the "collaborators" edge to the PollCollaborator entity by IDs.
+func (_u *PollUpdate) AddCollaboratorIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddCollaboratorIDs(ids...)
+	return _u
+}
+
+// AddCollaborators adds the "collaborators" edges to the PollCollaborator entity.
+func (_u *PollUpdate) AddCollaborators(v ...*PollCollaborator) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollaboratorIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_u *PollUpdate) AddShareLinkIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddShareLinkIDs(ids...)
+	return _u
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_u *PollUpdate) AddShareLinks(v ...*ShareLink) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareLinkIDs(ids...)
+}
+
 // Mutation returns the PollMutation object of the builder.
-func (_u *PollUpdateOne) Mutation() *PollMutation {
+func (_u *PollUpdate) Mutation() *PollMutation {
 	return _u.mutation
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *PollUpdate) ClearOrganization() *PollUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // ClearOptions clears all "options" edges to the PollOption entity.
-func (_u *PollUpdateOne) ClearOptions() *PollUpdateOne {
+func (_u *PollUpdate) ClearOptions() *PollUpdate {
 	_u.mutation.ClearOptions()
 	return _u
 }
 
 // RemoveOptionIDs removes the "options" edge to PollOption entities by IDs.
-func (_u *PollUpdateOne) RemoveOptionIDs(ids ...int) *PollUpdateOne {
+func (_u *PollUpdate) RemoveOptionIDs(ids ...int) *PollUpdate {
 	_u.mutation.RemoveOptionIDs(ids...)
 	return _u
 }
 
 // RemoveOptions removes "options" edges to PollOption entities.
-func (_u *PollUpdateOne) RemoveOptions(v ...*PollOption) *PollUpdateOne {
+func (_u *PollUpdate) RemoveOptions(v ...*PollOption) *PollUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
@@ -451,19 +664,19 @@ func (_u *PollUpdateOne) RemoveOptions(v ...*PollOption) *PollUpdateOne {
 }
 
 // ClearVotes clears all "votes" edges to the Vote entity.
-func (_u *PollUpdateOne) ClearVotes() *PollUpdateOne {
+func (_u *PollUpdate) ClearVotes() *PollUpdate {
 	_u.mutation.ClearVotes()
 	return _u
 }
 
 // RemoveVoteIDs removes the "votes" edge to Vote entities by IDs.
-func (_u *PollUpdateOne) RemoveVoteIDs(ids ...int) *PollUpdateOne {
+func (_u *PollUpdate) RemoveVoteIDs(ids ...int) *PollUpdate {
 	_u.mutation.RemoveVoteIDs(ids...)
 	return _u
 }
 
 // RemoveVotes removes "votes" edges to Vote entities.
-func (_u *PollUpdateOne) RemoveVotes(v ...*Vote) *PollUpdateOne {
+func (_u *PollUpdate) RemoveVotes(v ...*Vote) *PollUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
@@ -471,65 +684,262 @@ func (_u *PollUpdateOne) RemoveVotes(v ...*Vote) *PollUpdateOne {
 	return _u.RemoveVoteIDs(ids...)
 }
 
-// Where appends a list predicates to the PollUpdate builder.
-func (_u *PollUpdateOne) Where(ps ...predicate.Poll) *PollUpdateOne {
-	_u.mutation.Where(ps...)
+// ClearBallots clears all "ballots" edges to the Ballot entity.
+func (_u *PollUpdate) ClearBallots() *PollUpdate {
+	_u.mutation.ClearBallots()
 	return _u
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *PollUpdateOne) Select(field string, fields ...string) *PollUpdateOne {
-	_u.fields = append([]string{field}, fields...)
+// RemoveBallotIDs removes the "ballots" edge to Ballot entities by IDs.
+func (_u *PollUpdate) RemoveBallotIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveBallotIDs(ids...)
 	return _u
 }
 
-// Save executes the query and returns the updated Poll entity.
-func (_u *PollUpdateOne) Save(ctx context.Context) (*Poll, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// RemoveBallots removes "ballots" edges to Ballot entities.
+func (_u *PollUpdate) RemoveBallots(v ...*Ballot) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotIDs(ids...)
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *PollUpdateOne) SaveX(ctx context.Context) *Poll {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// ClearTransitions clears all "transitions" edges to the PollTransition entity.
+func (_u *PollUpdate) ClearTransitions() *PollUpdate {
+	_u.mutation.ClearTransitions()
+	return _u
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to PollTransition entities by IDs.
+func (_u *PollUpdate) RemoveTransitionIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveTransitionIDs(ids...)
+	return _u
+}
+
+// RemoveTransitions removes "transitions" edges to PollTransition entities.
+func (_u *PollUpdate) RemoveTransitions(v ...*PollTransition) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return node
+	return _u.RemoveTransitionIDs(ids...)
 }
 
-// Exec executes the query on the entity.
-func (_u *PollUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearParticipations clears all "participations" edges to the Participation entity.
+func (_u *PollUpdate) ClearParticipations() *PollUpdate {
+	_u.mutation.ClearParticipations()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *PollUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// RemoveParticipationIDs removes the "participations" edge to Participation entities by IDs.
+func (_u *PollUpdate) RemoveParticipationIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveParticipationIDs(ids...)
+	return _u
+}
+
+// RemoveParticipations removes "participations" edges to Participation entities.
+func (_u *PollUpdate) RemoveParticipations(v ...*Participation) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveParticipationIDs(ids...)
 }
 
-func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
-	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Poll.id" for update`)}
+// ClearAnonymousBallots clears all "anonymous_ballots" edges to the AnonymousBallot entity.
+func (_u *PollUpdate) ClearAnonymousBallots() *PollUpdate {
+	_u.mutation.ClearAnonymousBallots()
+	return _u
+}
+
+// RemoveAnonymousBallotIDs removes the "anonymous_ballots" edge to AnonymousBallot entities by IDs.
+func (_u *PollUpdate) RemoveAnonymousBallotIDs(ids ...string) *PollUpdate {
+	_u.mutation.RemoveAnonymousBallotIDs(ids...)
+	return _u
+}
+
+// RemoveAnonymousBallots removes "anonymous_ballots" edges to AnonymousBallot entities.
+func (_u *PollUpdate) RemoveAnonymousBallots(v ...*AnonymousBallot) *PollUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, poll.FieldID)
-		for _, f := range fields {
-			if !poll.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != poll.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	return _u.RemoveAnonymousBallotIDs(ids...)
+}
+
+// ClearBallotLog clears all "ballot_log" edges to the BallotLogEntry entity.
+func (_u *PollUpdate) ClearBallotLog() *PollUpdate {
+	_u.mutation.ClearBallotLog()
+	return _u
+}
+
+// RemoveBallotLogIDs removes the "ballot_log" edge to BallotLogEntry entities by IDs.
+func (_u *PollUpdate) RemoveBallotLogIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveBallotLogIDs(ids...)
+	return _u
+}
+
+// RemoveBallotLog removes "ballot_log" edges to BallotLogEntry entities.
+func (_u *PollUpdate) RemoveBallotLog(v ...*BallotLogEntry) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotLogIDs(ids...)
+}
+
+// ClearGuestVoters clears all "guest_voters" edges to the GuestVoter entity.
+func (_u *PollUpdate) ClearGuestVoters() *PollUpdate {
+	_u.mutation.ClearGuestVoters()
+	return _u
+}
+
+// RemoveGuestVoterIDs removes the "guest_voters" edge to GuestVoter entities by IDs.
+func (_u *PollUpdate) RemoveGuestVoterIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveGuestVoterIDs(ids...)
+	return _u
+}
+
+// RemoveGuestVoters removes "guest_voters" edges to GuestVoter entities.
+func (_u *PollUpdate) RemoveGuestVoters(v ...*GuestVoter) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGuestVoterIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to the PollMember entity.
+func (_u *PollUpdate) ClearMembers() *PollUpdate {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to PollMember entities by IDs.
+func (_u *PollUpdate) RemoveMemberIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to PollMember entities.
+func (_u *PollUpdate) RemoveMembers(v ...*PollMember) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// ClearCollaborators clears all "collaborators" edges to the PollCollaborator entity.
+func (_u *PollUpdate) ClearCollaborators() *PollUpdate {
+	_u.mutation.ClearCollaborators()
+	return _u
+}
+
+// RemoveCollaboratorIDs removes the "collaborators" edge to PollCollaborator entities by IDs.
+func (_u *PollUpdate) RemoveCollaboratorIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveCollaboratorIDs(ids...)
+	return _u
+}
+
+// RemoveCollaborators removes "collaborators" edges to PollCollaborator entities.
+func (_u *PollUpdate) RemoveCollaborators(v ...*PollCollaborator) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollaboratorIDs(ids...)
+}
+
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (_u *PollUpdate) ClearShareLinks() *PollUpdate {
+	_u.mutation.ClearShareLinks()
+	return _u
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (_u *PollUpdate) RemoveShareLinkIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveShareLinkIDs(ids...)
+	return _u
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (_u *PollUpdate) RemoveShareLinks(v ...*ShareLink) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareLinkIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PollUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PollUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollUpdate) check() error {
+	if v, ok := _u.mutation.VotingMethod(); ok {
+		if err := poll.VotingMethodValidator(v); err != nil {
+			return &ValidationError{Name: "voting_method", err: fmt.Errorf(`ent: validator failed for field "Poll.voting_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TallyRule(); ok {
+		if err := poll.TallyRuleValidator(v); err != nil {
+			return &ValidationError{Name: "tally_rule", err: fmt.Errorf(`ent: validator failed for field "Poll.tally_rule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PassThreshold(); ok {
+		if err := poll.PassThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "pass_threshold", err: fmt.Errorf(`ent: validator failed for field "Poll.pass_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := poll.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResultsVisibility(); ok {
+		if err := poll.ResultsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "results_visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.results_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GuestDedupe(); ok {
+		if err := poll.GuestDedupeValidator(v); err != nil {
+			return &ValidationError{Name: "guest_dedupe", err: fmt.Errorf(`ent: validator failed for field "Poll.guest_dedupe": %w`, err)}
 		}
 	}
+	return nil
+}
+
+func (_u *PollUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -549,9 +959,128 @@ func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
 	if value, ok := _u.mutation.AddedCreatedBy(); ok {
 		_spec.AddField(poll.FieldCreatedBy, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.MinChoices(); ok {
+		_spec.SetField(poll.FieldMinChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinChoices(); ok {
+		_spec.AddField(poll.FieldMinChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxChoices(); ok {
+		_spec.SetField(poll.FieldMaxChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxChoices(); ok {
+		_spec.AddField(poll.FieldMaxChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VotingMethod(); ok {
+		_spec.SetField(poll.FieldVotingMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TallyRule(); ok {
+		_spec.SetField(poll.FieldTallyRule, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScoreMin(); ok {
+		_spec.SetField(poll.FieldScoreMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScoreMin(); ok {
+		_spec.AddField(poll.FieldScoreMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScoreMax(); ok {
+		_spec.SetField(poll.FieldScoreMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScoreMax(); ok {
+		_spec.AddField(poll.FieldScoreMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PassThreshold(); ok {
+		_spec.SetField(poll.FieldPassThreshold, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PassPercentage(); ok {
+		_spec.SetField(poll.FieldPassPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPassPercentage(); ok {
+		_spec.AddField(poll.FieldPassPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(poll.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(poll.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CloseEventAt(); ok {
+		_spec.SetField(poll.FieldCloseEventAt, field.TypeTime, value)
+	}
+	if _u.mutation.CloseEventAtCleared() {
+		_spec.ClearField(poll.FieldCloseEventAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(poll.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(poll.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(poll.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ResultsVisibility(); ok {
+		_spec.SetField(poll.FieldResultsVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AllowGuests(); ok {
+		_spec.SetField(poll.FieldAllowGuests, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.GuestDedupe(); ok {
+		_spec.SetField(poll.FieldGuestDedupe, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(poll.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(poll.FieldRevision, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poll.OrganizationTable,
+			Columns: []string{poll.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poll.OrganizationTable,
+			Columns: []string{poll.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -642,6 +1171,1997 @@ func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotsIDs(); len(nodes) > 0 && !_u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.TransitionsTable,
+			Columns: []string{poll.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !_u.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.TransitionsTable,
+			Columns: []string{poll.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.TransitionsTable,
+			Columns: []string{poll.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !_u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnonymousBallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.AnonymousBallotsTable,
+			Columns: []string{poll.AnonymousBallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnonymousBallotsIDs(); len(nodes) > 0 && !_u.mutation.AnonymousBallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.AnonymousBallotsTable,
+			Columns: []string{poll.AnonymousBallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnonymousBallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.AnonymousBallotsTable,
+			Columns: []string{poll.AnonymousBallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BallotLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotLogTable,
+			Columns: []string{poll.BallotLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotLogIDs(); len(nodes) > 0 && !_u.mutation.BallotLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotLogTable,
+			Columns: []string{poll.BallotLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotLogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotLogTable,
+			Columns: []string{poll.BallotLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GuestVotersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.GuestVotersTable,
+			Columns: []string{poll.GuestVotersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGuestVotersIDs(); len(nodes) > 0 && !_u.mutation.GuestVotersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.GuestVotersTable,
+			Columns: []string{poll.GuestVotersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVotersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.GuestVotersTable,
+			Columns: []string{poll.GuestVotersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.MembersTable,
+			Columns: []string{poll.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.MembersTable,
+			Columns: []string{poll.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.MembersTable,
+			Columns: []string{poll.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.CollaboratorsTable,
+			Columns: []string{poll.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollaboratorsIDs(); len(nodes) > 0 && !_u.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.CollaboratorsTable,
+			Columns: []string{poll.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollaboratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.CollaboratorsTable,
+			Columns: []string{poll.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ShareLinksTable,
+			Columns: []string{poll.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !_u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ShareLinksTable,
+			Columns: []string{poll.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ShareLinksTable,
+			Columns: []string{poll.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poll.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PollUpdateOne is the builder for updating a single Poll entity.
+type PollUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PollMutation
+}
+
+// SetTitle sets the "title" field.
+func (_u *PollUpdateOne) SetTitle(v string) *PollUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableTitle(v *string) *PollUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PollUpdateOne) SetDescription(v string) *PollUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableDescription(v *string) *PollUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PollUpdateOne) SetCreatedBy(v int) *PollUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableCreatedBy(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *PollUpdateOne) AddCreatedBy(v int) *PollUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *PollUpdateOne) SetOrganizationID(v int) *PollUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableOrganizationID(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *PollUpdateOne) ClearOrganizationID() *PollUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetMinChoices sets the "min_choices" field.
+func (_u *PollUpdateOne) SetMinChoices(v int) *PollUpdateOne {
+	_u.mutation.ResetMinChoices()
+	_u.mutation.SetMinChoices(v)
+	return _u
+}
+
+// SetNillableMinChoices sets the "min_choices" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableMinChoices(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetMinChoices(*v)
+	}
+	return _u
+}
+
+// AddMinChoices adds value to the "min_choices" field.
+func (_u *PollUpdateOne) AddMinChoices(v int) *PollUpdateOne {
+	_u.mutation.AddMinChoices(v)
+	return _u
+}
+
+// SetMaxChoices sets the "max_choices" field.
+func (_u *PollUpdateOne) SetMaxChoices(v int) *PollUpdateOne {
+	_u.mutation.ResetMaxChoices()
+	_u.mutation.SetMaxChoices(v)
+	return _u
+}
+
+// SetNillableMaxChoices sets the "max_choices" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableMaxChoices(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetMaxChoices(*v)
+	}
+	return _u
+}
+
+// AddMaxChoices adds value to the "max_choices" field.
+func (_u *PollUpdateOne) AddMaxChoices(v int) *PollUpdateOne {
+	_u.mutation.AddMaxChoices(v)
+	return _u
+}
+
+// SetVotingMethod sets the "voting_method" field.
+func (_u *PollUpdateOne) SetVotingMethod(v poll.VotingMethod) *PollUpdateOne {
+	_u.mutation.SetVotingMethod(v)
+	return _u
+}
+
+// SetNillableVotingMethod sets the "voting_method" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableVotingMethod(v *poll.VotingMethod) *PollUpdateOne {
+	if v != nil {
+		_u.SetVotingMethod(*v)
+	}
+	return _u
+}
+
+// SetTallyRule sets the "tally_rule" field.
+func (_u *PollUpdateOne) SetTallyRule(v poll.TallyRule) *PollUpdateOne {
+	_u.mutation.SetTallyRule(v)
+	return _u
+}
+
+// SetNillableTallyRule sets the "tally_rule" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableTallyRule(v *poll.TallyRule) *PollUpdateOne {
+	if v != nil {
+		_u.SetTallyRule(*v)
+	}
+	return _u
+}
+
+// SetScoreMin sets the "score_min" field.
+func (_u *PollUpdateOne) SetScoreMin(v int) *PollUpdateOne {
+	_u.mutation.ResetScoreMin()
+	_u.mutation.SetScoreMin(v)
+	return _u
+}
+
+// SetNillableScoreMin sets the "score_min" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableScoreMin(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetScoreMin(*v)
+	}
+	return _u
+}
+
+// AddScoreMin adds value to the "score_min" field.
+func (_u *PollUpdateOne) AddScoreMin(v int) *PollUpdateOne {
+	_u.mutation.AddScoreMin(v)
+	return _u
+}
+
+// SetScoreMax sets the "score_max" field.
+func (_u *PollUpdateOne) SetScoreMax(v int) *PollUpdateOne {
+	_u.mutation.ResetScoreMax()
+	_u.mutation.SetScoreMax(v)
+	return _u
+}
+
+// SetNillableScoreMax sets the "score_max" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableScoreMax(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetScoreMax(*v)
+	}
+	return _u
+}
+
+// AddScoreMax adds value to the "score_max" field.
+func (_u *PollUpdateOne) AddScoreMax(v int) *PollUpdateOne {
+	_u.mutation.AddScoreMax(v)
+	return _u
+}
+
+// SetPassThreshold sets the "pass_threshold" field.
+func (_u *PollUpdateOne) SetPassThreshold(v poll.PassThreshold) *PollUpdateOne {
+	_u.mutation.SetPassThreshold(v)
+	return _u
+}
+
+// SetNillablePassThreshold sets the "pass_threshold" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillablePassThreshold(v *poll.PassThreshold) *PollUpdateOne {
+	if v != nil {
+		_u.SetPassThreshold(*v)
+	}
+	return _u
+}
+
+// SetPassPercentage sets the "pass_percentage" field.
+func (_u *PollUpdateOne) SetPassPercentage(v int) *PollUpdateOne {
+	_u.mutation.ResetPassPercentage()
+	_u.mutation.SetPassPercentage(v)
+	return _u
+}
+
+// SetNillablePassPercentage sets the "pass_percentage" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillablePassPercentage(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetPassPercentage(*v)
+	}
+	return _u
+}
+
+// AddPassPercentage adds value to the "pass_percentage" field.
+func (_u *PollUpdateOne) AddPassPercentage(v int) *PollUpdateOne {
+	_u.mutation.AddPassPercentage(v)
+	return _u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_u *PollUpdateOne) SetOpensAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableOpensAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetOpensAt(*v)
+	}
+	return _u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *PollUpdateOne) ClearOpensAt() *PollUpdateOne {
+	_u.mutation.ClearOpensAt()
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *PollUpdateOne) SetClosesAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableClosesAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *PollUpdateOne) ClearClosesAt() *PollUpdateOne {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
+// SetCloseEventAt sets the "close_event_at" field.
+func (_u *PollUpdateOne) SetCloseEventAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetCloseEventAt(v)
+	return _u
+}
+
+// SetNillableCloseEventAt sets the "close_event_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableCloseEventAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetCloseEventAt(*v)
+	}
+	return _u
+}
+
+// ClearCloseEventAt clears the value of the "close_event_at" field.
+func (_u *PollUpdateOne) ClearCloseEventAt() *PollUpdateOne {
+	_u.mutation.ClearCloseEventAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PollUpdateOne) SetClosedAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableClosedAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PollUpdateOne) ClearClosedAt() *PollUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *PollUpdateOne) SetArchivedAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableArchivedAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *PollUpdateOne) ClearArchivedAt() *PollUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *PollUpdateOne) SetVisibility(v poll.Visibility) *PollUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableVisibility(v *poll.Visibility) *PollUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetResultsVisibility sets the "results_visibility" field.
+func (_u *PollUpdateOne) SetResultsVisibility(v poll.ResultsVisibility) *PollUpdateOne {
+	_u.mutation.SetResultsVisibility(v)
+	return _u
+}
+
+// SetNillableResultsVisibility sets the "results_visibility" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableResultsVisibility(v *poll.ResultsVisibility) *PollUpdateOne {
+	if v != nil {
+		_u.SetResultsVisibility(*v)
+	}
+	return _u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_u *PollUpdateOne) SetAnonymous(v bool) *PollUpdateOne {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableAnonymous(v *bool) *PollUpdateOne {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
+// SetAllowGuests sets the "allow_guests" field.
+func (_u *PollUpdateOne) SetAllowGuests(v bool) *PollUpdateOne {
+	_u.mutation.SetAllowGuests(v)
+	return _u
+}
+
+// SetNillableAllowGuests sets the "allow_guests" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableAllowGuests(v *bool) *PollUpdateOne {
+	if v != nil {
+		_u.SetAllowGuests(*v)
+	}
+	return _u
+}
+
+// SetGuestDedupe sets the "guest_dedupe" field.
+func (_u *PollUpdateOne) SetGuestDedupe(v poll.GuestDedupe) *PollUpdateOne {
+	_u.mutation.SetGuestDedupe(v)
+	return _u
+}
+
+// SetNillableGuestDedupe sets the "guest_dedupe" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableGuestDedupe(v *poll.GuestDedupe) *PollUpdateOne {
+	if v != nil {
+		_u.SetGuestDedupe(*v)
+	}
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *PollUpdateOne) SetRevision(v int) *PollUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableRevision(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *PollUpdateOne) AddRevision(v int) *PollUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollUpdateOne) SetCreatedAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableCreatedAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *PollUpdateOne) SetOrganization(v *Organization) *PollUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
+func (_u *PollUpdateOne) AddOptionIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddOptionIDs(ids...)
+	return _u
+}
+
+// AddOptions adds the "options" edges to the PollOption entity.
+func (_u *PollUpdateOne) AddOptions(v ...*PollOption) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOptionIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
+func (_u *PollUpdateOne) AddVoteIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddVoteIDs(ids...)
+	return _u
+}
+
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_u *PollUpdateOne) AddVotes(v ...*Vote) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
+}
+
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_u *PollUpdateOne) AddBallotIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddBallotIDs(ids...)
+	return _u
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_u *PollUpdateOne) AddBallots(v ...*Ballot) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the PollTransition entity by IDs.
+func (_u *PollUpdateOne) AddTransitionIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddTransitionIDs(ids...)
+	return _u
+}
+
+// AddTransitions adds the "transitions" edges to the PollTransition entity.
+func (_u *PollUpdateOne) AddTransitions(v ...*PollTransition) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransitionIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_u *PollUpdateOne) AddParticipationIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddParticipationIDs(ids...)
+	return _u
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_u *PollUpdateOne) AddParticipations(v ...*Participation) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipationIDs(ids...)
+}
+
+// AddAnonymousBallotIDs adds the "anonymous_ballots" edge to the AnonymousBallot entity by IDs.
+func (_u *PollUpdateOne) AddAnonymousBallotIDs(ids ...string) *PollUpdateOne {
+	_u.mutation.AddAnonymousBallotIDs(ids...)
+	return _u
+}
+
+// AddAnonymousBallots adds the "anonymous_ballots" edges to the AnonymousBallot entity.
+func (_u *PollUpdateOne) AddAnonymousBallots(v ...*AnonymousBallot) *PollUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnonymousBallotIDs(ids...)
+}
+
+// AddBallotLogIDs adds the "ballot_log" edge to the BallotLogEntry entity by IDs.
+func (_u *PollUpdateOne) AddBallotLogIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddBallotLogIDs(ids...)
+	return _u
+}
+
+// AddBallotLog adds the "ballot_log" edges to the BallotLogEntry entity.
+func (_u *PollUpdateOne) AddBallotLog(v ...*BallotLogEntry) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotLogIDs(ids...)
+}
+
+// AddGuestVoterIDs adds the "guest_voters" edge to the GuestVoter entity by IDs.
+func (_u *PollUpdateOne) AddGuestVoterIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddGuestVoterIDs(ids...)
+	return _u
+}
+
+// AddGuestVoters adds the "guest_voters" edges to the GuestVoter entity.
+func (_u *PollUpdateOne) AddGuestVoters(v ...*GuestVoter) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGuestVoterIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the PollMember entity by IDs.
+func (_u *PollUpdateOne) AddMemberIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the PollMember entity.
+func (_u *PollUpdateOne) AddMembers(v ...*PollMember) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// AddCollaboratorIDs adds the "collaborators" edge to the PollCollaborator entity by IDs.
+func (_u *PollUpdateOne) AddCollaboratorIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddCollaboratorIDs(ids...)
+	return _u
+}
+
+// AddCollaborators adds the "collaborators" edges to the PollCollaborator entity.
+func (_u *PollUpdateOne) AddCollaborators(v ...*PollCollaborator) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollaboratorIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_u *PollUpdateOne) AddShareLinkIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddShareLinkIDs(ids...)
+	return _u
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_u *PollUpdateOne) AddShareLinks(v ...*ShareLink) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareLinkIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (_u *PollUpdateOne) Mutation() *PollMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *PollUpdateOne) ClearOrganization() *PollUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearOptions clears all "options" edges to the PollOption entity.
+func (_u *PollUpdateOne) ClearOptions() *PollUpdateOne {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// RemoveOptionIDs removes the "options" edge to PollOption entities by IDs.
+func (_u *PollUpdateOne) RemoveOptionIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveOptionIDs(ids...)
+	return _u
+}
+
+// RemoveOptions removes "options" edges to PollOption entities.
+func (_u *PollUpdateOne) RemoveOptions(v ...*PollOption) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOptionIDs(ids...)
+}
+
+// ClearVotes clears all "votes" edges to the Vote entity.
+func (_u *PollUpdateOne) ClearVotes() *PollUpdateOne {
+	_u.mutation.ClearVotes()
+	return _u
+}
+
+// RemoveVoteIDs removes the "votes" edge to Vote entities by IDs.
+func (_u *PollUpdateOne) RemoveVoteIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveVoteIDs(ids...)
+	return _u
+}
+
+// RemoveVotes removes "votes" edges to Vote entities.
+func (_u *PollUpdateOne) RemoveVotes(v ...*Vote) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteIDs(ids...)
+}
+
+// ClearBallots clears all "ballots" edges to the Ballot entity.
+func (_u *PollUpdateOne) ClearBallots() *PollUpdateOne {
+	_u.mutation.ClearBallots()
+	return _u
+}
+
+// RemoveBallotIDs removes the "ballots" edge to Ballot entities by IDs.
+func (_u *PollUpdateOne) RemoveBallotIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveBallotIDs(ids...)
+	return _u
+}
+
+// RemoveBallots removes "ballots" edges to Ballot entities.
+func (_u *PollUpdateOne) RemoveBallots(v ...*Ballot) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotIDs(ids...)
+}
+
+// ClearTransitions clears all "transitions" edges to the PollTransition entity.
+func (_u *PollUpdateOne) ClearTransitions() *PollUpdateOne {
+	_u.mutation.ClearTransitions()
+	return _u
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to PollTransition entities by IDs.
+func (_u *PollUpdateOne) RemoveTransitionIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveTransitionIDs(ids...)
+	return _u
+}
+
+// RemoveTransitions removes "transitions" edges to PollTransition entities.
+func (_u *PollUpdateOne) RemoveTransitions(v ...*PollTransition) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransitionIDs(ids...)
+}
+
+// ClearParticipations clears all "participations" edges to the Participation entity.
+func (_u *PollUpdateOne) ClearParticipations() *PollUpdateOne {
+	_u.mutation.ClearParticipations()
+	return _u
+}
+
+// RemoveParticipationIDs removes the "participations" edge to Participation entities by IDs.
+func (_u *PollUpdateOne) RemoveParticipationIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveParticipationIDs(ids...)
+	return _u
+}
+
+// RemoveParticipations removes "participations" edges to Participation entities.
+func (_u *PollUpdateOne) RemoveParticipations(v ...*Participation) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipationIDs(ids...)
+}
+
+// ClearAnonymousBallots clears all "anonymous_ballots" edges to the AnonymousBallot entity.
+func (_u *PollUpdateOne) ClearAnonymousBallots() *PollUpdateOne {
+	_u.mutation.ClearAnonymousBallots()
+	return _u
+}
+
+// RemoveAnonymousBallotIDs removes the "anonymous_ballots" edge to AnonymousBallot entities by IDs.
+func (_u *PollUpdateOne) RemoveAnonymousBallotIDs(ids ...string) *PollUpdateOne {
+	_u.mutation.RemoveAnonymousBallotIDs(ids...)
+	return _u
+}
+
+// RemoveAnonymousBallots removes "anonymous_ballots" edges to AnonymousBallot entities.
+func (_u *PollUpdateOne) RemoveAnonymousBallots(v ...*AnonymousBallot) *PollUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnonymousBallotIDs(ids...)
+}
+
+// ClearBallotLog clears all "ballot_log" edges to the BallotLogEntry entity.
+func (_u *PollUpdateOne) ClearBallotLog() *PollUpdateOne {
+	_u.mutation.ClearBallotLog()
+	return _u
+}
+
+// RemoveBallotLogIDs removes the "ballot_log" edge to BallotLogEntry entities by IDs.
+func (_u *PollUpdateOne) RemoveBallotLogIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveBallotLogIDs(ids...)
+	return _u
+}
+
+// RemoveBallotLog removes "ballot_log" edges to BallotLogEntry entities.
+func (_u *PollUpdateOne) RemoveBallotLog(v ...*BallotLogEntry) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotLogIDs(ids...)
+}
+
+// ClearGuestVoters clears all "guest_voters" edges to the GuestVoter entity.
+func (_u *PollUpdateOne) ClearGuestVoters() *PollUpdateOne {
+	_u.mutation.ClearGuestVoters()
+	return _u
+}
+
+// RemoveGuestVoterIDs removes the "guest_voters" edge to GuestVoter entities by IDs.
+func (_u *PollUpdateOne) RemoveGuestVoterIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveGuestVoterIDs(ids...)
+	return _u
+}
+
+// RemoveGuestVoters removes "guest_voters" edges to GuestVoter entities.
+func (_u *PollUpdateOne) RemoveGuestVoters(v ...*GuestVoter) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGuestVoterIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to the PollMember entity.
+func (_u *PollUpdateOne) ClearMembers() *PollUpdateOne {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to PollMember entities by IDs.
+func (_u *PollUpdateOne) RemoveMemberIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to PollMember entities.
+func (_u *PollUpdateOne) RemoveMembers(v ...*PollMember) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// ClearCollaborators clears all "collaborators" edges to the PollCollaborator entity.
+func (_u *PollUpdateOne) ClearCollaborators() *PollUpdateOne {
+	_u.mutation.ClearCollaborators()
+	return _u
+}
+
+// RemoveCollaboratorIDs removes the "collaborators" edge to PollCollaborator entities by IDs.
+func (_u *PollUpdateOne) RemoveCollaboratorIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveCollaboratorIDs(ids...)
+	return _u
+}
+
+// RemoveCollaborators removes "collaborators" edges to PollCollaborator entities.
+func (_u *PollUpdateOne) RemoveCollaborators(v ...*PollCollaborator) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollaboratorIDs(ids...)
+}
+
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (_u *PollUpdateOne) ClearShareLinks() *PollUpdateOne {
+	_u.mutation.ClearShareLinks()
+	return _u
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (_u *PollUpdateOne) RemoveShareLinkIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveShareLinkIDs(ids...)
+	return _u
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (_u *PollUpdateOne) RemoveShareLinks(v ...*ShareLink) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareLinkIDs(ids...)
+}
+
+// Where appends a list predicates to the PollUpdate builder.
+func (_u *PollUpdateOne) Where(ps ...predicate.Poll) *PollUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PollUpdateOne) Select(field string, fields ...string) *PollUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Poll entity.
+func (_u *PollUpdateOne) Save(ctx context.Context) (*Poll, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollUpdateOne) SaveX(ctx context.Context) *Poll {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PollUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollUpdateOne) check() error {
+	if v, ok := _u.mutation.VotingMethod(); ok {
+		if err := poll.VotingMethodValidator(v); err != nil {
+			return &ValidationError{Name: "voting_method", err: fmt.Errorf(`ent: validator failed for field "Poll.voting_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TallyRule(); ok {
+		if err := poll.TallyRuleValidator(v); err != nil {
+			return &ValidationError{Name: "tally_rule", err: fmt.Errorf(`ent: validator failed for field "Poll.tally_rule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PassThreshold(); ok {
+		if err := poll.PassThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "pass_threshold", err: fmt.Errorf(`ent: validator failed for field "Poll.pass_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := poll.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResultsVisibility(); ok {
+		if err := poll.ResultsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "results_visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.results_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GuestDedupe(); ok {
+		if err := poll.GuestDedupeValidator(v); err != nil {
+			return &ValidationError{Name: "guest_dedupe", err: fmt.Errorf(`ent: validator failed for field "Poll.guest_dedupe": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Poll.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, poll.FieldID)
+		for _, f := range fields {
+			if !poll.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != poll.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(poll.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(poll.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(poll.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(poll.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MinChoices(); ok {
+		_spec.SetField(poll.FieldMinChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinChoices(); ok {
+		_spec.AddField(poll.FieldMinChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxChoices(); ok {
+		_spec.SetField(poll.FieldMaxChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxChoices(); ok {
+		_spec.AddField(poll.FieldMaxChoices, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VotingMethod(); ok {
+		_spec.SetField(poll.FieldVotingMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TallyRule(); ok {
+		_spec.SetField(poll.FieldTallyRule, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScoreMin(); ok {
+		_spec.SetField(poll.FieldScoreMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScoreMin(); ok {
+		_spec.AddField(poll.FieldScoreMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScoreMax(); ok {
+		_spec.SetField(poll.FieldScoreMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScoreMax(); ok {
+		_spec.AddField(poll.FieldScoreMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PassThreshold(); ok {
+		_spec.SetField(poll.FieldPassThreshold, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PassPercentage(); ok {
+		_spec.SetField(poll.FieldPassPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPassPercentage(); ok {
+		_spec.AddField(poll.FieldPassPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(poll.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(poll.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CloseEventAt(); ok {
+		_spec.SetField(poll.FieldCloseEventAt, field.TypeTime, value)
+	}
+	if _u.mutation.CloseEventAtCleared() {
+		_spec.ClearField(poll.FieldCloseEventAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(poll.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(poll.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(poll.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ResultsVisibility(); ok {
+		_spec.SetField(poll.FieldResultsVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AllowGuests(); ok {
+		_spec.SetField(poll.FieldAllowGuests, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.GuestDedupe(); ok {
+		_spec.SetField(poll.FieldGuestDedupe, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(poll.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(poll.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poll.OrganizationTable,
+			Columns: []string{poll.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poll.OrganizationTable,
+			Columns: []string{poll.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !_u.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotsIDs(); len(nodes) > 0 && !_u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.TransitionsTable,
+			Columns: []string{poll.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !_u.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.TransitionsTable,
+			Columns: []string{poll.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.TransitionsTable,
+			Columns: []string{poll.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !_u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnonymousBallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.AnonymousBallotsTable,
+			Columns: []string{poll.AnonymousBallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnonymousBallotsIDs(); len(nodes) > 0 && !_u.mutation.AnonymousBallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.AnonymousBallotsTable,
+			Columns: []string{poll.AnonymousBallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnonymousBallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.AnonymousBallotsTable,
+			Columns: []string{poll.AnonymousBallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousballot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BallotLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotLogTable,
+			Columns: []string{poll.BallotLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotLogIDs(); len(nodes) > 0 && !_u.mutation.BallotLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotLogTable,
+			Columns: []string{poll.BallotLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotLogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.BallotLogTable,
+			Columns: []string{poll.BallotLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotlogentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GuestVotersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.GuestVotersTable,
+			Columns: []string{poll.GuestVotersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGuestVotersIDs(); len(nodes) > 0 && !_u.mutation.GuestVotersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.GuestVotersTable,
+			Columns: []string{poll.GuestVotersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVotersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.GuestVotersTable,
+			Columns: []string{poll.GuestVotersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.MembersTable,
+			Columns: []string{poll.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.MembersTable,
+			Columns: []string{poll.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.MembersTable,
+			Columns: []string{poll.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.CollaboratorsTable,
+			Columns: []string{poll.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollaboratorsIDs(); len(nodes) > 0 && !_u.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.CollaboratorsTable,
+			Columns: []string{poll.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollaboratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.CollaboratorsTable,
+			Columns: []string{poll.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ShareLinksTable,
+			Columns: []string{poll.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !_u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ShareLinksTable,
+			Columns: []string{poll.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   poll.ShareLinksTable,
+			Columns: []string{poll.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Poll{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PollCollaboratorUpdate is the builder for updating PollCollaborator entities.
+type PollCollaboratorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PollCollaboratorMutation
+}
+
+// Where appends a list predicates to the PollCollaboratorUpdate builder.
+func (_u *PollCollaboratorUpdate) Where(ps ...predicate.PollCollaborator) *PollCollaboratorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *PollCollaboratorUpdate) SetPollID(v int) *PollCollaboratorUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *PollCollaboratorUpdate) SetNillablePollID(v *int) *PollCollaboratorUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PollCollaboratorUpdate) SetUserID(v int) *PollCollaboratorUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PollCollaboratorUpdate) SetNillableUserID(v *int) *PollCollaboratorUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *PollCollaboratorUpdate) SetRole(v pollcollaborator.Role) *PollCollaboratorUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *PollCollaboratorUpdate) SetNillableRole(v *pollcollaborator.Role) *PollCollaboratorUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollCollaboratorUpdate) SetCreatedAt(v time.Time) *PollCollaboratorUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollCollaboratorUpdate) SetNillableCreatedAt(v *time.Time) *PollCollaboratorUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *PollCollaboratorUpdate) SetPoll(v *Poll) *PollCollaboratorUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PollCollaboratorUpdate) SetUser(v *User) *PollCollaboratorUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the PollCollaboratorMutation object of the builder.
+func (_u *PollCollaboratorUpdate) Mutation() *PollCollaboratorMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *PollCollaboratorUpdate) ClearPoll() *PollCollaboratorUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PollCollaboratorUpdate) ClearUser() *PollCollaboratorUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PollCollaboratorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollCollaboratorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PollCollaboratorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollCollaboratorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollCollaboratorUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := pollcollaborator.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "PollCollaborator.role": %w`, err)}
+		}
+	}
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollCollaborator.poll"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollCollaborator.user"`)
+	}
+	return nil
+}
+
+func (_u *PollCollaboratorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollcollaborator.Table, pollcollaborator.Columns, sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(pollcollaborator.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pollcollaborator.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.PollTable,
+			Columns: []string{pollcollaborator.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.PollTable,
+			Columns: []string{pollcollaborator.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.UserTable,
+			Columns: []string{pollcollaborator.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.UserTable,
+			Columns: []string{pollcollaborator.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollcollaborator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PollCollaboratorUpdateOne is the builder for updating a single PollCollaborator entity.
+type PollCollaboratorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PollCollaboratorMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *PollCollaboratorUpdateOne) SetPollID(v int) *PollCollaboratorUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *PollCollaboratorUpdateOne) SetNillablePollID(v *int) *PollCollaboratorUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PollCollaboratorUpdateOne) SetUserID(v int) *PollCollaboratorUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PollCollaboratorUpdateOne) SetNillableUserID(v *int) *PollCollaboratorUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *PollCollaboratorUpdateOne) SetRole(v pollcollaborator.Role) *PollCollaboratorUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *PollCollaboratorUpdateOne) SetNillableRole(v *pollcollaborator.Role) *PollCollaboratorUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollCollaboratorUpdateOne) SetCreatedAt(v time.Time) *PollCollaboratorUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollCollaboratorUpdateOne) SetNillableCreatedAt(v *time.Time) *PollCollaboratorUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *PollCollaboratorUpdateOne) SetPoll(v *Poll) *PollCollaboratorUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PollCollaboratorUpdateOne) SetUser(v *User) *PollCollaboratorUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the PollCollaboratorMutation object of the builder.
+func (_u *PollCollaboratorUpdateOne) Mutation() *PollCollaboratorMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *PollCollaboratorUpdateOne) ClearPoll() *PollCollaboratorUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PollCollaboratorUpdateOne) ClearUser() *PollCollaboratorUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the PollCollaboratorUpdate builder.
+func (_u *PollCollaboratorUpdateOne) Where(ps ...predicate.PollCollaborator) *PollCollaboratorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PollCollaboratorUpdateOne) Select(field string, fields ...string) *PollCollaboratorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PollCollaborator entity.
+func (_u *PollCollaboratorUpdateOne) Save(ctx context.Context) (*PollCollaborator, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollCollaboratorUpdateOne) SaveX(ctx context.Context) *PollCollaborator {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PollCollaboratorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollCollaboratorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollCollaboratorUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := pollcollaborator.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "PollCollaborator.role": %w`, err)}
+		}
+	}
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollCollaborator.poll"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollCollaborator.user"`)
+	}
+	return nil
+}
+
+func (_u *PollCollaboratorUpdateOne) sqlSave(ctx context.Context) (_node *PollCollaborator, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollcollaborator.Table, pollcollaborator.Columns, sqlgraph.NewFieldSpec(pollcollaborator.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PollCollaborator.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pollcollaborator.FieldID)
+		for _, f := range fields {
+			if !pollcollaborator.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pollcollaborator.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(pollcollaborator.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pollcollaborator.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.PollTable,
+			Columns: []string{pollcollaborator.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.PollTable,
+			Columns: []string{pollcollaborator.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.UserTable,
+			Columns: []string{pollcollaborator.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pollcollaborator.UserTable,
+			Columns: []string{pollcollaborator.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PollCollaborator{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollcollaborator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
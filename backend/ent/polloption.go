@@ -35,9 +35,11 @@ type PollOptionEdges struct {
 	Poll *Poll `json:"poll,omitempty"`
 	// Votes holds the value of the votes edge.
 	Votes []*Vote `json:"votes,omitempty"`
+	// BallotEntries holds the value of the ballot_entries edge.
+	BallotEntries []*BallotEntry `json:"ballot_entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // PollOrErr returns the Poll value or an error if the edge
@@ -60,6 +62,15 @@ func (e PollOptionEdges) VotesOrErr() ([]*Vote, error) {
 	return nil, &NotLoadedError{edge: "votes"}
 }
 
+// BallotEntriesOrErr returns the BallotEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollOptionEdges) BallotEntriesOrErr() ([]*BallotEntry, error) {
+	if e.loadedTypes[2] {
+		return e.BallotEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "ballot_entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PollOption) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -131,6 +142,11 @@ func (_m *PollOption) QueryVotes() *VoteQuery {
 	return NewPollOptionClient(_m.config).QueryVotes(_m)
 }
 
+// QueryBallotEntries queries the "ballot_entries" edge of the PollOption entity.
+func (_m *PollOption) QueryBallotEntries() *BallotEntryQuery {
+	return NewPollOptionClient(_m.config).QueryBallotEntries(_m)
+}
+
 // Update returns a builder for updating this PollOption.
 // Note that you need to call PollOption.Unwrap() before calling this method if this PollOption
 // was returned from a transaction, and the transaction was committed or rolled back.
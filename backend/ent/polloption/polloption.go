@@ -22,6 +22,8 @@ const (
 	EdgePoll = "poll"
 	// EdgeVotes holds the string denoting the votes edge name in mutations.
 	EdgeVotes = "votes"
+	// EdgeBallotEntries holds the string denoting the ballot_entries edge name in mutations.
+	EdgeBallotEntries = "ballot_entries"
 	// Table holds the table name of the polloption in the database.
 	Table = "poll_options"
 	// PollTable is the table that holds the poll relation/edge.
@@ -38,6 +40,13 @@ const (
 	VotesInverseTable = "votes"
 	// VotesColumn is the table column denoting the votes relation/edge.
 	VotesColumn = "poll_option_id"
+	// BallotEntriesTable is the table that holds the ballot_entries relation/edge.
+	BallotEntriesTable = "ballot_entries"
+	// BallotEntriesInverseTable is the table name for the BallotEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ballotentry" package.
+	BallotEntriesInverseTable = "ballot_entries"
+	// BallotEntriesColumn is the table column denoting the ballot_entries relation/edge.
+	BallotEntriesColumn = "poll_option_id"
 )
 
 // Columns holds all SQL columns for polloption fields.
@@ -106,6 +115,20 @@ func ByVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBallotEntriesCount orders the results by ballot_entries count.
+func ByBallotEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBallotEntriesStep(), opts...)
+	}
+}
+
+// ByBallotEntries orders the results by ballot_entries terms.
+func ByBallotEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBallotEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPollStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -120,3 +143,10 @@ func newVotesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, VotesTable, VotesColumn),
 	)
 }
+func newBallotEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BallotEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BallotEntriesTable, BallotEntriesColumn),
+	)
+}
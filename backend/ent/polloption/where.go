@@ -240,6 +240,29 @@ func HasVotesWith(preds ...predicate.Vote) predicate.PollOption {
 	})
 }
 
+// HasBallotEntries applies the HasEdge predicate on the "ballot_entries" edge.
+func HasBallotEntries() predicate.PollOption {
+	return predicate.PollOption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, BallotEntriesTable, BallotEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBallotEntriesWith applies the HasEdge predicate on the "ballot_entries" edge with a given conditions (other predicates).
+func HasBallotEntriesWith(preds ...predicate.BallotEntry) predicate.PollOption {
+	return predicate.PollOption(func(s *sql.Selector) {
+		step := newBallotEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PollOption) predicate.PollOption {
 	return predicate.PollOption(sql.AndPredicates(predicates...))
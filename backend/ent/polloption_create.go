@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pollapp/backend/ent/ballotentry"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
 	"pollapp/backend/ent/vote"
@@ -67,6 +68,21 @@ func (_c *PollOptionCreate) AddVotes(v ...*Vote) *PollOptionCreate {
 	return _c.AddVoteIDs(ids...)
 }
 
+// AddBallotEntryIDs adds the "ballot_entries" edge to the BallotEntry entity by IDs.
+func (_c *PollOptionCreate) AddBallotEntryIDs(ids ...int) *PollOptionCreate {
+	_c.mutation.AddBallotEntryIDs(ids...)
+	return _c
+}
+
+// AddBallotEntries adds the "ballot_entries" edges to the BallotEntry entity.
+func (_c *PollOptionCreate) AddBallotEntries(v ...*BallotEntry) *PollOptionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBallotEntryIDs(ids...)
+}
+
 // Mutation returns the PollOptionMutation object of the builder.
 func (_c *PollOptionCreate) Mutation() *PollOptionMutation {
 	return _c.mutation
@@ -189,6 +205,22 @@ func (_c *PollOptionCreate) createSpec() (*PollOption, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BallotEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   polloption.BallotEntriesTable,
+			Columns: []string{polloption.BallotEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
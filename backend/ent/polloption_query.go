@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"pollapp/backend/ent/ballotentry"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
 	"pollapp/backend/ent/predicate"
@@ -21,12 +22,13 @@ import (
 // PollOptionQuery is the builder for querying PollOption entities.
 type PollOptionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []polloption.OrderOption
-	inters     []Interceptor
-	predicates []predicate.PollOption
-	withPoll   *PollQuery
-	withVotes  *VoteQuery
+	ctx               *QueryContext
+	order             []polloption.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.PollOption
+	withPoll          *PollQuery
+	withVotes         *VoteQuery
+	withBallotEntries *BallotEntryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (_q *PollOptionQuery) QueryVotes() *VoteQuery {
 	return query
 }
 
+// QueryBallotEntries chains the current query on the "ballot_entries" edge.
+func (_q *PollOptionQuery) QueryBallotEntries() *BallotEntryQuery {
+	query := (&BallotEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(polloption.Table, polloption.FieldID, selector),
+			sqlgraph.To(ballotentry.Table, ballotentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, polloption.BallotEntriesTable, polloption.BallotEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PollOption entity from the query.
 // Returns a *NotFoundError when no PollOption was found.
 func (_q *PollOptionQuery) First(ctx context.Context) (*PollOption, error) {
@@ -294,13 +318,14 @@ func (_q *PollOptionQuery) Clone() *PollOptionQuery {
 		return nil
 	}
 	return &PollOptionQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]polloption.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.PollOption{}, _q.predicates...),
-		withPoll:   _q.withPoll.Clone(),
-		withVotes:  _q.withVotes.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]polloption.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.PollOption{}, _q.predicates...),
+		withPoll:          _q.withPoll.Clone(),
+		withVotes:         _q.withVotes.Clone(),
+		withBallotEntries: _q.withBallotEntries.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +354,17 @@ func (_q *PollOptionQuery) WithVotes(opts ...func(*VoteQuery)) *PollOptionQuery
 	return _q
 }
 
+// WithBallotEntries tells the query-builder to eager-load the nodes that are connected to
+// the "ballot_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollOptionQuery) WithBallotEntries(opts ...func(*BallotEntryQuery)) *PollOptionQuery {
+	query := (&BallotEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBallotEntries = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (_q *PollOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*P
 	var (
 		nodes       = []*PollOption{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withPoll != nil,
 			_q.withVotes != nil,
+			_q.withBallotEntries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +480,13 @@ func (_q *PollOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*P
 			return nil, err
 		}
 	}
+	if query := _q.withBallotEntries; query != nil {
+		if err := _q.loadBallotEntries(ctx, query, nodes,
+			func(n *PollOption) { n.Edges.BallotEntries = []*BallotEntry{} },
+			func(n *PollOption, e *BallotEntry) { n.Edges.BallotEntries = append(n.Edges.BallotEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,6 +549,36 @@ func (_q *PollOptionQuery) loadVotes(ctx context.Context, query *VoteQuery, node
 	}
 	return nil
 }
+func (_q *PollOptionQuery) loadBallotEntries(ctx context.Context, query *BallotEntryQuery, nodes []*PollOption, init func(*PollOption), assign func(*PollOption, *BallotEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PollOption)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ballotentry.FieldPollOptionID)
+	}
+	query.Where(predicate.BallotEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(polloption.BallotEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollOptionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_option_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PollOptionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pollapp/backend/ent/ballotentry"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
 	"pollapp/backend/ent/predicate"
@@ -98,6 +99,21 @@ func (_u *PollOptionUpdate) AddVotes(v ...*Vote) *PollOptionUpdate {
 	return _u.AddVoteIDs(ids...)
 }
 
+// AddBallotEntryIDs adds the "ballot_entries" edge to the BallotEntry entity by IDs.
+func (_u *PollOptionUpdate) AddBallotEntryIDs(ids ...int) *PollOptionUpdate {
+	_u.mutation.AddBallotEntryIDs(ids...)
+	return _u
+}
+
+// AddBallotEntries adds the "ballot_entries" edges to the BallotEntry entity.
+func (_u *PollOptionUpdate) AddBallotEntries(v ...*BallotEntry) *PollOptionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotEntryIDs(ids...)
+}
+
 // Mutation returns the PollOptionMutation object of the builder.
 func (_u *PollOptionUpdate) Mutation() *PollOptionMutation {
 	return _u.mutation
@@ -130,6 +146,27 @@ func (_u *PollOptionUpdate) RemoveVotes(v ...*Vote) *PollOptionUpdate {
 	return _u.RemoveVoteIDs(ids...)
 }
 
+// ClearBallotEntries clears all "ballot_entries" edges to the BallotEntry entity.
+func (_u *PollOptionUpdate) ClearBallotEntries() *PollOptionUpdate {
+	_u.mutation.ClearBallotEntries()
+	return _u
+}
+
+// RemoveBallotEntryIDs removes the "ballot_entries" edge to BallotEntry entities by IDs.
+func (_u *PollOptionUpdate) RemoveBallotEntryIDs(ids ...int) *PollOptionUpdate {
+	_u.mutation.RemoveBallotEntryIDs(ids...)
+	return _u
+}
+
+// RemoveBallotEntries removes "ballot_entries" edges to BallotEntry entities.
+func (_u *PollOptionUpdate) RemoveBallotEntries(v ...*BallotEntry) *PollOptionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotEntryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PollOptionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -260,6 +297,51 @@ func (_u *PollOptionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BallotEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   polloption.BallotEntriesTable,
+			Columns: []string{polloption.BallotEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotEntriesIDs(); len(nodes) > 0 && !_u.mutation.BallotEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   polloption.BallotEntriesTable,
+			Columns: []string{polloption.BallotEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   polloption.BallotEntriesTable,
+			Columns: []string{polloption.BallotEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{polloption.Label}
@@ -349,6 +431,21 @@ func (_u *PollOptionUpdateOne) AddVotes(v ...*Vote) *PollOptionUpdateOne {
 	return _u.AddVoteIDs(ids...)
 }
 
+// AddBallotEntryIDs adds the "ballot_entries" edge to the BallotEntry entity by IDs.
+func (_u *PollOptionUpdateOne) AddBallotEntryIDs(ids ...int) *PollOptionUpdateOne {
+	_u.mutation.AddBallotEntryIDs(ids...)
+	return _u
+}
+
+// AddBallotEntries adds the "ballot_entries" edges to the BallotEntry entity.
+func (_u *PollOptionUpdateOne) AddBallotEntries(v ...*BallotEntry) *PollOptionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotEntryIDs(ids...)
+}
+
 // Mutation returns the PollOptionMutation object of the builder.
 func (_u *PollOptionUpdateOne) Mutation() *PollOptionMutation {
 	return _u.mutation
@@ -381,6 +478,27 @@ func (_u *PollOptionUpdateOne) RemoveVotes(v ...*Vote) *PollOptionUpdateOne {
 	return _u.RemoveVoteIDs(ids...)
 }
 
+// ClearBallotEntries clears all "ballot_entries" edges to the BallotEntry entity.
+func (_u *PollOptionUpdateOne) ClearBallotEntries() *PollOptionUpdateOne {
+	_u.mutation.ClearBallotEntries()
+	return _u
+}
+
+// RemoveBallotEntryIDs removes the "ballot_entries" edge to BallotEntry entities by IDs.
+func (_u *PollOptionUpdateOne) RemoveBallotEntryIDs(ids ...int) *PollOptionUpdateOne {
+	_u.mutation.RemoveBallotEntryIDs(ids...)
+	return _u
+}
+
+// RemoveBallotEntries removes "ballot_entries" edges to BallotEntry entities.
+func (_u *PollOptionUpdateOne) RemoveBallotEntries(v ...*BallotEntry) *PollOptionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotEntryIDs(ids...)
+}
+
 // Where appends a list predicates to the PollOptionUpdate builder.
 func (_u *PollOptionUpdateOne) Where(ps ...predicate.PollOption) *PollOptionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -541,6 +659,51 @@ func (_u *PollOptionUpdateOne) sqlSave(ctx context.Context) (_node *PollOption,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BallotEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   polloption.BallotEntriesTable,
+			Columns: []string{polloption.BallotEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotEntriesIDs(); len(nodes) > 0 && !_u.mutation.BallotEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   polloption.BallotEntriesTable,
+			Columns: []string{polloption.BallotEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   polloption.BallotEntriesTable,
+			Columns: []string{polloption.BallotEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballotentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PollOption{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
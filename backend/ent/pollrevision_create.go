@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/pollrevision"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PollRevisionCreate is the builder for creating a PollRevision entity.
+type PollRevisionCreate struct {
+	config
+	mutation *PollRevisionMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *PollRevisionCreate) SetPollID(v int) *PollRevisionCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetEntity sets the "entity" field.
+func (_c *PollRevisionCreate) SetEntity(v pollrevision.Entity) *PollRevisionCreate {
+	_c.mutation.SetEntity(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *PollRevisionCreate) SetEntityID(v int) *PollRevisionCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *PollRevisionCreate) SetAction(v pollrevision.Action) *PollRevisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *PollRevisionCreate) SetActorID(v int) *PollRevisionCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *PollRevisionCreate) SetNillableActorID(v *int) *PollRevisionCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetBefore sets the "before" field.
+func (_c *PollRevisionCreate) SetBefore(v map[string]interface{}) *PollRevisionCreate {
+	_c.mutation.SetBefore(v)
+	return _c
+}
+
+// SetAfter sets the "after" field.
+func (_c *PollRevisionCreate) SetAfter(v map[string]interface{}) *PollRevisionCreate {
+	_c.mutation.SetAfter(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PollRevisionCreate) SetCreatedAt(v time.Time) *PollRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PollRevisionCreate) SetNillableCreatedAt(v *time.Time) *PollRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PollRevisionMutation object of the builder.
+func (_c *PollRevisionCreate) Mutation() *PollRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the PollRevision in the database.
+func (_c *PollRevisionCreate) Save(ctx context.Context) (*PollRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PollRevisionCreate) SaveX(ctx context.Context) *PollRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PollRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := pollrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PollRevisionCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "PollRevision.poll_id"`)}
+	}
+	if _, ok := _c.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "PollRevision.entity"`)}
+	}
+	if v, ok := _c.mutation.Entity(); ok {
+		if err := pollrevision.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "PollRevision.entity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "PollRevision.entity_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "PollRevision.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := pollrevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PollRevision.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PollRevision.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PollRevisionCreate) sqlSave(ctx context.Context) (*PollRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PollRevisionCreate) createSpec() (*PollRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PollRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pollrevision.Table, sqlgraph.NewFieldSpec(pollrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PollID(); ok {
+		_spec.SetField(pollrevision.FieldPollID, field.TypeInt, value)
+		_node.PollID = value
+	}
+	if value, ok := _c.mutation.Entity(); ok {
+		_spec.SetField(pollrevision.FieldEntity, field.TypeEnum, value)
+		_node.Entity = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(pollrevision.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(pollrevision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(pollrevision.FieldActorID, field.TypeInt, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.Before(); ok {
+		_spec.SetField(pollrevision.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := _c.mutation.After(); ok {
+		_spec.SetField(pollrevision.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pollrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PollRevisionCreateBulk is the builder for creating many PollRevision entities in bulk.
+type PollRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PollRevisionCreate
+}
+
+// Save creates the PollRevision entities in the database.
+func (_c *PollRevisionCreateBulk) Save(ctx context.Context) ([]*PollRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PollRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PollRevisionCreateBulk) SaveX(ctx context.Context) []*PollRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
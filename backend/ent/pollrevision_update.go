@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/pollrevision"
+	"pollapp/backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PollRevisionUpdate is the builder for updating PollRevision entities.
+type PollRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PollRevisionMutation
+}
+
+// Where appends a list predicates to the PollRevisionUpdate builder.
+func (_u *PollRevisionUpdate) Where(ps ...predicate.PollRevision) *PollRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *PollRevisionUpdate) SetPollID(v int) *PollRevisionUpdate {
+	_u.mutation.ResetPollID()
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *PollRevisionUpdate) SetNillablePollID(v *int) *PollRevisionUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// AddPollID adds value to the "poll_id" field.
+func (_u *PollRevisionUpdate) AddPollID(v int) *PollRevisionUpdate {
+	_u.mutation.AddPollID(v)
+	return _u
+}
+
+// SetEntity sets the "entity" field.
+func (_u *PollRevisionUpdate) SetEntity(v pollrevision.Entity) *PollRevisionUpdate {
+	_u.mutation.SetEntity(v)
+	return _u
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (_u *PollRevisionUpdate) SetNillableEntity(v *pollrevision.Entity) *PollRevisionUpdate {
+	if v != nil {
+		_u.SetEntity(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *PollRevisionUpdate) SetEntityID(v int) *PollRevisionUpdate {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *PollRevisionUpdate) SetNillableEntityID(v *int) *PollRevisionUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *PollRevisionUpdate) AddEntityID(v int) *PollRevisionUpdate {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *PollRevisionUpdate) SetAction(v pollrevision.Action) *PollRevisionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *PollRevisionUpdate) SetNillableAction(v *pollrevision.Action) *PollRevisionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *PollRevisionUpdate) SetActorID(v int) *PollRevisionUpdate {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *PollRevisionUpdate) SetNillableActorID(v *int) *PollRevisionUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *PollRevisionUpdate) AddActorID(v int) *PollRevisionUpdate {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *PollRevisionUpdate) ClearActorID() *PollRevisionUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetBefore sets the "before" field.
+func (_u *PollRevisionUpdate) SetBefore(v map[string]interface{}) *PollRevisionUpdate {
+	_u.mutation.SetBefore(v)
+	return _u
+}
+
+// ClearBefore clears the value of the "before" field.
+func (_u *PollRevisionUpdate) ClearBefore() *PollRevisionUpdate {
+	_u.mutation.ClearBefore()
+	return _u
+}
+
+// SetAfter sets the "after" field.
+func (_u *PollRevisionUpdate) SetAfter(v map[string]interface{}) *PollRevisionUpdate {
+	_u.mutation.SetAfter(v)
+	return _u
+}
+
+// ClearAfter clears the value of the "after" field.
+func (_u *PollRevisionUpdate) ClearAfter() *PollRevisionUpdate {
+	_u.mutation.ClearAfter()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollRevisionUpdate) SetCreatedAt(v time.Time) *PollRevisionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollRevisionUpdate) SetNillableCreatedAt(v *time.Time) *PollRevisionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PollRevisionMutation object of the builder.
+func (_u *PollRevisionUpdate) Mutation() *PollRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PollRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PollRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollRevisionUpdate) check() error {
+	if v, ok := _u.mutation.Entity(); ok {
+		if err := pollrevision.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "PollRevision.entity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := pollrevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PollRevision.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PollRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollrevision.Table, pollrevision.Columns, sqlgraph.NewFieldSpec(pollrevision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PollID(); ok {
+		_spec.SetField(pollrevision.FieldPollID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPollID(); ok {
+		_spec.AddField(pollrevision.FieldPollID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Entity(); ok {
+		_spec.SetField(pollrevision.FieldEntity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(pollrevision.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(pollrevision.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(pollrevision.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(pollrevision.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(pollrevision.FieldActorID, field.TypeInt, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(pollrevision.FieldActorID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Before(); ok {
+		_spec.SetField(pollrevision.FieldBefore, field.TypeJSON, value)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(pollrevision.FieldBefore, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.After(); ok {
+		_spec.SetField(pollrevision.FieldAfter, field.TypeJSON, value)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(pollrevision.FieldAfter, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pollrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PollRevisionUpdateOne is the builder for updating a single PollRevision entity.
+type PollRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PollRevisionMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *PollRevisionUpdateOne) SetPollID(v int) *PollRevisionUpdateOne {
+	_u.mutation.ResetPollID()
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *PollRevisionUpdateOne) SetNillablePollID(v *int) *PollRevisionUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// AddPollID adds value to the "poll_id" field.
+func (_u *PollRevisionUpdateOne) AddPollID(v int) *PollRevisionUpdateOne {
+	_u.mutation.AddPollID(v)
+	return _u
+}
+
+// SetEntity sets the "entity" field.
+func (_u *PollRevisionUpdateOne) SetEntity(v pollrevision.Entity) *PollRevisionUpdateOne {
+	_u.mutation.SetEntity(v)
+	return _u
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (_u *PollRevisionUpdateOne) SetNillableEntity(v *pollrevision.Entity) *PollRevisionUpdateOne {
+	if v != nil {
+		_u.SetEntity(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *PollRevisionUpdateOne) SetEntityID(v int) *PollRevisionUpdateOne {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *PollRevisionUpdateOne) SetNillableEntityID(v *int) *PollRevisionUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *PollRevisionUpdateOne) AddEntityID(v int) *PollRevisionUpdateOne {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *PollRevisionUpdateOne) SetAction(v pollrevision.Action) *PollRevisionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *PollRevisionUpdateOne) SetNillableAction(v *pollrevision.Action) *PollRevisionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *PollRevisionUpdateOne) SetActorID(v int) *PollRevisionUpdateOne {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *PollRevisionUpdateOne) SetNillableActorID(v *int) *PollRevisionUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *PollRevisionUpdateOne) AddActorID(v int) *PollRevisionUpdateOne {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *PollRevisionUpdateOne) ClearActorID() *PollRevisionUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetBefore sets the "before" field.
+func (_u *PollRevisionUpdateOne) SetBefore(v map[string]interface{}) *PollRevisionUpdateOne {
+	_u.mutation.SetBefore(v)
+	return _u
+}
+
+// ClearBefore clears the value of the "before" field.
+func (_u *PollRevisionUpdateOne) ClearBefore() *PollRevisionUpdateOne {
+	_u.mutation.ClearBefore()
+	return _u
+}
+
+// SetAfter sets the "after" field.
+func (_u *PollRevisionUpdateOne) SetAfter(v map[string]interface{}) *PollRevisionUpdateOne {
+	_u.mutation.SetAfter(v)
+	return _u
+}
+
+// ClearAfter clears the value of the "after" field.
+func (_u *PollRevisionUpdateOne) ClearAfter() *PollRevisionUpdateOne {
+	_u.mutation.ClearAfter()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollRevisionUpdateOne) SetCreatedAt(v time.Time) *PollRevisionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollRevisionUpdateOne) SetNillableCreatedAt(v *time.Time) *PollRevisionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PollRevisionMutation object of the builder.
+func (_u *PollRevisionUpdateOne) Mutation() *PollRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PollRevisionUpdate builder.
+func (_u *PollRevisionUpdateOne) Where(ps ...predicate.PollRevision) *PollRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PollRevisionUpdateOne) Select(field string, fields ...string) *PollRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PollRevision entity.
+func (_u *PollRevisionUpdateOne) Save(ctx context.Context) (*PollRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollRevisionUpdateOne) SaveX(ctx context.Context) *PollRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PollRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.Entity(); ok {
+		if err := pollrevision.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "PollRevision.entity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := pollrevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PollRevision.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PollRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PollRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollrevision.Table, pollrevision.Columns, sqlgraph.NewFieldSpec(pollrevision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PollRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pollrevision.FieldID)
+		for _, f := range fields {
+			if !pollrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pollrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PollID(); ok {
+		_spec.SetField(pollrevision.FieldPollID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPollID(); ok {
+		_spec.AddField(pollrevision.FieldPollID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Entity(); ok {
+		_spec.SetField(pollrevision.FieldEntity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(pollrevision.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(pollrevision.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(pollrevision.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(pollrevision.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(pollrevision.FieldActorID, field.TypeInt, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(pollrevision.FieldActorID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Before(); ok {
+		_spec.SetField(pollrevision.FieldBefore, field.TypeJSON, value)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(pollrevision.FieldBefore, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.After(); ok {
+		_spec.SetField(pollrevision.FieldAfter, field.TypeJSON, value)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(pollrevision.FieldAfter, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pollrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &PollRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/polltransition"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PollTransitionCreate is the builder for creating a PollTransition entity.
+type PollTransitionCreate struct {
+	config
+	mutation *PollTransitionMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *PollTransitionCreate) SetPollID(v int) *PollTransitionCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *PollTransitionCreate) SetActorID(v int) *PollTransitionCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *PollTransitionCreate) SetAction(v polltransition.Action) *PollTransitionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PollTransitionCreate) SetCreatedAt(v time.Time) *PollTransitionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PollTransitionCreate) SetNillableCreatedAt(v *time.Time) *PollTransitionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *PollTransitionCreate) SetPoll(v *Poll) *PollTransitionCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (_c *PollTransitionCreate) SetActor(v *User) *PollTransitionCreate {
+	return _c.SetActorID(v.ID)
+}
+
+// Mutation returns the PollTransitionMutation object of the builder.
+func (_c *PollTransitionCreate) Mutation() *PollTransitionMutation {
+	return _c.mutation
+}
+
+// Save creates the PollTransition in the database.
+func (_c *PollTransitionCreate) Save(ctx context.Context) (*PollTransition, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PollTransitionCreate) SaveX(ctx context.Context) *PollTransition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollTransitionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollTransitionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PollTransitionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := polltransition.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PollTransitionCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "PollTransition.poll_id"`)}
+	}
+	if _, ok := _c.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "PollTransition.actor_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "PollTransition.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := polltransition.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PollTransition.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PollTransition.created_at"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "PollTransition.poll"`)}
+	}
+	if len(_c.mutation.ActorIDs()) == 0 {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required edge "PollTransition.actor"`)}
+	}
+	return nil
+}
+
+func (_c *PollTransitionCreate) sqlSave(ctx context.Context) (*PollTransition, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PollTransitionCreate) createSpec() (*PollTransition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PollTransition{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(polltransition.Table, sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(polltransition.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(polltransition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.PollTable,
+			Columns: []string{polltransition.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.ActorTable,
+			Columns: []string{polltransition.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ActorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PollTransitionCreateBulk is the builder for creating many PollTransition entities in bulk.
+type PollTransitionCreateBulk struct {
+	config
+	err      error
+	builders []*PollTransitionCreate
+}
+
+// Save creates the PollTransition entities in the database.
+func (_c *PollTransitionCreateBulk) Save(ctx context.Context) ([]*PollTransition, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PollTransition, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollTransitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PollTransitionCreateBulk) SaveX(ctx context.Context) []*PollTransition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollTransitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollTransitionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/polltransition"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PollTransitionUpdate is the builder for updating PollTransition entities.
+type PollTransitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PollTransitionMutation
+}
+
+// Where appends a list predicates to the PollTransitionUpdate builder.
+func (_u *PollTransitionUpdate) Where(ps ...predicate.PollTransition) *PollTransitionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *PollTransitionUpdate) SetPollID(v int) *PollTransitionUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *PollTransitionUpdate) SetNillablePollID(v *int) *PollTransitionUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *PollTransitionUpdate) SetActorID(v int) *PollTransitionUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *PollTransitionUpdate) SetNillableActorID(v *int) *PollTransitionUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *PollTransitionUpdate) SetAction(v polltransition.Action) *PollTransitionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *PollTransitionUpdate) SetNillableAction(v *polltransition.Action) *PollTransitionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollTransitionUpdate) SetCreatedAt(v time.Time) *PollTransitionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollTransitionUpdate) SetNillableCreatedAt(v *time.Time) *PollTransitionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *PollTransitionUpdate) SetPoll(v *Poll) *PollTransitionUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (_u *PollTransitionUpdate) SetActor(v *User) *PollTransitionUpdate {
+	return _u.SetActorID(v.ID)
+}
+
+// Mutation returns the PollTransitionMutation object of the builder.
+func (_u *PollTransitionUpdate) Mutation() *PollTransitionMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *PollTransitionUpdate) ClearPoll() *PollTransitionUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (_u *PollTransitionUpdate) ClearActor() *PollTransitionUpdate {
+	_u.mutation.ClearActor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PollTransitionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollTransitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PollTransitionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollTransitionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollTransitionUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := polltransition.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PollTransition.action": %w`, err)}
+		}
+	}
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollTransition.poll"`)
+	}
+	if _u.mutation.ActorCleared() && len(_u.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollTransition.actor"`)
+	}
+	return nil
+}
+
+func (_u *PollTransitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(polltransition.Table, polltransition.Columns, sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(polltransition.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(polltransition.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.PollTable,
+			Columns: []string{polltransition.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.PollTable,
+			Columns: []string{polltransition.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.ActorTable,
+			Columns: []string{polltransition.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.ActorTable,
+			Columns: []string{polltransition.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{polltransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PollTransitionUpdateOne is the builder for updating a single PollTransition entity.
+type PollTransitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PollTransitionMutation
+}
+
+// SetPollID sets the "poll_id" field.
+func (_u *PollTransitionUpdateOne) SetPollID(v int) *PollTransitionUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *PollTransitionUpdateOne) SetNillablePollID(v *int) *PollTransitionUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *PollTransitionUpdateOne) SetActorID(v int) *PollTransitionUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *PollTransitionUpdateOne) SetNillableActorID(v *int) *PollTransitionUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *PollTransitionUpdateOne) SetAction(v polltransition.Action) *PollTransitionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *PollTransitionUpdateOne) SetNillableAction(v *polltransition.Action) *PollTransitionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PollTransitionUpdateOne) SetCreatedAt(v time.Time) *PollTransitionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PollTransitionUpdateOne) SetNillableCreatedAt(v *time.Time) *PollTransitionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *PollTransitionUpdateOne) SetPoll(v *Poll) *PollTransitionUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (_u *PollTransitionUpdateOne) SetActor(v *User) *PollTransitionUpdateOne {
+	return _u.SetActorID(v.ID)
+}
+
+// Mutation returns the PollTransitionMutation object of the builder.
+func (_u *PollTransitionUpdateOne) Mutation() *PollTransitionMutation {
+	return _u.mutation
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *PollTransitionUpdateOne) ClearPoll() *PollTransitionUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (_u *PollTransitionUpdateOne) ClearActor() *PollTransitionUpdateOne {
+	_u.mutation.ClearActor()
+	return _u
+}
+
+// Where appends a list predicates to the PollTransitionUpdate builder.
+func (_u *PollTransitionUpdateOne) Where(ps ...predicate.PollTransition) *PollTransitionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PollTransitionUpdateOne) Select(field string, fields ...string) *PollTransitionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PollTransition entity.
+func (_u *PollTransitionUpdateOne) Save(ctx context.Context) (*PollTransition, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollTransitionUpdateOne) SaveX(ctx context.Context) *PollTransition {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PollTransitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollTransitionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollTransitionUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := polltransition.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PollTransition.action": %w`, err)}
+		}
+	}
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollTransition.poll"`)
+	}
+	if _u.mutation.ActorCleared() && len(_u.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PollTransition.actor"`)
+	}
+	return nil
+}
+
+func (_u *PollTransitionUpdateOne) sqlSave(ctx context.Context) (_node *PollTransition, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(polltransition.Table, polltransition.Columns, sqlgraph.NewFieldSpec(polltransition.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PollTransition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, polltransition.FieldID)
+		for _, f := range fields {
+			if !polltransition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != polltransition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(polltransition.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(polltransition.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.PollTable,
+			Columns: []string{polltransition.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.PollTable,
+			Columns: []string{polltransition.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.ActorTable,
+			Columns: []string{polltransition.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   polltransition.ActorTable,
+			Columns: []string{polltransition.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PollTransition{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{polltransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
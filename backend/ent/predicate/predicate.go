@@ -6,14 +6,74 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// AnonymousBallot is the predicate function for anonymousballot builders.
+type AnonymousBallot func(*sql.Selector)
+
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// Ballot is the predicate function for ballot builders.
+type Ballot func(*sql.Selector)
+
+// BallotEntry is the predicate function for ballotentry builders.
+type BallotEntry func(*sql.Selector)
+
+// BallotLogEntry is the predicate function for ballotlogentry builders.
+type BallotLogEntry func(*sql.Selector)
+
+// EmailToken is the predicate function for emailtoken builders.
+type EmailToken func(*sql.Selector)
+
+// GuestVoter is the predicate function for guestvoter builders.
+type GuestVoter func(*sql.Selector)
+
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
+// OrganizationMember is the predicate function for organizationmember builders.
+type OrganizationMember func(*sql.Selector)
+
+// Participation is the predicate function for participation builders.
+type Participation func(*sql.Selector)
+
 // Poll is the predicate function for poll builders.
 type Poll func(*sql.Selector)
 
+// PollCollaborator is the predicate function for pollcollaborator builders.
+type PollCollaborator func(*sql.Selector)
+
+// PollMember is the predicate function for pollmember builders.
+type PollMember func(*sql.Selector)
+
 // PollOption is the predicate function for polloption builders.
 type PollOption func(*sql.Selector)
 
+// PollRevision is the predicate function for pollrevision builders.
+type PollRevision func(*sql.Selector)
+
+// PollTransition is the predicate function for polltransition builders.
+type PollTransition func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// ShareLink is the predicate function for sharelink builders.
+type ShareLink func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
 // Vote is the predicate function for vote builders.
 type Vote func(*sql.Selector)
@@ -3,10 +3,28 @@
 package ent
 
 import (
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/apikey"
+	"pollapp/backend/ent/auditevent"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/emailtoken"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/organization"
+	"pollapp/backend/ent/organizationmember"
+	"pollapp/backend/ent/participation"
 	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+	"pollapp/backend/ent/pollmember"
 	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/pollrevision"
+	"pollapp/backend/ent/polltransition"
+	"pollapp/backend/ent/recoverycode"
+	"pollapp/backend/ent/refreshtoken"
 	"pollapp/backend/ent/schema"
+	"pollapp/backend/ent/session"
+	"pollapp/backend/ent/sharelink"
 	"pollapp/backend/ent/user"
+	"pollapp/backend/ent/useridentity"
 	"pollapp/backend/ent/vote"
 	"time"
 )
@@ -15,28 +33,188 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[8].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	anonymousballotFields := schema.AnonymousBallot{}.Fields()
+	_ = anonymousballotFields
+	// anonymousballotDescID is the schema descriptor for id field.
+	anonymousballotDescID := anonymousballotFields[0].Descriptor()
+	// anonymousballot.DefaultID holds the default value on creation for the id field.
+	anonymousballot.DefaultID = anonymousballotDescID.Default.(func() string)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[5].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	ballotFields := schema.Ballot{}.Fields()
+	_ = ballotFields
+	// ballotDescCreatedAt is the schema descriptor for created_at field.
+	ballotDescCreatedAt := ballotFields[3].Descriptor()
+	// ballot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ballot.DefaultCreatedAt = ballotDescCreatedAt.Default.(func() time.Time)
+	emailtokenFields := schema.EmailToken{}.Fields()
+	_ = emailtokenFields
+	// emailtokenDescCreatedAt is the schema descriptor for created_at field.
+	emailtokenDescCreatedAt := emailtokenFields[6].Descriptor()
+	// emailtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailtoken.DefaultCreatedAt = emailtokenDescCreatedAt.Default.(func() time.Time)
+	guestvoterFields := schema.GuestVoter{}.Fields()
+	_ = guestvoterFields
+	// guestvoterDescCreatedAt is the schema descriptor for created_at field.
+	guestvoterDescCreatedAt := guestvoterFields[3].Descriptor()
+	// guestvoter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	guestvoter.DefaultCreatedAt = guestvoterDescCreatedAt.Default.(func() time.Time)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[1].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	organizationmemberFields := schema.OrganizationMember{}.Fields()
+	_ = organizationmemberFields
+	// organizationmemberDescPending is the schema descriptor for pending field.
+	organizationmemberDescPending := organizationmemberFields[3].Descriptor()
+	// organizationmember.DefaultPending holds the default value on creation for the pending field.
+	organizationmember.DefaultPending = organizationmemberDescPending.Default.(bool)
+	// organizationmemberDescCreatedAt is the schema descriptor for created_at field.
+	organizationmemberDescCreatedAt := organizationmemberFields[4].Descriptor()
+	// organizationmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationmember.DefaultCreatedAt = organizationmemberDescCreatedAt.Default.(func() time.Time)
+	participationFields := schema.Participation{}.Fields()
+	_ = participationFields
+	// participationDescCreatedAt is the schema descriptor for created_at field.
+	participationDescCreatedAt := participationFields[3].Descriptor()
+	// participation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	participation.DefaultCreatedAt = participationDescCreatedAt.Default.(func() time.Time)
 	pollFields := schema.Poll{}.Fields()
 	_ = pollFields
+	// pollDescMinChoices is the schema descriptor for min_choices field.
+	pollDescMinChoices := pollFields[4].Descriptor()
+	// poll.DefaultMinChoices holds the default value on creation for the min_choices field.
+	poll.DefaultMinChoices = pollDescMinChoices.Default.(int)
+	// pollDescMaxChoices is the schema descriptor for max_choices field.
+	pollDescMaxChoices := pollFields[5].Descriptor()
+	// poll.DefaultMaxChoices holds the default value on creation for the max_choices field.
+	poll.DefaultMaxChoices = pollDescMaxChoices.Default.(int)
+	// pollDescScoreMin is the schema descriptor for score_min field.
+	pollDescScoreMin := pollFields[8].Descriptor()
+	// poll.DefaultScoreMin holds the default value on creation for the score_min field.
+	poll.DefaultScoreMin = pollDescScoreMin.Default.(int)
+	// pollDescScoreMax is the schema descriptor for score_max field.
+	pollDescScoreMax := pollFields[9].Descriptor()
+	// poll.DefaultScoreMax holds the default value on creation for the score_max field.
+	poll.DefaultScoreMax = pollDescScoreMax.Default.(int)
+	// pollDescPassPercentage is the schema descriptor for pass_percentage field.
+	pollDescPassPercentage := pollFields[11].Descriptor()
+	// poll.DefaultPassPercentage holds the default value on creation for the pass_percentage field.
+	poll.DefaultPassPercentage = pollDescPassPercentage.Default.(int)
+	// pollDescAnonymous is the schema descriptor for anonymous field.
+	pollDescAnonymous := pollFields[19].Descriptor()
+	// poll.DefaultAnonymous holds the default value on creation for the anonymous field.
+	poll.DefaultAnonymous = pollDescAnonymous.Default.(bool)
+	// pollDescAllowGuests is the schema descriptor for allow_guests field.
+	pollDescAllowGuests := pollFields[20].Descriptor()
+	// poll.DefaultAllowGuests holds the default value on creation for the allow_guests field.
+	poll.DefaultAllowGuests = pollDescAllowGuests.Default.(bool)
+	// pollDescRevision is the schema descriptor for revision field.
+	pollDescRevision := pollFields[22].Descriptor()
+	// poll.DefaultRevision holds the default value on creation for the revision field.
+	poll.DefaultRevision = pollDescRevision.Default.(int)
 	// pollDescCreatedAt is the schema descriptor for created_at field.
-	pollDescCreatedAt := pollFields[3].Descriptor()
+	pollDescCreatedAt := pollFields[23].Descriptor()
 	// poll.DefaultCreatedAt holds the default value on creation for the created_at field.
 	poll.DefaultCreatedAt = pollDescCreatedAt.Default.(func() time.Time)
+	pollcollaboratorFields := schema.PollCollaborator{}.Fields()
+	_ = pollcollaboratorFields
+	// pollcollaboratorDescCreatedAt is the schema descriptor for created_at field.
+	pollcollaboratorDescCreatedAt := pollcollaboratorFields[3].Descriptor()
+	// pollcollaborator.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollcollaborator.DefaultCreatedAt = pollcollaboratorDescCreatedAt.Default.(func() time.Time)
+	pollmemberFields := schema.PollMember{}.Fields()
+	_ = pollmemberFields
+	// pollmemberDescCreatedAt is the schema descriptor for created_at field.
+	pollmemberDescCreatedAt := pollmemberFields[2].Descriptor()
+	// pollmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollmember.DefaultCreatedAt = pollmemberDescCreatedAt.Default.(func() time.Time)
 	polloptionFields := schema.PollOption{}.Fields()
 	_ = polloptionFields
 	// polloptionDescOrder is the schema descriptor for order field.
 	polloptionDescOrder := polloptionFields[2].Descriptor()
 	// polloption.DefaultOrder holds the default value on creation for the order field.
 	polloption.DefaultOrder = polloptionDescOrder.Default.(int)
+	pollrevisionFields := schema.PollRevision{}.Fields()
+	_ = pollrevisionFields
+	// pollrevisionDescCreatedAt is the schema descriptor for created_at field.
+	pollrevisionDescCreatedAt := pollrevisionFields[7].Descriptor()
+	// pollrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollrevision.DefaultCreatedAt = pollrevisionDescCreatedAt.Default.(func() time.Time)
+	polltransitionFields := schema.PollTransition{}.Fields()
+	_ = polltransitionFields
+	// polltransitionDescCreatedAt is the schema descriptor for created_at field.
+	polltransitionDescCreatedAt := polltransitionFields[3].Descriptor()
+	// polltransition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	polltransition.DefaultCreatedAt = polltransitionDescCreatedAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[4].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	sharelinkFields := schema.ShareLink{}.Fields()
+	_ = sharelinkFields
+	// sharelinkDescUses is the schema descriptor for uses field.
+	sharelinkDescUses := sharelinkFields[6].Descriptor()
+	// sharelink.DefaultUses holds the default value on creation for the uses field.
+	sharelink.DefaultUses = sharelinkDescUses.Default.(int)
+	// sharelinkDescCreatedAt is the schema descriptor for created_at field.
+	sharelinkDescCreatedAt := sharelinkFields[8].Descriptor()
+	// sharelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sharelink.DefaultCreatedAt = sharelinkDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[8].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescTotpFailures is the schema descriptor for totp_failures field.
+	userDescTotpFailures := userFields[9].Descriptor()
+	// user.DefaultTotpFailures holds the default value on creation for the totp_failures field.
+	user.DefaultTotpFailures = userDescTotpFailures.Default.(int)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[3].Descriptor()
+	userDescCreatedAt := userFields[11].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[4].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescLastLoginAt is the schema descriptor for last_login_at field.
+	useridentityDescLastLoginAt := useridentityFields[5].Descriptor()
+	// useridentity.DefaultLastLoginAt holds the default value on creation for the last_login_at field.
+	useridentity.DefaultLastLoginAt = useridentityDescLastLoginAt.Default.(func() time.Time)
 	voteFields := schema.Vote{}.Fields()
 	_ = voteFields
 	// voteDescCreatedAt is the schema descriptor for created_at field.
-	voteDescCreatedAt := voteFields[3].Descriptor()
+	voteDescCreatedAt := voteFields[4].Descriptor()
 	// vote.DefaultCreatedAt holds the default value on creation for the created_at field.
 	vote.DefaultCreatedAt = voteDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// AnonymousBallot is a ballot on an anonymous poll. It carries no user
+// reference, a random rather than sequential ID and a timestamp coarsened
+// to the day, so it can't be joined back to the Participation of the voter
+// who cast it. Only the holder of the receipt token can replace it.
+type AnonymousBallot struct {
+	ent.Schema
+}
+
+func (AnonymousBallot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(randomBallotID).
+			Immutable(),
+		field.Int("poll_id"),
+		// choices holds the selected option IDs, or the full ranking on
+		// ranked polls.
+		field.JSON("choices", []int{}).Optional(),
+		// scores maps option IDs to scores on score and STAR polls.
+		field.JSON("scores", map[int]int{}).Optional(),
+		// receipt_hash is the SHA-256 of the receipt token handed to the
+		// voter.
+		field.String("receipt_hash").Unique().Sensitive(),
+		field.Time("cast_on"),
+	}
+}
+
+func (AnonymousBallot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+	}
+}
+
+func randomBallotID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
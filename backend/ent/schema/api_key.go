@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// APIKey is a personal key a user creates for scripts and bots. Only a
+// hash of the key is stored; the user sees it once, when it is created.
+type APIKey struct {
+	ent.Schema
+}
+
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		// prefix is the start of the key, shown so users can tell their
+		// keys apart.
+		field.String("prefix"),
+		field.String("token_hash").Unique().Sensitive(),
+		// scopes are the writes the key may make, such as "polls:write";
+		// every key may read.
+		field.Strings("scopes").Optional(),
+		// org_id is the organization the key acts in, the one active when
+		// it was created.
+		field.Int("org_id").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+	}
+}
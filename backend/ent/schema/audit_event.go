@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent is an entry in the append-only log of security-relevant
+// events. Entries are never changed or removed; see internal/audit.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event").Immutable(),
+		// actor_id is the user the event is about or who caused it, when
+		// known.
+		field.Int("actor_id").Optional().Nillable().Immutable(),
+		field.String("ip").Optional().Immutable(),
+		field.String("user_agent").Optional().Immutable(),
+		field.JSON("details", map[string]any{}).Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event", "created_at"),
+		index.Fields("actor_id", "created_at"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// Ballot is a voter's complete submission for polls whose voting method
+// needs more than a set of ticked options, such as ranked or score polls.
+// The individual preferences live in BallotEntry rows.
+type Ballot struct {
+	ent.Schema
+}
+
+func (Ballot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		// Exactly one of user_id and guest_voter_id is set.
+		field.Int("user_id").Optional(),
+		field.Int("guest_voter_id").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Ballot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+		edge.To("user", User.Type).Unique().Field("user_id"),
+		edge.To("guest_voter", GuestVoter.Type).Unique().Field("guest_voter_id"),
+		edge.From("entries", BallotEntry.Type).Ref("ballot"),
+	}
+}
+
+func (Ballot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "user_id").Unique(),
+		index.Fields("poll_id", "guest_voter_id").Unique(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// BallotEntry records where a single option was placed on a ballot: its
+// rank on ranked ballots or its score on score and STAR ballots.
+type BallotEntry struct {
+	ent.Schema
+}
+
+func (BallotEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("ballot_id"),
+		field.Int("poll_option_id"),
+		// rank is 1 for the most preferred option.
+		field.Int("rank").Optional(),
+		field.Int("score").Optional(),
+	}
+}
+
+func (BallotEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ballot", Ballot.Type).Required().Unique().Field("ballot_id"),
+		edge.To("poll_option", PollOption.Type).Required().Unique().Field("poll_option_id"),
+	}
+}
+
+func (BallotEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ballot_id", "poll_option_id").Unique(),
+	}
+}
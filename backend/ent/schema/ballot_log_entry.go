@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// BallotLogEntry is one link in a poll's append-only hash chain of ballot
+// hashes. It has no user reference and no timestamp; see internal/ballotlog.
+type BallotLogEntry struct {
+	ent.Schema
+}
+
+func (BallotLogEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		// sequence numbers a poll's entries from 1 with no gaps.
+		field.Int("sequence"),
+		field.String("ballot_hash"),
+		// hash chains this entry to the previous one.
+		field.String("hash"),
+	}
+}
+
+func (BallotLogEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+	}
+}
+
+func (BallotLogEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "sequence").Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// EmailToken is a single-use, time-limited secret mailed to a user to
+// reset their password or verify their email. Only a hash of the token is
+// stored.
+type EmailToken struct {
+	ent.Schema
+}
+
+func (EmailToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Enum("purpose").Values("reset_password", "verify_email"),
+		field.String("token_hash").Unique().Sensitive(),
+		// email is the address the token was sent to. Verification only
+		// counts while it is still the user's address.
+		field.String("email"),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (EmailToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// GuestVoter is someone without an account who voted on a poll that
+// allows guests, identified by the poll-scoped guest token they were
+// issued. Only hashes of the token subject and client IP are kept.
+type GuestVoter struct {
+	ent.Schema
+}
+
+func (GuestVoter) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		field.String("token_hash").Unique().Sensitive(),
+		field.String("ip_hash").Optional().Sensitive(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (GuestVoter) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+		edge.From("votes", Vote.Type).Ref("guest_voter"),
+		edge.From("ballots", Ballot.Type).Ref("guest_voter"),
+		edge.From("participations", Participation.Type).Ref("guest_voter"),
+	}
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// Organization is a workspace shared by a team. Its polls are only visible
+// to its members, and only while it is their active organization.
+type Organization struct {
+	ent.Schema
+}
+
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("members", OrganizationMember.Type).Ref("organization"),
+		edge.From("polls", Poll.Type).Ref("organization"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// OrganizationMember gives a user a role in an organization. Owners and
+// admins manage the membership; only owners can make or unmake owners.
+// Users they add are invited, and only become members once they accept.
+type OrganizationMember struct {
+	ent.Schema
+}
+
+func (OrganizationMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("organization_id"),
+		field.Int("user_id"),
+		field.Enum("role").Values("owner", "admin", "member").Default("member"),
+		// pending invitations give no access to the organization until
+		// the user accepts them.
+		field.Bool("pending").Default(false),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (OrganizationMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("organization", Organization.Type).Required().Unique().Field("organization_id"),
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+	}
+}
+
+func (OrganizationMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "user_id").Unique(),
+	}
+}
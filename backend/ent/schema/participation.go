@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// Participation records that a user voted on an anonymous poll, without
+// any reference to what they chose.
+type Participation struct {
+	ent.Schema
+}
+
+func (Participation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		// Exactly one of user_id and guest_voter_id is set.
+		field.Int("user_id").Optional(),
+		field.Int("guest_voter_id").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Participation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+		edge.To("user", User.Type).Unique().Field("user_id"),
+		edge.To("guest_voter", GuestVoter.Type).Unique().Field("guest_voter_id"),
+	}
+}
+
+func (Participation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "user_id").Unique(),
+		index.Fields("poll_id", "guest_voter_id").Unique(),
+	}
+}
@@ -17,13 +17,66 @@ func (Poll) Fields() []ent.Field {
 		field.String("title"),
 		field.String("description"),
 		field.Int("created_by"),
+		// organization_id scopes the poll to an organization; polls
+		// created outside any organization leave it unset.
+		field.Int("organization_id").Optional().Nillable(),
+		field.Int("min_choices").Default(1),
+		field.Int("max_choices").Default(1),
+		field.Enum("voting_method").Values("plurality", "ranked", "score", "star", "approval", "proposal").Default("plurality"),
+		// tally_rule picks how ranked ballots are counted.
+		field.Enum("tally_rule").Values("instant_runoff", "condorcet").Default("instant_runoff"),
+		// score_min and score_max bound the scores on score and STAR ballots.
+		field.Int("score_min").Default(0),
+		field.Int("score_max").Default(5),
+		// pass_threshold decides whether a proposal passes, counting only
+		// Yes and No votes; pass_percentage is used by the "percentage" rule.
+		field.Enum("pass_threshold").Values("simple_majority", "two_thirds", "percentage").Default("simple_majority"),
+		field.Int("pass_percentage").Default(50),
+		// opens_at and closes_at bound the voting window; unset means
+		// open since creation and never closing respectively.
+		field.Time("opens_at").Optional().Nillable(),
+		field.Time("closes_at").Optional().Nillable(),
+		// close_event_at is the closes_at the scheduler last published a
+		// close event for, so that it catches up on closes it missed while
+		// the server was down without announcing any twice.
+		field.Time("close_event_at").Optional().Nillable().StructTag(`json:"-"`),
+		// closed_at and archived_at are set when the owner closes or
+		// archives the poll by hand.
+		field.Time("closed_at").Optional().Nillable(),
+		field.Time("archived_at").Optional().Nillable(),
+		// visibility decides who can find and open the poll: everyone,
+		// only those with a share link, or only its owner and members.
+		field.Enum("visibility").Values("public", "unlisted", "private").Default("public"),
+		// results_visibility decides who may see vote counts and results.
+		field.Enum("results_visibility").Values("always", "after_vote", "after_close", "owner_only").Default("always"),
+		// anonymous polls keep who voted apart from what they chose; see
+		// Participation and AnonymousBallot.
+		field.Bool("anonymous").Default(false),
+		// allow_guests lets people without an account vote with a guest
+		// token; guest_dedupe decides whether a new token from an IP
+		// address that already voted is turned away.
+		field.Bool("allow_guests").Default(false),
+		field.Enum("guest_dedupe").Values("token", "token_ip").Default("token"),
+		// revision goes up whenever the poll's text or options change, so
+		// clients can tell the poll changed since they loaded it.
+		field.Int("revision").Default(1),
 		field.Time("created_at").Default(time.Now),
 	}
 }
 
 func (Poll) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.To("organization", Organization.Type).Unique().Field("organization_id"),
 		edge.From("options", PollOption.Type).Ref("poll"),
 		edge.From("votes", Vote.Type).Ref("poll"),
+		edge.From("ballots", Ballot.Type).Ref("poll"),
+		edge.From("transitions", PollTransition.Type).Ref("poll"),
+		edge.From("participations", Participation.Type).Ref("poll"),
+		edge.From("anonymous_ballots", AnonymousBallot.Type).Ref("poll"),
+		edge.From("ballot_log", BallotLogEntry.Type).Ref("poll"),
+		edge.From("guest_voters", GuestVoter.Type).Ref("poll"),
+		edge.From("members", PollMember.Type).Ref("poll"),
+		edge.From("collaborators", PollCollaborator.Type).Ref("poll"),
+		edge.From("share_links", ShareLink.Type).Ref("poll"),
 	}
 }
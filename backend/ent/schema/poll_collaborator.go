@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// PollCollaborator is a user the creator shares control of a poll with.
+// Co-owners can do everything the creator can, editors can change the poll
+// and open or close it, and results viewers can always see its results.
+type PollCollaborator struct {
+	ent.Schema
+}
+
+func (PollCollaborator) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		field.Int("user_id"),
+		field.Enum("role").Values("co_owner", "editor", "results_viewer"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (PollCollaborator) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+	}
+}
+
+func (PollCollaborator) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "user_id").Unique(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// PollMember is a user the owner invited to a poll. Private polls can only
+// be seen and voted on by their owner and members.
+type PollMember struct {
+	ent.Schema
+}
+
+func (PollMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		field.Int("user_id"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (PollMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+	}
+}
+
+func (PollMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "user_id").Unique(),
+	}
+}
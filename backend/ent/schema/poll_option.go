@@ -22,5 +22,6 @@ func (PollOption) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
 		edge.From("votes", Vote.Type).Ref("poll_option"),
+		edge.From("ballot_entries", BallotEntry.Type).Ref("poll_option"),
 	}
 }
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PollRevision records one change to a poll or one of its options, with
+// snapshots from before and after it. Revisions are written by the hooks
+// in internal/history and outlive the poll, so they have no edges.
+type PollRevision struct {
+	ent.Schema
+}
+
+func (PollRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		field.Enum("entity").Values("poll", "option"),
+		field.Int("entity_id"),
+		field.Enum("action").Values("create", "update", "delete", "close", "reopen", "archive"),
+		// actor_id is the signed-in user who made the change, if any.
+		field.Int("actor_id").Optional().Nillable(),
+		// before and after are unset for creations and deletions
+		// respectively.
+		field.JSON("before", map[string]any{}).Optional(),
+		field.JSON("after", map[string]any{}).Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (PollRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "created_at"),
+	}
+}
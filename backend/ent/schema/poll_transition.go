@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// PollTransition records a manual lifecycle change of a poll and who made
+// it.
+type PollTransition struct {
+	ent.Schema
+}
+
+func (PollTransition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		field.Int("actor_id"),
+		field.Enum("action").Values("close", "reopen", "archive"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (PollTransition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+		edge.To("actor", User.Type).Required().Unique().Field("actor_id"),
+	}
+}
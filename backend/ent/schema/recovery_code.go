@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// RecoveryCode is a single-use code that stands in for a TOTP code when
+// the user has lost their authenticator. Only a hash of the code is
+// stored.
+type RecoveryCode struct {
+	ent.Schema
+}
+
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("code_hash").Unique().Sensitive(),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+	}
+}
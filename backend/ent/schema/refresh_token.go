@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// RefreshToken is a single-use token that trades for a new access token
+// and a new refresh token of the same session. Only a hash of the token is
+// stored.
+type RefreshToken struct {
+	ent.Schema
+}
+
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("session_id"),
+		field.String("token_hash").Unique().Sensitive(),
+		field.Time("expires_at"),
+		// used_at is set once the token has been traded in. Presenting it
+		// again means it leaked, and the whole session is revoked.
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("session", Session.Type).Required().Unique().Field("session_id"),
+	}
+}
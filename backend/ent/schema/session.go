@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// Session is one sign-in of a user: the family of refresh tokens handed
+// out since, each replacing the one before. Revoking it ends the sign-in
+// and makes its access tokens invalid.
+type Session struct {
+	ent.Schema
+}
+
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		// org_id is the active organization tokens of the session carry.
+		field.Int("org_id").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+		// revoked_reason says why: "logout", "refresh token reused" or
+		// "banned".
+		field.String("revoked_reason").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+		edge.From("refresh_tokens", RefreshToken.Type).Ref("session"),
+	}
+}
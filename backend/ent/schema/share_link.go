@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+)
+
+// ShareLink is a secret /p/<token> link to a poll. Only a hash of the token
+// is stored; the owner sees the token once, when the link is created.
+type ShareLink struct {
+	ent.Schema
+}
+
+func (ShareLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("poll_id"),
+		field.Int("created_by"),
+		field.String("token_hash").Unique().Sensitive(),
+		// grant is what the link allows; "vote" implies "view".
+		field.Enum("grant").Values("view", "vote").Default("vote"),
+		// expires_at and max_uses are optional limits. uses counts views
+		// on view links and ballots on vote links.
+		field.Time("expires_at").Optional().Nillable(),
+		field.Int("max_uses").Optional().Nillable(),
+		field.Int("uses").Default(0),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (ShareLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
+		edge.To("creator", User.Type).Required().Unique().Field("created_by"),
+	}
+}
@@ -17,6 +17,25 @@ func (User) Fields() []ent.Field {
 		field.String("username").Unique(),
 		field.String("email").Unique(),
 		field.String("password_hash"),
+		// role is the user's site-wide role, see service.RoleHas.
+		field.Enum("role").Values("user", "moderator", "admin").Default("user"),
+		// banned_at is set while the user is banned from signing in.
+		field.Time("banned_at").Optional().Nillable(),
+		// email_verified_at is set once the user has proven they own
+		// their email.
+		field.Time("email_verified_at").Optional().Nillable(),
+		// totp_secret is the user's authenticator secret. It is set on
+		// enrollment, and second factors are only required once
+		// totp_enabled_at is set by confirming a code.
+		field.String("totp_secret").Optional().Nillable().Sensitive(),
+		field.Time("totp_enabled_at").Optional().Nillable(),
+		// totp_last_step is the time step of the last accepted code; codes
+		// from it or earlier are refused so that none works twice.
+		field.Int64("totp_last_step").Default(0).StructTag(`json:"-"`),
+		// totp_failures counts wrong second factors in a row, the last at
+		// totp_failed_at, to lock out guessing.
+		field.Int("totp_failures").Default(0).StructTag(`json:"-"`),
+		field.Time("totp_failed_at").Optional().Nillable().StructTag(`json:"-"`),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -24,5 +43,17 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("votes", Vote.Type).Ref("user"),
+		edge.From("ballots", Ballot.Type).Ref("user"),
+		edge.From("poll_transitions", PollTransition.Type).Ref("actor"),
+		edge.From("participations", Participation.Type).Ref("user"),
+		edge.From("poll_memberships", PollMember.Type).Ref("user"),
+		edge.From("poll_collaborations", PollCollaborator.Type).Ref("user"),
+		edge.From("share_links", ShareLink.Type).Ref("creator"),
+		edge.From("organization_memberships", OrganizationMember.Type).Ref("user"),
+		edge.From("sessions", Session.Type).Ref("user"),
+		edge.From("identities", UserIdentity.Type).Ref("user"),
+		edge.From("api_keys", APIKey.Type).Ref("user"),
+		edge.From("email_tokens", EmailToken.Type).Ref("user"),
+		edge.From("recovery_codes", RecoveryCode.Type).Ref("user"),
 	}
 }
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity links a user to their account at an OpenID provider, the
+// provider's issuer and subject together naming it.
+type UserIdentity struct {
+	ent.Schema
+}
+
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("issuer"),
+		field.String("subject"),
+		// email is the address the provider gave at the last sign-in.
+		field.String("email").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_login_at").Default(time.Now),
+	}
+}
+
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+	}
+}
+
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("issuer", "subject").Unique(),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
 )
 
 type Vote struct {
@@ -16,7 +17,9 @@ func (Vote) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("poll_id"),
 		field.Int("poll_option_id"),
-		field.Int("user_id"),
+		// Exactly one of user_id and guest_voter_id is set.
+		field.Int("user_id").Optional(),
+		field.Int("guest_voter_id").Optional(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -25,6 +28,15 @@ func (Vote) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("poll", Poll.Type).Required().Unique().Field("poll_id"),
 		edge.To("poll_option", PollOption.Type).Required().Unique().Field("poll_option_id"),
-		edge.To("user", User.Type).Required().Unique().Field("user_id"),
+		edge.To("user", User.Type).Unique().Field("user_id"),
+		edge.To("guest_voter", GuestVoter.Type).Unique().Field("guest_voter_id"),
+	}
+}
+
+func (Vote) Indexes() []ent.Index {
+	return []ent.Index{
+		// A ballot may select several options, but each option only once.
+		index.Fields("user_id", "poll_id", "poll_option_id").Unique(),
+		index.Fields("guest_voter_id", "poll_id", "poll_option_id").Unique(),
 	}
 }
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/sharelink"
+	"pollapp/backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareLinkCreate is the builder for creating a ShareLink entity.
+type ShareLinkCreate struct {
+	config
+	mutation *ShareLinkMutation
+	hooks    []Hook
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *ShareLinkCreate) SetPollID(v int) *ShareLinkCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ShareLinkCreate) SetCreatedBy(v int) *ShareLinkCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *ShareLinkCreate) SetTokenHash(v string) *ShareLinkCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetGrant sets the "grant" field.
+func (_c *ShareLinkCreate) SetGrant(v sharelink.Grant) *ShareLinkCreate {
+	_c.mutation.SetGrant(v)
+	return _c
+}
+
+// SetNillableGrant sets the "grant" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableGrant(v *sharelink.Grant) *ShareLinkCreate {
+	if v != nil {
+		_c.SetGrant(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ShareLinkCreate) SetExpiresAt(v time.Time) *ShareLinkCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableExpiresAt(v *time.Time) *ShareLinkCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *ShareLinkCreate) SetMaxUses(v int) *ShareLinkCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableMaxUses(v *int) *ShareLinkCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetUses sets the "uses" field.
+func (_c *ShareLinkCreate) SetUses(v int) *ShareLinkCreate {
+	_c.mutation.SetUses(v)
+	return _c
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableUses(v *int) *ShareLinkCreate {
+	if v != nil {
+		_c.SetUses(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *ShareLinkCreate) SetRevokedAt(v time.Time) *ShareLinkCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableRevokedAt(v *time.Time) *ShareLinkCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ShareLinkCreate) SetCreatedAt(v time.Time) *ShareLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableCreatedAt(v *time.Time) *ShareLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *ShareLinkCreate) SetPoll(v *Poll) *ShareLinkCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (_c *ShareLinkCreate) SetCreatorID(id int) *ShareLinkCreate {
+	_c.mutation.SetCreatorID(id)
+	return _c
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_c *ShareLinkCreate) SetCreator(v *User) *ShareLinkCreate {
+	return _c.SetCreatorID(v.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (_c *ShareLinkCreate) Mutation() *ShareLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the ShareLink in the database.
+func (_c *ShareLinkCreate) Save(ctx context.Context) (*ShareLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShareLinkCreate) SaveX(ctx context.Context) *ShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShareLinkCreate) defaults() {
+	if _, ok := _c.mutation.Grant(); !ok {
+		v := sharelink.DefaultGrant
+		_c.mutation.SetGrant(v)
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		v := sharelink.DefaultUses
+		_c.mutation.SetUses(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sharelink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShareLinkCreate) check() error {
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "ShareLink.poll_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ShareLink.created_by"`)}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ShareLink.token_hash"`)}
+	}
+	if _, ok := _c.mutation.Grant(); !ok {
+		return &ValidationError{Name: "grant", err: errors.New(`ent: missing required field "ShareLink.grant"`)}
+	}
+	if v, ok := _c.mutation.Grant(); ok {
+		if err := sharelink.GrantValidator(v); err != nil {
+			return &ValidationError{Name: "grant", err: fmt.Errorf(`ent: validator failed for field "ShareLink.grant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "ShareLink.uses"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareLink.created_at"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "ShareLink.poll"`)}
+	}
+	if len(_c.mutation.CreatorIDs()) == 0 {
+		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "ShareLink.creator"`)}
+	}
+	return nil
+}
+
+func (_c *ShareLinkCreate) sqlSave(ctx context.Context) (*ShareLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShareLinkCreate) createSpec() (*ShareLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sharelink.Table, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(sharelink.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.Grant(); ok {
+		_spec.SetField(sharelink.FieldGrant, field.TypeEnum, value)
+		_node.Grant = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(sharelink.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = &value
+	}
+	if value, ok := _c.mutation.Uses(); ok {
+		_spec.SetField(sharelink.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(sharelink.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sharelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.PollTable,
+			Columns: []string{sharelink.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.CreatorTable,
+			Columns: []string{sharelink.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedBy = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareLinkCreateBulk is the builder for creating many ShareLink entities in bulk.
+type ShareLinkCreateBulk struct {
+	config
+	err      error
+	builders []*ShareLinkCreate
+}
+
+// Save creates the ShareLink entities in the database.
+func (_c *ShareLinkCreateBulk) Save(ctx context.Context) ([]*ShareLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShareLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShareLinkCreateBulk) SaveX(ctx context.Context) []*ShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,12 +12,52 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AnonymousBallot is the client for interacting with the AnonymousBallot builders.
+	AnonymousBallot *AnonymousBallotClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// Ballot is the client for interacting with the Ballot builders.
+	Ballot *BallotClient
+	// BallotEntry is the client for interacting with the BallotEntry builders.
+	BallotEntry *BallotEntryClient
+	// BallotLogEntry is the client for interacting with the BallotLogEntry builders.
+	BallotLogEntry *BallotLogEntryClient
+	// EmailToken is the client for interacting with the EmailToken builders.
+	EmailToken *EmailTokenClient
+	// GuestVoter is the client for interacting with the GuestVoter builders.
+	GuestVoter *GuestVoterClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// OrganizationMember is the client for interacting with the OrganizationMember builders.
+	OrganizationMember *OrganizationMemberClient
+	// Participation is the client for interacting with the Participation builders.
+	Participation *ParticipationClient
 	// Poll is the client for interacting with the Poll builders.
 	Poll *PollClient
+	// PollCollaborator is the client for interacting with the PollCollaborator builders.
+	PollCollaborator *PollCollaboratorClient
+	// PollMember is the client for interacting with the PollMember builders.
+	PollMember *PollMemberClient
 	// PollOption is the client for interacting with the PollOption builders.
 	PollOption *PollOptionClient
+	// PollRevision is the client for interacting with the PollRevision builders.
+	PollRevision *PollRevisionClient
+	// PollTransition is the client for interacting with the PollTransition builders.
+	PollTransition *PollTransitionClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// Vote is the client for interacting with the Vote builders.
 	Vote *VoteClient
 
@@ -151,9 +191,29 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.AnonymousBallot = NewAnonymousBallotClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.Ballot = NewBallotClient(tx.config)
+	tx.BallotEntry = NewBallotEntryClient(tx.config)
+	tx.BallotLogEntry = NewBallotLogEntryClient(tx.config)
+	tx.EmailToken = NewEmailTokenClient(tx.config)
+	tx.GuestVoter = NewGuestVoterClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
+	tx.OrganizationMember = NewOrganizationMemberClient(tx.config)
+	tx.Participation = NewParticipationClient(tx.config)
 	tx.Poll = NewPollClient(tx.config)
+	tx.PollCollaborator = NewPollCollaboratorClient(tx.config)
+	tx.PollMember = NewPollMemberClient(tx.config)
 	tx.PollOption = NewPollOptionClient(tx.config)
+	tx.PollRevision = NewPollRevisionClient(tx.config)
+	tx.PollTransition = NewPollTransitionClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.ShareLink = NewShareLinkClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 	tx.Vote = NewVoteClient(tx.config)
 }
 
@@ -164,7 +224,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Poll.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
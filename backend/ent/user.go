@@ -23,6 +23,22 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// PasswordHash holds the value of the "password_hash" field.
 	PasswordHash string `json:"password_hash,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// BannedAt holds the value of the "banned_at" field.
+	BannedAt *time.Time `json:"banned_at,omitempty"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"-"`
+	// TotpEnabledAt holds the value of the "totp_enabled_at" field.
+	TotpEnabledAt *time.Time `json:"totp_enabled_at,omitempty"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep int64 `json:"-"`
+	// TotpFailures holds the value of the "totp_failures" field.
+	TotpFailures int `json:"-"`
+	// TotpFailedAt holds the value of the "totp_failed_at" field.
+	TotpFailedAt *time.Time `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -35,9 +51,33 @@ type User struct {
 type UserEdges struct {
 	// Votes holds the value of the votes edge.
 	Votes []*Vote `json:"votes,omitempty"`
+	// Ballots holds the value of the ballots edge.
+	Ballots []*Ballot `json:"ballots,omitempty"`
+	// PollTransitions holds the value of the poll_transitions edge.
+	PollTransitions []*PollTransition `json:"poll_transitions,omitempty"`
+	// Participations holds the value of the participations edge.
+	Participations []*Participation `json:"participations,omitempty"`
+	// PollMemberships holds the value of the poll_memberships edge.
+	PollMemberships []*PollMember `json:"poll_memberships,omitempty"`
+	// PollCollaborations holds the value of the poll_collaborations edge.
+	PollCollaborations []*PollCollaborator `json:"poll_collaborations,omitempty"`
+	// ShareLinks holds the value of the share_links edge.
+	ShareLinks []*ShareLink `json:"share_links,omitempty"`
+	// OrganizationMemberships holds the value of the organization_memberships edge.
+	OrganizationMemberships []*OrganizationMember `json:"organization_memberships,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// EmailTokens holds the value of the email_tokens edge.
+	EmailTokens []*EmailToken `json:"email_tokens,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [13]bool
 }
 
 // VotesOrErr returns the Votes value or an error if the edge
@@ -49,16 +89,124 @@ func (e UserEdges) VotesOrErr() ([]*Vote, error) {
 	return nil, &NotLoadedError{edge: "votes"}
 }
 
+// BallotsOrErr returns the Ballots value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BallotsOrErr() ([]*Ballot, error) {
+	if e.loadedTypes[1] {
+		return e.Ballots, nil
+	}
+	return nil, &NotLoadedError{edge: "ballots"}
+}
+
+// PollTransitionsOrErr returns the PollTransitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PollTransitionsOrErr() ([]*PollTransition, error) {
+	if e.loadedTypes[2] {
+		return e.PollTransitions, nil
+	}
+	return nil, &NotLoadedError{edge: "poll_transitions"}
+}
+
+// ParticipationsOrErr returns the Participations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ParticipationsOrErr() ([]*Participation, error) {
+	if e.loadedTypes[3] {
+		return e.Participations, nil
+	}
+	return nil, &NotLoadedError{edge: "participations"}
+}
+
+// PollMembershipsOrErr returns the PollMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PollMembershipsOrErr() ([]*PollMember, error) {
+	if e.loadedTypes[4] {
+		return e.PollMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "poll_memberships"}
+}
+
+// PollCollaborationsOrErr returns the PollCollaborations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PollCollaborationsOrErr() ([]*PollCollaborator, error) {
+	if e.loadedTypes[5] {
+		return e.PollCollaborations, nil
+	}
+	return nil, &NotLoadedError{edge: "poll_collaborations"}
+}
+
+// ShareLinksOrErr returns the ShareLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ShareLinksOrErr() ([]*ShareLink, error) {
+	if e.loadedTypes[6] {
+		return e.ShareLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "share_links"}
+}
+
+// OrganizationMembershipsOrErr returns the OrganizationMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OrganizationMembershipsOrErr() ([]*OrganizationMember, error) {
+	if e.loadedTypes[7] {
+		return e.OrganizationMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "organization_memberships"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[8] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[9] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[10] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// EmailTokensOrErr returns the EmailTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailTokensOrErr() ([]*EmailToken, error) {
+	if e.loadedTypes[11] {
+		return e.EmailTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "email_tokens"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[12] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldID, user.FieldTotpLastStep, user.FieldTotpFailures:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldEmail, user.FieldPasswordHash:
+		case user.FieldUsername, user.FieldEmail, user.FieldPasswordHash, user.FieldRole, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldBannedAt, user.FieldEmailVerifiedAt, user.FieldTotpEnabledAt, user.FieldTotpFailedAt, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -99,6 +247,59 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PasswordHash = value.String
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = user.Role(value.String)
+			}
+		case user.FieldBannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_at", values[i])
+			} else if value.Valid {
+				_m.BannedAt = new(time.Time)
+				*_m.BannedAt = value.Time
+			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = new(time.Time)
+				*_m.EmailVerifiedAt = value.Time
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = new(string)
+				*_m.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled_at", values[i])
+			} else if value.Valid {
+				_m.TotpEnabledAt = new(time.Time)
+				*_m.TotpEnabledAt = value.Time
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				_m.TotpLastStep = value.Int64
+			}
+		case user.FieldTotpFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_failures", values[i])
+			} else if value.Valid {
+				_m.TotpFailures = int(value.Int64)
+			}
+		case user.FieldTotpFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_failed_at", values[i])
+			} else if value.Valid {
+				_m.TotpFailedAt = new(time.Time)
+				*_m.TotpFailedAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -123,6 +324,66 @@ func (_m *User) QueryVotes() *VoteQuery {
 	return NewUserClient(_m.config).QueryVotes(_m)
 }
 
+// QueryBallots queries the "ballots" edge of the User entity.
+func (_m *User) QueryBallots() *BallotQuery {
+	return NewUserClient(_m.config).QueryBallots(_m)
+}
+
+// QueryPollTransitions queries the "poll_transitions" edge of the User entity.
+func (_m *User) QueryPollTransitions() *PollTransitionQuery {
+	return NewUserClient(_m.config).QueryPollTransitions(_m)
+}
+
+// QueryParticipations queries the "participations" edge of the User entity.
+func (_m *User) QueryParticipations() *ParticipationQuery {
+	return NewUserClient(_m.config).QueryParticipations(_m)
+}
+
+// QueryPollMemberships queries the "poll_memberships" edge of the User entity.
+func (_m *User) QueryPollMemberships() *PollMemberQuery {
+	return NewUserClient(_m.config).QueryPollMemberships(_m)
+}
+
+// QueryPollCollaborations queries the "poll_collaborations" edge of the User entity.
+func (_m *User) QueryPollCollaborations() *PollCollaboratorQuery {
+	return NewUserClient(_m.config).QueryPollCollaborations(_m)
+}
+
+// QueryShareLinks queries the "share_links" edge of the User entity.
+func (_m *User) QueryShareLinks() *ShareLinkQuery {
+	return NewUserClient(_m.config).QueryShareLinks(_m)
+}
+
+// QueryOrganizationMemberships queries the "organization_memberships" edge of the User entity.
+func (_m *User) QueryOrganizationMemberships() *OrganizationMemberQuery {
+	return NewUserClient(_m.config).QueryOrganizationMemberships(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *SessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (_m *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(_m.config).QueryIdentities(_m)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (_m *User) QueryAPIKeys() *APIKeyQuery {
+	return NewUserClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryEmailTokens queries the "email_tokens" edge of the User entity.
+func (_m *User) QueryEmailTokens() *EmailTokenQuery {
+	return NewUserClient(_m.config).QueryEmailTokens(_m)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (_m *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(_m.config).QueryRecoveryCodes(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -155,6 +416,37 @@ func (_m *User) String() string {
 	builder.WriteString("password_hash=")
 	builder.WriteString(_m.PasswordHash)
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	if v := _m.BannedAt; v != nil {
+		builder.WriteString("banned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.TotpEnabledAt; v != nil {
+		builder.WriteString("totp_enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("totp_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpFailures))
+	builder.WriteString(", ")
+	if v := _m.TotpFailedAt; v != nil {
+		builder.WriteString("totp_failed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
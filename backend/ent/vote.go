@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"pollapp/backend/ent/guestvoter"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
 	"pollapp/backend/ent/user"
@@ -26,6 +27,8 @@ type Vote struct {
 	PollOptionID int `json:"poll_option_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
+	// GuestVoterID holds the value of the "guest_voter_id" field.
+	GuestVoterID int `json:"guest_voter_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -42,9 +45,11 @@ type VoteEdges struct {
 	PollOption *PollOption `json:"poll_option,omitempty"`
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// GuestVoter holds the value of the guest_voter edge.
+	GuestVoter *GuestVoter `json:"guest_voter,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // PollOrErr returns the Poll value or an error if the edge
@@ -80,12 +85,23 @@ func (e VoteEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// GuestVoterOrErr returns the GuestVoter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VoteEdges) GuestVoterOrErr() (*GuestVoter, error) {
+	if e.GuestVoter != nil {
+		return e.GuestVoter, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: guestvoter.Label}
+	}
+	return nil, &NotLoadedError{edge: "guest_voter"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Vote) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vote.FieldID, vote.FieldPollID, vote.FieldPollOptionID, vote.FieldUserID:
+		case vote.FieldID, vote.FieldPollID, vote.FieldPollOptionID, vote.FieldUserID, vote.FieldGuestVoterID:
 			values[i] = new(sql.NullInt64)
 		case vote.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -128,6 +144,12 @@ func (_m *Vote) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = int(value.Int64)
 			}
+		case vote.FieldGuestVoterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_voter_id", values[i])
+			} else if value.Valid {
+				_m.GuestVoterID = int(value.Int64)
+			}
 		case vote.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -162,6 +184,11 @@ func (_m *Vote) QueryUser() *UserQuery {
 	return NewVoteClient(_m.config).QueryUser(_m)
 }
 
+// QueryGuestVoter queries the "guest_voter" edge of the Vote entity.
+func (_m *Vote) QueryGuestVoter() *GuestVoterQuery {
+	return NewVoteClient(_m.config).QueryGuestVoter(_m)
+}
+
 // Update returns a builder for updating this Vote.
 // Note that you need to call Vote.Unwrap() before calling this method if this Vote
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -194,6 +221,9 @@ func (_m *Vote) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	builder.WriteString("guest_voter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GuestVoterID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -20,6 +20,8 @@ const (
 	FieldPollOptionID = "poll_option_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldGuestVoterID holds the string denoting the guest_voter_id field in the database.
+	FieldGuestVoterID = "guest_voter_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgePoll holds the string denoting the poll edge name in mutations.
@@ -28,6 +30,8 @@ const (
 	EdgePollOption = "poll_option"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeGuestVoter holds the string denoting the guest_voter edge name in mutations.
+	EdgeGuestVoter = "guest_voter"
 	// Table holds the table name of the vote in the database.
 	Table = "votes"
 	// PollTable is the table that holds the poll relation/edge.
@@ -51,6 +55,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// GuestVoterTable is the table that holds the guest_voter relation/edge.
+	GuestVoterTable = "votes"
+	// GuestVoterInverseTable is the table name for the GuestVoter entity.
+	// It exists in this package in order to avoid circular dependency with the "guestvoter" package.
+	GuestVoterInverseTable = "guest_voters"
+	// GuestVoterColumn is the table column denoting the guest_voter relation/edge.
+	GuestVoterColumn = "guest_voter_id"
 )
 
 // Columns holds all SQL columns for vote fields.
@@ -59,6 +70,7 @@ var Columns = []string{
 	FieldPollID,
 	FieldPollOptionID,
 	FieldUserID,
+	FieldGuestVoterID,
 	FieldCreatedAt,
 }
 
@@ -100,6 +112,11 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByGuestVoterID orders the results by the guest_voter_id field.
+func ByGuestVoterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestVoterID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -125,6 +142,13 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByGuestVoterField orders the results by guest_voter field.
+func ByGuestVoterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGuestVoterStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newPollStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -146,3 +170,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
 	)
 }
+func newGuestVoterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GuestVoterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, GuestVoterTable, GuestVoterColumn),
+	)
+}
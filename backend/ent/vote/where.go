@@ -70,6 +70,11 @@ func UserID(v int) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldUserID, v))
 }
 
+// GuestVoterID applies equality check predicate on the "guest_voter_id" field. It's identical to GuestVoterIDEQ.
+func GuestVoterID(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldGuestVoterID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldCreatedAt, v))
@@ -135,6 +140,46 @@ func UserIDNotIn(vs ...int) predicate.Vote {
 	return predicate.Vote(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Vote {
+	return predicate.Vote(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Vote {
+	return predicate.Vote(sql.FieldNotNull(FieldUserID))
+}
+
+// GuestVoterIDEQ applies the EQ predicate on the "guest_voter_id" field.
+func GuestVoterIDEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldGuestVoterID, v))
+}
+
+// GuestVoterIDNEQ applies the NEQ predicate on the "guest_voter_id" field.
+func GuestVoterIDNEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldNEQ(FieldGuestVoterID, v))
+}
+
+// GuestVoterIDIn applies the In predicate on the "guest_voter_id" field.
+func GuestVoterIDIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldIn(FieldGuestVoterID, vs...))
+}
+
+// GuestVoterIDNotIn applies the NotIn predicate on the "guest_voter_id" field.
+func GuestVoterIDNotIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldNotIn(FieldGuestVoterID, vs...))
+}
+
+// GuestVoterIDIsNil applies the IsNil predicate on the "guest_voter_id" field.
+func GuestVoterIDIsNil() predicate.Vote {
+	return predicate.Vote(sql.FieldIsNull(FieldGuestVoterID))
+}
+
+// GuestVoterIDNotNil applies the NotNil predicate on the "guest_voter_id" field.
+func GuestVoterIDNotNil() predicate.Vote {
+	return predicate.Vote(sql.FieldNotNull(FieldGuestVoterID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldCreatedAt, v))
@@ -244,6 +289,29 @@ func HasUserWith(preds ...predicate.User) predicate.Vote {
 	})
 }
 
+// HasGuestVoter applies the HasEdge predicate on the "guest_voter" edge.
+func HasGuestVoter() predicate.Vote {
+	return predicate.Vote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, GuestVoterTable, GuestVoterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGuestVoterWith applies the HasEdge predicate on the "guest_voter" edge with a given conditions (other predicates).
+func HasGuestVoterWith(preds ...predicate.GuestVoter) predicate.Vote {
+	return predicate.Vote(func(s *sql.Selector) {
+		step := newGuestVoterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Vote) predicate.Vote {
 	return predicate.Vote(sql.AndPredicates(predicates...))
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pollapp/backend/ent/guestvoter"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
 	"pollapp/backend/ent/user"
@@ -41,6 +42,28 @@ func (_c *VoteCreate) SetUserID(v int) *VoteCreate {
 	return _c
 }
 
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *VoteCreate) SetNillableUserID(v *int) *VoteCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_c *VoteCreate) SetGuestVoterID(v int) *VoteCreate {
+	_c.mutation.SetGuestVoterID(v)
+	return _c
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_c *VoteCreate) SetNillableGuestVoterID(v *int) *VoteCreate {
+	if v != nil {
+		_c.SetGuestVoterID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *VoteCreate) SetCreatedAt(v time.Time) *VoteCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -70,6 +93,11 @@ func (_c *VoteCreate) SetUser(v *User) *VoteCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_c *VoteCreate) SetGuestVoter(v *GuestVoter) *VoteCreate {
+	return _c.SetGuestVoterID(v.ID)
+}
+
 // Mutation returns the VoteMutation object of the builder.
 func (_c *VoteCreate) Mutation() *VoteMutation {
 	return _c.mutation
@@ -119,9 +147,6 @@ func (_c *VoteCreate) check() error {
 	if _, ok := _c.mutation.PollOptionID(); !ok {
 		return &ValidationError{Name: "poll_option_id", err: errors.New(`ent: missing required field "Vote.poll_option_id"`)}
 	}
-	if _, ok := _c.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Vote.user_id"`)}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Vote.created_at"`)}
 	}
@@ -131,9 +156,6 @@ func (_c *VoteCreate) check() error {
 	if len(_c.mutation.PollOptionIDs()) == 0 {
 		return &ValidationError{Name: "poll_option", err: errors.New(`ent: missing required edge "Vote.poll_option"`)}
 	}
-	if len(_c.mutation.UserIDs()) == 0 {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Vote.user"`)}
-	}
 	return nil
 }
 
@@ -215,6 +237,23 @@ func (_c *VoteCreate) createSpec() (*Vote, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vote.GuestVoterTable,
+			Columns: []string{vote.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuestVoterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"pollapp/backend/ent/guestvoter"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
 	"pollapp/backend/ent/predicate"
@@ -28,6 +29,7 @@ type VoteQuery struct {
 	withPoll       *PollQuery
 	withPollOption *PollOptionQuery
 	withUser       *UserQuery
+	withGuestVoter *GuestVoterQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -130,6 +132,28 @@ func (_q *VoteQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryGuestVoter chains the current query on the "guest_voter" edge.
+func (_q *VoteQuery) QueryGuestVoter() *GuestVoterQuery {
+	query := (&GuestVoterClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vote.Table, vote.FieldID, selector),
+			sqlgraph.To(guestvoter.Table, guestvoter.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vote.GuestVoterTable, vote.GuestVoterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Vote entity from the query.
 // Returns a *NotFoundError when no Vote was found.
 func (_q *VoteQuery) First(ctx context.Context) (*Vote, error) {
@@ -325,6 +349,7 @@ func (_q *VoteQuery) Clone() *VoteQuery {
 		withPoll:       _q.withPoll.Clone(),
 		withPollOption: _q.withPollOption.Clone(),
 		withUser:       _q.withUser.Clone(),
+		withGuestVoter: _q.withGuestVoter.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -364,6 +389,17 @@ func (_q *VoteQuery) WithUser(opts ...func(*UserQuery)) *VoteQuery {
 	return _q
 }
 
+// WithGuestVoter tells the query-builder to eager-load the nodes that are connected to
+// the "guest_voter" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VoteQuery) WithGuestVoter(opts ...func(*GuestVoterQuery)) *VoteQuery {
+	query := (&GuestVoterClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGuestVoter = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -442,10 +478,11 @@ func (_q *VoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vote, e
 	var (
 		nodes       = []*Vote{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withPoll != nil,
 			_q.withPollOption != nil,
 			_q.withUser != nil,
+			_q.withGuestVoter != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -484,6 +521,12 @@ func (_q *VoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vote, e
 			return nil, err
 		}
 	}
+	if query := _q.withGuestVoter; query != nil {
+		if err := _q.loadGuestVoter(ctx, query, nodes, nil,
+			func(n *Vote, e *GuestVoter) { n.Edges.GuestVoter = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -574,6 +617,35 @@ func (_q *VoteQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Vo
 	}
 	return nil
 }
+func (_q *VoteQuery) loadGuestVoter(ctx context.Context, query *GuestVoterQuery, nodes []*Vote, init func(*Vote), assign func(*Vote, *GuestVoter)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Vote)
+	for i := range nodes {
+		fk := nodes[i].GuestVoterID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(guestvoter.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "guest_voter_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *VoteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -609,6 +681,9 @@ func (_q *VoteQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withUser != nil {
 			_spec.Node.AddColumnOnce(vote.FieldUserID)
 		}
+		if _q.withGuestVoter != nil {
+			_spec.Node.AddColumnOnce(vote.FieldGuestVoterID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pollapp/backend/ent/guestvoter"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
 	"pollapp/backend/ent/predicate"
@@ -73,6 +74,32 @@ func (_u *VoteUpdate) SetNillableUserID(v *int) *VoteUpdate {
 	return _u
 }
 
+// ClearUserID clears the value of the "user_id" field.
+func (_u *VoteUpdate) ClearUserID() *VoteUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_u *VoteUpdate) SetGuestVoterID(v int) *VoteUpdate {
+	_u.mutation.SetGuestVoterID(v)
+	return _u
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_u *VoteUpdate) SetNillableGuestVoterID(v *int) *VoteUpdate {
+	if v != nil {
+		_u.SetGuestVoterID(*v)
+	}
+	return _u
+}
+
+// ClearGuestVoterID clears the value of the "guest_voter_id" field.
+func (_u *VoteUpdate) ClearGuestVoterID() *VoteUpdate {
+	_u.mutation.ClearGuestVoterID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *VoteUpdate) SetCreatedAt(v time.Time) *VoteUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -102,6 +129,11 @@ func (_u *VoteUpdate) SetUser(v *User) *VoteUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_u *VoteUpdate) SetGuestVoter(v *GuestVoter) *VoteUpdate {
+	return _u.SetGuestVoterID(v.ID)
+}
+
 // Mutation returns the VoteMutation object of the builder.
 func (_u *VoteUpdate) Mutation() *VoteMutation {
 	return _u.mutation
@@ -125,6 +157,12 @@ func (_u *VoteUpdate) ClearUser() *VoteUpdate {
 	return _u
 }
 
+// ClearGuestVoter clears the "guest_voter" edge to the GuestVoter entity.
+func (_u *VoteUpdate) ClearGuestVoter() *VoteUpdate {
+	_u.mutation.ClearGuestVoter()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *VoteUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -160,9 +198,6 @@ func (_u *VoteUpdate) check() error {
 	if _u.mutation.PollOptionCleared() && len(_u.mutation.PollOptionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Vote.poll_option"`)
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Vote.user"`)
-	}
 	return nil
 }
 
@@ -268,6 +303,35 @@ func (_u *VoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GuestVoterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vote.GuestVoterTable,
+			Columns: []string{vote.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vote.GuestVoterTable,
+			Columns: []string{vote.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vote.Label}
@@ -330,6 +394,32 @@ func (_u *VoteUpdateOne) SetNillableUserID(v *int) *VoteUpdateOne {
 	return _u
 }
 
+// ClearUserID clears the value of the "user_id" field.
+func (_u *VoteUpdateOne) ClearUserID() *VoteUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetGuestVoterID sets the "guest_voter_id" field.
+func (_u *VoteUpdateOne) SetGuestVoterID(v int) *VoteUpdateOne {
+	_u.mutation.SetGuestVoterID(v)
+	return _u
+}
+
+// SetNillableGuestVoterID sets the "guest_voter_id" field if the given value is not nil.
+func (_u *VoteUpdateOne) SetNillableGuestVoterID(v *int) *VoteUpdateOne {
+	if v != nil {
+		_u.SetGuestVoterID(*v)
+	}
+	return _u
+}
+
+// ClearGuestVoterID clears the value of the "guest_voter_id" field.
+func (_u *VoteUpdateOne) ClearGuestVoterID() *VoteUpdateOne {
+	_u.mutation.ClearGuestVoterID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *VoteUpdateOne) SetCreatedAt(v time.Time) *VoteUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -359,6 +449,11 @@ func (_u *VoteUpdateOne) SetUser(v *User) *VoteUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetGuestVoter sets the "guest_voter" edge to the GuestVoter entity.
+func (_u *VoteUpdateOne) SetGuestVoter(v *GuestVoter) *VoteUpdateOne {
+	return _u.SetGuestVoterID(v.ID)
+}
+
 // Mutation returns the VoteMutation object of the builder.
 func (_u *VoteUpdateOne) Mutation() *VoteMutation {
 	return _u.mutation
@@ -382,6 +477,12 @@ func (_u *VoteUpdateOne) ClearUser() *VoteUpdateOne {
 	return _u
 }
 
+// ClearGuestVoter clears the "guest_voter" edge to the GuestVoter entity.
+func (_u *VoteUpdateOne) ClearGuestVoter() *VoteUpdateOne {
+	_u.mutation.ClearGuestVoter()
+	return _u
+}
+
 // Where appends a list predicates to the VoteUpdate builder.
 func (_u *VoteUpdateOne) Where(ps ...predicate.Vote) *VoteUpdateOne {
 	_u.mutation.Where(ps...)
@@ -430,9 +531,6 @@ func (_u *VoteUpdateOne) check() error {
 	if _u.mutation.PollOptionCleared() && len(_u.mutation.PollOptionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Vote.poll_option"`)
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Vote.user"`)
-	}
 	return nil
 }
 
@@ -555,6 +653,35 @@ func (_u *VoteUpdateOne) sqlSave(ctx context.Context) (_node *Vote, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GuestVoterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vote.GuestVoterTable,
+			Columns: []string{vote.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GuestVoterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vote.GuestVoterTable,
+			Columns: []string{vote.GuestVoterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guestvoter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Vote{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,95 @@
+// Package ballotlog defines the hash chain polls keep of their ballots, so
+// that voters can check their ballot was recorded and that nothing was
+// removed or rewritten afterwards.
+//
+// Every ballot is hashed together with a random nonce only the voter gets
+// back, so the published hashes say nothing about what was chosen. Each
+// chain entry hashes the previous entry's hash with the new ballot hash;
+// changing or dropping any entry changes every hash after it, including
+// the head.
+package ballotlog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Entry struct {
+	Sequence   int    `json:"sequence"`
+	BallotHash string `json:"ballot_hash"`
+	Hash       string `json:"hash"`
+}
+
+// Genesis is the hash the first entry of the poll's chain links to. It
+// binds the chain to the poll, so entries can't be moved between polls.
+func Genesis(pollID int) string {
+	return sum(fmt.Sprintf("poll:%d", pollID))
+}
+
+// Link returns the hash of the entry that appends ballotHash after prev.
+func Link(prev, ballotHash string) string {
+	return sum(prev + ":" + ballotHash)
+}
+
+// BallotHash commits to a ballot. choices are hashed in the order given,
+// which callers keep for rankings and sort otherwise; scores are hashed in
+// option order.
+func BallotHash(pollID int, nonce string, choices []int, scores map[int]int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "poll:%d|nonce:%s|choices:", pollID, nonce)
+	for i, id := range choices {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Itoa(id))
+	}
+
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	b.WriteString("|scores:")
+	for i, id := range ids {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, "%d=%d", id, scores[id])
+	}
+	return sum(b.String())
+}
+
+// Verify checks that entries form the poll's chain from its first entry
+// and returns the head, the hash of the last entry.
+func Verify(pollID int, entries []Entry) (string, error) {
+	head := Genesis(pollID)
+	for i, e := range entries {
+		if e.Sequence != i+1 {
+			return "", fmt.Errorf("entry %d: expected sequence %d, got %d", i, i+1, e.Sequence)
+		}
+		if want := Link(head, e.BallotHash); e.Hash != want {
+			return "", fmt.Errorf("entry %d: hash doesn't match the chain", e.Sequence)
+		}
+		head = e.Hash
+	}
+	return head, nil
+}
+
+// Find returns the entry recording ballotHash, if any.
+func Find(entries []Entry, ballotHash string) (Entry, bool) {
+	for _, e := range entries {
+		if e.BallotHash == ballotHash {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+func sum(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
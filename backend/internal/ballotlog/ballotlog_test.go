@@ -0,0 +1,190 @@
+package ballotlog
+
+import (
+	"strings"
+	"testing"
+)
+
+const testPoll = 7
+
+// chain builds the poll's log of the given ballot hashes.
+func chain(pollID int, ballotHashes ...string) []Entry {
+	entries := make([]Entry, len(ballotHashes))
+	prev := Genesis(pollID)
+	for i, h := range ballotHashes {
+		entries[i] = Entry{Sequence: i + 1, BallotHash: h, Hash: Link(prev, h)}
+		prev = entries[i].Hash
+	}
+	return entries
+}
+
+func ballots() []string {
+	return []string{
+		BallotHash(testPoll, "n1", []int{1}, nil),
+		BallotHash(testPoll, "n2", []int{2}, nil),
+		BallotHash(testPoll, "n3", []int{1, 2}, nil),
+		BallotHash(testPoll, "n4", nil, map[int]int{1: 5, 2: 3}),
+	}
+}
+
+func TestVerify(t *testing.T) {
+	intact := chain(testPoll, ballots()...)
+
+	tests := []struct {
+		name    string
+		pollID  int
+		entries func() []Entry
+		wantErr string
+	}{
+		{name: "empty", pollID: testPoll, entries: func() []Entry { return nil }},
+		{name: "intact", pollID: testPoll, entries: func() []Entry { return intact }},
+		{
+			name:   "edited ballot hash",
+			pollID: testPoll,
+			entries: func() []Entry {
+				e := append([]Entry(nil), intact...)
+				e[1].BallotHash = BallotHash(testPoll, "n2", []int{1}, nil)
+				return e
+			},
+			wantErr: "entry 2: hash doesn't match",
+		},
+		{
+			name:   "edited ballot with its hash recomputed",
+			pollID: testPoll,
+			entries: func() []Entry {
+				e := append([]Entry(nil), intact...)
+				e[1].BallotHash = BallotHash(testPoll, "n2", []int{1}, nil)
+				e[1].Hash = Link(e[0].Hash, e[1].BallotHash)
+				return e
+			},
+			wantErr: "entry 3: hash doesn't match",
+		},
+		{
+			name:   "reordered",
+			pollID: testPoll,
+			entries: func() []Entry {
+				e := append([]Entry(nil), intact...)
+				e[1], e[2] = e[2], e[1]
+				e[1].Sequence, e[2].Sequence = 2, 3
+				return e
+			},
+			wantErr: "entry 2: hash doesn't match",
+		},
+		{
+			name:   "dropped",
+			pollID: testPoll,
+			entries: func() []Entry {
+				return append(append([]Entry(nil), intact[:1]...), intact[2:]...)
+			},
+			wantErr: "expected sequence 2, got 3",
+		},
+		{
+			name:   "dropped and renumbered",
+			pollID: testPoll,
+			entries: func() []Entry {
+				e := append(append([]Entry(nil), intact[:1]...), intact[2:]...)
+				for i := range e {
+					e[i].Sequence = i + 1
+				}
+				return e
+			},
+			wantErr: "entry 2: hash doesn't match",
+		},
+		{
+			name:   "first entry dropped",
+			pollID: testPoll,
+			entries: func() []Entry {
+				e := append([]Entry(nil), intact[1:]...)
+				for i := range e {
+					e[i].Sequence = i + 1
+				}
+				return e
+			},
+			wantErr: "entry 1: hash doesn't match",
+		},
+		{
+			name:    "another poll's chain",
+			pollID:  testPoll + 1,
+			entries: func() []Entry { return intact },
+			wantErr: "entry 1: hash doesn't match",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := tt.entries()
+			head, err := Verify(tt.pollID, entries)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Verify error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := Genesis(tt.pollID)
+			if len(entries) > 0 {
+				want = entries[len(entries)-1].Hash
+			}
+			if head != want {
+				t.Errorf("head = %s, want %s", head, want)
+			}
+		})
+	}
+}
+
+// Dropping the last entry leaves a valid chain, but not the head that
+// was published before.
+func TestVerifyTruncated(t *testing.T) {
+	intact := chain(testPoll, ballots()...)
+	published, err := Verify(testPoll, intact)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := Verify(testPoll, intact[:len(intact)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head == published {
+		t.Error("truncated chain has the published head")
+	}
+}
+
+func TestFind(t *testing.T) {
+	hashes := ballots()
+	entries := chain(testPoll, hashes...)
+
+	e, ok := Find(entries, hashes[2])
+	if !ok || e.Sequence != 3 {
+		t.Errorf("Find = %+v, %v, want entry 3", e, ok)
+	}
+
+	// A receipt whose ballot isn't in the log, as when a ballot was
+	// dropped, or the voter's choices differ from what was recorded
+	for _, missing := range []string{
+		BallotHash(testPoll, "n5", []int{1}, nil),
+		BallotHash(testPoll, "n1", []int{2}, nil),
+		BallotHash(testPoll+1, "n1", []int{1}, nil),
+	} {
+		if e, ok := Find(entries, missing); ok {
+			t.Errorf("Find(%s) = %+v, want not found", missing, e)
+		}
+	}
+}
+
+func TestBallotHash(t *testing.T) {
+	base := BallotHash(testPoll, "nonce", []int{1, 2}, map[int]int{1: 5, 2: 3})
+	if got := BallotHash(testPoll, "nonce", []int{1, 2}, map[int]int{2: 3, 1: 5}); got != base {
+		t.Error("hash depends on score map order")
+	}
+	for name, other := range map[string]string{
+		"poll":    BallotHash(testPoll+1, "nonce", []int{1, 2}, map[int]int{1: 5, 2: 3}),
+		"nonce":   BallotHash(testPoll, "other", []int{1, 2}, map[int]int{1: 5, 2: 3}),
+		"ranking": BallotHash(testPoll, "nonce", []int{2, 1}, map[int]int{1: 5, 2: 3}),
+		"scores":  BallotHash(testPoll, "nonce", []int{1, 2}, map[int]int{1: 5, 2: 4}),
+	} {
+		if other == base {
+			t.Errorf("hash doesn't depend on the %s", name)
+		}
+	}
+}
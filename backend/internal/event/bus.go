@@ -0,0 +1,50 @@
+package event
+
+import (
+	"sync"
+	"time"
+)
+
+type Type string
+
+const (
+	// PollClosed is published when a poll stops accepting votes.
+	PollClosed Type = "poll.closed"
+)
+
+type Event struct {
+	Type   Type
+	PollID int
+	At     time.Time
+}
+
+// Bus is an in-process publish/subscribe hub that lets features react to
+// things happening elsewhere in the server without depending on each
+// other.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[Type][]func(Event)
+}
+
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[Type][]func(Event))}
+}
+
+// Subscribe registers fn to be called for every event of type t.
+func (b *Bus) Subscribe(t Type, fn func(Event)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[t] = append(b.handlers[t], fn)
+}
+
+// Publish delivers e to the subscribers of its type, synchronously and in
+// subscription order.
+func (b *Bus) Publish(e Event) {
+	b.mu.RLock()
+	handlers := b.handlers[e.Type]
+	b.mu.RUnlock()
+
+	for _, fn := range handlers {
+		fn(e)
+	}
+}
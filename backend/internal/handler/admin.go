@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+type AdminHandler struct {
+	service *service.AdminService
+}
+
+func NewAdminHandler(service *service.AdminService) *AdminHandler {
+	return &AdminHandler{service: service}
+}
+
+// ListUsers returns the site's users, filtered by the q query parameter
+// against usernames and emails.
+func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+
+	users, err := h.service.ListUsers(r.Context(), userID, r.URL.Query().Get("q"))
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(users)
+}
+
+func (h *AdminHandler) SetRole(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Role == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "role is required"})
+		return
+	}
+
+	account, err := h.service.SetRole(r.Context(), userID, id, req.Role)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(account)
+}
+
+func (h *AdminHandler) BanUser(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	account, err := h.service.BanUser(r.Context(), userID, id)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(account)
+}
+
+func (h *AdminHandler) UnbanUser(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	account, err := h.service.UnbanUser(r.Context(), userID, id)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(account)
+}
+
+// Transitions returns the poll lifecycle audit trail, optionally filtered
+// by the poll_id and actor_id query parameters.
+func (h *AdminHandler) Transitions(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	pollID, _ := strconv.Atoi(r.URL.Query().Get("poll_id"))
+	actorID, _ := strconv.Atoi(r.URL.Query().Get("actor_id"))
+
+	transitions, err := h.service.Transitions(r.Context(), userID, pollID, actorID)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(transitions)
+}
+
+// PollHistory returns the recorded changes to any poll, deleted or not.
+func (h *AdminHandler) PollHistory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	revisions, err := h.service.PollHistory(r.Context(), userID, id)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(revisions)
+}
+
+// AuditLog returns audit log entries, newest first. The event, actor_id,
+// ip, since, until, before_id and limit query parameters filter them;
+// since and until are RFC 3339 times.
+func (h *AdminHandler) AuditLog(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	query := r.URL.Query()
+
+	filter := service.AuditFilter{
+		Event: query.Get("event"),
+		IP:    query.Get("ip"),
+	}
+	filter.ActorID, _ = strconv.Atoi(query.Get("actor_id"))
+	filter.BeforeID, _ = strconv.Atoi(query.Get("before_id"))
+	filter.Limit, _ = strconv.Atoi(query.Get("limit"))
+	for name, t := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		if v := query.Get(name); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"error": name + " must be an RFC 3339 time"})
+				return
+			}
+			*t = parsed
+		}
+	}
+
+	events, err := h.service.AuditLog(r.Context(), userID, filter)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(events)
+}
+
+func writeAdminError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusBadRequest
+	if ent.IsNotFound(err) {
+		statusCode = http.StatusNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+	} else if errors.Is(err, service.ErrLastAdmin) {
+		statusCode = http.StatusConflict
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
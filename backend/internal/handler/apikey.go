@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"pollapp/backend/ent"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// CreateAPIKey creates a personal API key acting in the caller's active
+// organization. The key is only ever returned here.
+func (h *AuthHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	orgID, _ := r.Context().Value("orgID").(int)
+
+	var req struct {
+		Name   string   `json:"name"`
+		Scopes []string `json:"scopes"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	apiKey, key, err := h.service.CreateAPIKey(r.Context(), userID, orgID, req.Name, req.Scopes)
+	if err != nil {
+		writeAPIKeyError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"api_key": apiKey,
+		"key":     key,
+	})
+}
+
+func (h *AuthHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+
+	keys, err := h.service.ListAPIKeys(r.Context(), userID)
+	if err != nil {
+		writeAPIKeyError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(keys)
+}
+
+func (h *AuthHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	if err := h.service.RevokeAPIKey(r.Context(), userID, id); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		writeAPIKeyError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeAPIKeyError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusBadRequest
+	if ent.IsNotFound(err) {
+		statusCode = http.StatusNotFound
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
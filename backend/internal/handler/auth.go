@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 
@@ -64,9 +65,95 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request, _ httprouter
 		return
 	}
 
-	token, err := h.service.Login(r.Context(), req.Email, req.Password)
+	tokens, err := h.service.Login(r.Context(), req.Email, req.Password)
 	if err != nil {
-		w.WriteHeader(http.StatusUnauthorized)
+		statusCode := http.StatusUnauthorized
+		if errors.Is(err, service.ErrUserBanned) {
+			statusCode = http.StatusForbidden
+		}
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(tokens)
+}
+
+// Refresh trades a refresh token for a new access token and refresh token.
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "refresh_token is required"})
+		return
+	}
+
+	tokens, err := h.service.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		writeSessionError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(tokens)
+}
+
+// Logout revokes the session of the given refresh token. It takes the
+// refresh token rather than the access token so that clients whose access
+// token has expired can still sign out.
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "refresh_token is required"})
+		return
+	}
+
+	if err := h.service.Logout(r.Context(), req.RefreshToken); err != nil {
+		writeSessionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SwitchOrganization issues a new token with a different active
+// organization; organization_id 0 leaves all organizations.
+func (h *AuthHandler) SwitchOrganization(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	sessionID := r.Context().Value("sessionID").(int)
+	// API keys have no session to switch
+	if sessionID == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "switching organizations needs a signed-in session"})
+		return
+	}
+
+	var req struct {
+		OrganizationID int `json:"organization_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	token, err := h.service.SwitchOrganization(r.Context(), userID, sessionID, req.OrganizationID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, service.ErrNotOrgMember) {
+			statusCode = http.StatusForbidden
+		}
+		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 		return
 	}
@@ -75,3 +162,25 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request, _ httprouter
 		"token": token,
 	})
 }
+
+// JWKS publishes the public keys PollApp tokens can be verified with, so
+// other services can check them without sharing a secret. Only access
+// tokens carry the configured audience; services must require it, since
+// PollApp signs its other tokens with the same keys.
+func (h *AuthHandler) JWKS(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	json.NewEncoder(w).Encode(h.service.JWKS())
+}
+
+func writeSessionError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusInternalServerError
+	if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrRefreshTokenReused) || errors.Is(err, service.ErrMFARequired) {
+		statusCode = http.StatusUnauthorized
+	} else if errors.Is(err, service.ErrUserBanned) {
+		statusCode = http.StatusForbidden
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
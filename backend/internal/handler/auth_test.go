@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSwitchOrganizationWithoutSession(t *testing.T) {
+	h := NewAuthHandler(nil)
+	r := httptest.NewRequest(http.MethodPost, "/api/auth/switch-org", strings.NewReader(`{"organization_id": 1}`))
+	// API keys authenticate a user without a session
+	ctx := context.WithValue(r.Context(), "userID", 1)
+	ctx = context.WithValue(ctx, "sessionID", 0)
+	w := httptest.NewRecorder()
+
+	h.SwitchOrganization(w, r.WithContext(ctx), nil)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "signed-in session") {
+		t.Errorf("got %d %s, want 400 asking for a session", w.Code, w.Body)
+	}
+}
@@ -2,9 +2,16 @@ package handler
 
 import (
 	"pollapp/backend/ent"
+	"pollapp/backend/internal/audit"
+	"pollapp/backend/internal/history"
 	"entgo.io/ent/dialect/sql"
 )
 
+// NewEntClient returns a client that records the history of every poll
+// change and keeps the audit log append-only.
 func NewEntClient(drv *sql.Driver) *ent.Client {
-	return ent.NewClient(ent.Driver(drv))
+	client := ent.NewClient(ent.Driver(drv))
+	history.Register(client)
+	audit.Register(client)
+	return client
 }
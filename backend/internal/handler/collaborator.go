@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *PollHandler) ListCollaborators(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	collaborators, err := h.service.ListCollaborators(r.Context(), id, userID)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(collaborators)
+}
+
+// SetCollaborator gives a user, named by username or email, a role on the
+// poll or changes the role they have.
+func (h *PollHandler) SetCollaborator(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		User string `json:"user"`
+		Role string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.User == "" || req.Role == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "user and role are required"})
+		return
+	}
+
+	collaborator, err := h.service.SetCollaborator(r.Context(), id, userID, req.User, req.Role)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(collaborator)
+}
+
+// RemoveCollaborator takes away the role of the user named by username or
+// email in the :user route parameter.
+func (h *PollHandler) RemoveCollaborator(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	if err := h.service.RemoveCollaborator(r.Context(), id, userID, ps.ByName("user")); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		writeMemberError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
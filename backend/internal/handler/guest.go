@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"pollapp/backend/internal/middleware"
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+type GuestHandler struct {
+	authService *service.AuthService
+	pollService *service.PollService
+}
+
+func NewGuestHandler(authService *service.AuthService, pollService *service.PollService) *GuestHandler {
+	return &GuestHandler{authService: authService, pollService: pollService}
+}
+
+// IssueToken hands out a guest voter token for a poll that allows guests,
+// both in the response body and as a cookie scoped to the poll's routes,
+// or to the share link's when requested through one. A caller who already
+// holds a valid token for the poll gets it back rather than a new one.
+func (h *GuestHandler) IssueToken(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	// Guests have no account, so only public polls and polls shared by
+	// link are within reach
+	poll, err := accessiblePoll(h.pollService, r, id, 0)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	if !poll.AllowGuests {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{"error": service.ErrGuestsNotAllowed.Error()})
+		return
+	}
+
+	if cookie, err := r.Cookie(middleware.GuestTokenCookie); err == nil {
+		if _, err := h.authService.GuestSubject(cookie.Value, id); err == nil {
+			json.NewEncoder(w).Encode(map[string]string{"guest_token": cookie.Value})
+			return
+		}
+	}
+
+	token, expiresAt, err := h.authService.IssueGuestToken(id)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     middleware.GuestTokenCookie,
+		Value:    token,
+		Path:     path.Dir(r.URL.Path),
+		Expires:  expiresAt,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]string{
+		"guest_token": token,
+		"expires_at":  expiresAt.Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// History returns the recorded changes to the poll and its options.
+func (h *PollHandler) History(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	revisions, err := h.service.History(r.Context(), id, userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if ent.IsNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, service.ErrUnauthorized) {
+			statusCode = http.StatusForbidden
+		}
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(revisions)
+}
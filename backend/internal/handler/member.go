@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// SetVisibility changes whether the poll is public, unlisted or private.
+func (h *PollHandler) SetVisibility(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		Visibility string `json:"visibility"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	poll, err := h.service.SetVisibility(r.Context(), id, userID, req.Visibility)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	writePoll(w, poll)
+}
+
+func (h *PollHandler) ListMembers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	members, err := h.service.ListMembers(r.Context(), id, userID)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(members)
+}
+
+// AddMember invites a user, named by username or email, to the poll.
+func (h *PollHandler) AddMember(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		User string `json:"user"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.User == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "user is required"})
+		return
+	}
+
+	member, err := h.service.AddMember(r.Context(), id, userID, req.User)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(member)
+}
+
+// RemoveMember withdraws the invitation of the user named by username or
+// email in the :user route parameter.
+func (h *PollHandler) RemoveMember(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	if err := h.service.RemoveMember(r.Context(), id, userID, ps.ByName("user")); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		writeMemberError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeMemberError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusBadRequest
+	if ent.IsNotFound(err) || errors.Is(err, service.ErrUserNotFound) {
+		statusCode = http.StatusNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// VerifyMFA finishes a sign-in that needs a second factor, trading the mfa
+// token from login and a code for tokens.
+func (h *AuthHandler) VerifyMFA(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req struct {
+		MFAToken string `json:"mfa_token"`
+		Code     string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.MFAToken == "" || req.Code == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "mfa_token and code are required"})
+		return
+	}
+
+	tokens, err := h.service.VerifyMFA(r.Context(), req.MFAToken, req.Code)
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(tokens)
+}
+
+// EnrollTOTP starts setting up an authenticator app. Signed-in users call
+// it with their access token; users whose role requires a second factor
+// call it while signing in, with the mfa token from login.
+func (h *AuthHandler) EnrollTOTP(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req struct {
+		MFAToken string `json:"mfa_token"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+			return
+		}
+	}
+
+	userID, ok := r.Context().Value("userID").(int)
+	if req.MFAToken != "" {
+		var err error
+		userID, err = h.service.PendingMFAUser(r.Context(), req.MFAToken)
+		if err != nil {
+			writeMFAError(w, err)
+			return
+		}
+	} else if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Sign in or pass mfa_token"})
+		return
+	}
+
+	enrollment, err := h.service.EnrollTOTP(r.Context(), userID)
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(enrollment)
+}
+
+// ConfirmTOTP turns on the enrolled authenticator once a code from it is
+// shown, returning recovery codes. With an mfa token, it also completes
+// the sign-in and returns tokens.
+func (h *AuthHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req struct {
+		MFAToken string `json:"mfa_token"`
+		Code     string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Code == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "code is required"})
+		return
+	}
+
+	var enrollment *service.MFAEnrollment
+	var err error
+	if req.MFAToken != "" {
+		enrollment, err = h.service.FinishMFAEnrollment(r.Context(), req.MFAToken, req.Code)
+	} else if userID, ok := r.Context().Value("userID").(int); ok {
+		var codes []string
+		codes, err = h.service.ConfirmTOTP(r.Context(), userID, req.Code)
+		enrollment = &service.MFAEnrollment{RecoveryCodes: codes}
+	} else {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Sign in or pass mfa_token"})
+		return
+	}
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(enrollment)
+}
+
+func (h *AuthHandler) DisableTOTP(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+
+	var req struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Code == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "code is required"})
+		return
+	}
+
+	if err := h.service.DisableTOTP(r.Context(), userID, req.Code); err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+
+	var req struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Code == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "code is required"})
+		return
+	}
+
+	codes, err := h.service.RegenerateRecoveryCodes(r.Context(), userID, req.Code)
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string][]string{"recovery_codes": codes})
+}
+
+func writeMFAError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusInternalServerError
+	if errors.Is(err, service.ErrInvalidMFAToken) || errors.Is(err, service.ErrInvalidMFACode) {
+		statusCode = http.StatusUnauthorized
+	} else if errors.Is(err, service.ErrMFALocked) {
+		statusCode = http.StatusTooManyRequests
+	} else if errors.Is(err, service.ErrMFAAlreadyEnabled) || errors.Is(err, service.ErrMFANotEnabled) {
+		statusCode = http.StatusConflict
+	} else if errors.Is(err, service.ErrMFARequired) || errors.Is(err, service.ErrUserBanned) {
+		statusCode = http.StatusForbidden
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
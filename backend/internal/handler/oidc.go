@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// oidcStateCookie carries the signed sign-in state between the login and
+// callback requests. It is scoped to the OIDC routes.
+const (
+	oidcStateCookie = "oidc_state"
+	oidcCookiePath  = "/api/auth/oidc"
+)
+
+type OIDCHandler struct {
+	service *service.AuthService
+	// redirect is the frontend page sent the tokens after signing in, in
+	// the URL fragment. If empty the callback responds with JSON.
+	redirect string
+}
+
+func NewOIDCHandler(service *service.AuthService, redirect string) *OIDCHandler {
+	return &OIDCHandler{service: service, redirect: redirect}
+}
+
+// Login sends the browser to the OpenID provider to sign in.
+func (h *OIDCHandler) Login(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	authURL, stateToken, err := h.service.StartOIDC(r.Context())
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		writeOIDCError(w, err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    stateToken,
+		Path:     oidcCookiePath,
+		MaxAge:   int((10 * time.Minute).Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		// Lax, so the cookie comes along when the provider redirects back
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// Callback is where the provider sends the browser back with an
+// authorization code. It signs the user in and hands the tokens to the
+// frontend.
+func (h *OIDCHandler) Callback(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	query := r.URL.Query()
+
+	stateToken := ""
+	if cookie, err := r.Cookie(oidcStateCookie); err == nil {
+		stateToken = cookie.Value
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Path:     oidcCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	var result *service.LoginResult
+	var err error
+	if providerError := query.Get("error"); providerError != "" {
+		err = errors.New("sign-in was refused: " + providerError)
+	} else {
+		result, err = h.service.FinishOIDC(r.Context(), stateToken, query.Get("state"), query.Get("code"))
+	}
+
+	if h.redirect != "" {
+		fragment := url.Values{}
+		if err != nil {
+			fragment.Set("error", err.Error())
+		} else if result.MFAToken != "" {
+			fragment.Set("mfa_token", result.MFAToken)
+			if result.MFAEnrollmentRequired {
+				fragment.Set("mfa_enrollment_required", "true")
+			} else {
+				fragment.Set("mfa_required", "true")
+			}
+		} else {
+			fragment.Set("token", result.AccessToken)
+			fragment.Set("refresh_token", result.RefreshToken)
+			fragment.Set("expires_at", result.ExpiresAt.Format(time.RFC3339))
+		}
+		http.Redirect(w, r, h.redirect+"#"+fragment.Encode(), http.StatusFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		writeOIDCError(w, err)
+		return
+	}
+	json.NewEncoder(w).Encode(result)
+}
+
+func writeOIDCError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusUnauthorized
+	if errors.Is(err, service.ErrOIDCDisabled) {
+		statusCode = http.StatusNotFound
+	} else if errors.Is(err, service.ErrOIDCState) {
+		statusCode = http.StatusBadRequest
+	} else if errors.Is(err, service.ErrOIDCEmailTaken) {
+		statusCode = http.StatusConflict
+	} else if errors.Is(err, service.ErrUserBanned) {
+		statusCode = http.StatusForbidden
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
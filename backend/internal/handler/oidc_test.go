@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/enttest"
+	"pollapp/backend/internal/jwtkeys"
+	"pollapp/backend/internal/oidc"
+	"pollapp/backend/internal/oidc/oidctest"
+	"pollapp/backend/internal/service"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/julienschmidt/httprouter"
+	_ "modernc.org/sqlite"
+)
+
+// newOIDCServer serves the OIDC routes, signing in through a mock
+// provider. redirect is passed to NewOIDCHandler.
+func newOIDCServer(t *testing.T, redirect string) *httptest.Server {
+	t.Helper()
+	idpServer, idp, err := oidctest.NewServer("pollapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(idpServer.Close)
+	idp.SignInAs("carol@example.com", true)
+
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { client.Close() })
+	keys, err := jwtkeys.Load(jwtkeys.Config{
+		Secret:   "test-secret-at-least-32-characters-long",
+		Issuer:   "pollapp",
+		Audience: "pollapp",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewUnstartedServer(nil)
+	authService := service.NewAuthService(client, keys)
+	authService.EnableOIDC(oidc.New(oidc.Config{
+		Issuer:      idp.Issuer(),
+		ClientID:    "pollapp",
+		RedirectURL: "http://" + srv.Listener.Addr().String() + "/api/auth/oidc/callback",
+	}))
+	h := NewOIDCHandler(authService, redirect)
+	router := httprouter.New()
+	router.GET("/api/auth/oidc/login", h.Login)
+	router.GET("/api/auth/oidc/callback", h.Callback)
+	srv.Config.Handler = router
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newBrowser(t *testing.T) *http.Client {
+	t.Helper()
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Client{Jar: jar}
+}
+
+func TestOIDCLoginCallback(t *testing.T) {
+	srv := newOIDCServer(t, "")
+	browser := newBrowser(t)
+
+	resp, err := browser.Get(srv.URL + "/api/auth/oidc/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/api/auth/oidc/callback" {
+		t.Fatalf("ended at %s with %s, want the callback with 200 OK", resp.Request.URL.Path, resp.Status)
+	}
+	var result service.LoginResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Tokens == nil || result.AccessToken == "" || result.RefreshToken == "" {
+		t.Errorf("result = %+v, want tokens", result)
+	}
+
+	// The callback clears the state cookie, so it can't be replayed
+	u, _ := url.Parse(srv.URL + "/api/auth/oidc/callback")
+	if cookies := browser.Jar.Cookies(u); len(cookies) != 0 {
+		t.Errorf("cookies left after the callback: %v", cookies)
+	}
+}
+
+func TestOIDCLoginCallbackRedirect(t *testing.T) {
+	srv := newOIDCServer(t, "http://localhost:3000/auth/callback")
+	browser := newBrowser(t)
+	// Stop at the frontend, which isn't running
+	browser.CheckRedirect = func(req *http.Request, _ []*http.Request) error {
+		if req.URL.Host == "localhost:3000" {
+			return http.ErrUseLastResponse
+		}
+		return nil
+	}
+
+	resp, err := browser.Get(srv.URL + "/api/auth/oidc/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fragment, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location.Path != "/auth/callback" || fragment.Get("token") == "" || fragment.Get("refresh_token") == "" {
+		t.Errorf("redirected to %s, want the frontend with tokens in the fragment", location)
+	}
+}
+
+func TestOIDCCallbackWithoutState(t *testing.T) {
+	srv := newOIDCServer(t, "")
+	browser := newBrowser(t)
+	browser.CheckRedirect = func(req *http.Request, _ []*http.Request) error {
+		if req.URL.Path == "/api/auth/oidc/callback" {
+			return http.ErrUseLastResponse
+		}
+		return nil
+	}
+	resp, err := browser.Get(srv.URL + "/api/auth/oidc/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	// A browser that didn't start the sign-in has no state cookie
+	resp, err = http.Get(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("callback returned %s, want 400 Bad Request", resp.Status)
+	}
+}
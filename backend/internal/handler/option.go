@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *PollHandler) AddOption(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		Text string `json:"text"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	poll, err := h.service.AddOption(r.Context(), id, userID, req.Text)
+	if err != nil {
+		writeOptionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	writePoll(w, poll)
+}
+
+// ReorderOptions puts the poll's options in the order of the option_ids
+// in the body.
+func (h *PollHandler) ReorderOptions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		OptionIDs []int `json:"option_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	poll, err := h.service.ReorderOptions(r.Context(), id, userID, req.OptionIDs)
+	if err != nil {
+		writeOptionError(w, err)
+		return
+	}
+
+	writePoll(w, poll)
+}
+
+func (h *PollHandler) RenameOption(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+	optionID, _ := strconv.Atoi(ps.ByName("optionID"))
+
+	var req struct {
+		Text string `json:"text"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	poll, err := h.service.RenameOption(r.Context(), id, userID, optionID, req.Text)
+	if err != nil {
+		writeOptionError(w, err)
+		return
+	}
+
+	writePoll(w, poll)
+}
+
+func (h *PollHandler) RemoveOption(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+	optionID, _ := strconv.Atoi(ps.ByName("optionID"))
+
+	poll, err := h.service.RemoveOption(r.Context(), id, userID, optionID)
+	if err != nil {
+		writeOptionError(w, err)
+		return
+	}
+
+	writePoll(w, poll)
+}
+
+func writeOptionError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusBadRequest
+	if ent.IsNotFound(err) {
+		statusCode = http.StatusNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+	} else if errors.Is(err, service.ErrOptionsLocked) {
+		statusCode = http.StatusConflict
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
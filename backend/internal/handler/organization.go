@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+type OrgHandler struct {
+	service *service.OrgService
+}
+
+func NewOrgHandler(service *service.OrgService) *OrgHandler {
+	return &OrgHandler{service: service}
+}
+
+func (h *OrgHandler) CreateOrganization(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	org, err := h.service.CreateOrganization(r.Context(), userID, req.Name)
+	if err != nil {
+		writeOrgError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(org)
+}
+
+// ListOrganizations returns the caller's organizations and their role in
+// each.
+func (h *OrgHandler) ListOrganizations(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+
+	orgs, err := h.service.ListOrganizations(r.Context(), userID)
+	if err != nil {
+		writeOrgError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(orgs)
+}
+
+func (h *OrgHandler) ListMembers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	members, err := h.service.ListMembers(r.Context(), id, userID)
+	if err != nil {
+		writeOrgError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(members)
+}
+
+// SetMember adds a user, named by username or email, to the organization
+// or changes their role.
+func (h *OrgHandler) SetMember(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		User string `json:"user"`
+		Role string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.User == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "user is required"})
+		return
+	}
+
+	member, err := h.service.SetMember(r.Context(), id, userID, req.User, req.Role)
+	if err != nil {
+		writeOrgError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(member)
+}
+
+// AcceptInvitation makes the caller a member of an organization they were
+// invited to.
+func (h *OrgHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	org, err := h.service.AcceptInvitation(r.Context(), id, userID)
+	if err != nil {
+		writeOrgError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(org)
+}
+
+func (h *OrgHandler) RemoveMember(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	if err := h.service.RemoveMember(r.Context(), id, userID, ps.ByName("user")); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		writeOrgError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeOrgError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusBadRequest
+	if ent.IsNotFound(err) || errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrNoInvitation) {
+		statusCode = http.StatusNotFound
+	} else if errors.Is(err, service.ErrNotOrgMember) || errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+	} else if errors.Is(err, service.ErrLastOwner) {
+		statusCode = http.StatusConflict
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -1,11 +1,16 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/audit"
 	"pollapp/backend/internal/service"
 
 	"github.com/julienschmidt/httprouter"
@@ -23,11 +28,27 @@ func (h *PollHandler) CreatePoll(w http.ResponseWriter, r *http.Request, _ httpr
 	w.Header().Set("Content-Type", "application/json")
 	
 	userID := r.Context().Value("userID").(int)
+	orgID, _ := r.Context().Value("orgID").(int)
 
 	var req struct {
-		Title       string   `json:"title"`
-		Description string   `json:"description"`
-		Options     []string `json:"options"`
+		Title             string     `json:"title"`
+		Description       string     `json:"description"`
+		Options           []string   `json:"options"`
+		MinChoices        int        `json:"min_choices"`
+		MaxChoices        int        `json:"max_choices"`
+		VotingMethod      string     `json:"voting_method"`
+		TallyRule         string     `json:"tally_rule"`
+		ScoreMin          int        `json:"score_min"`
+		ScoreMax          int        `json:"score_max"`
+		PassThreshold     string     `json:"pass_threshold"`
+		PassPercentage    int        `json:"pass_percentage"`
+		OpensAt           *time.Time `json:"opens_at"`
+		ClosesAt          *time.Time `json:"closes_at"`
+		ResultsVisibility string     `json:"results_visibility"`
+		Anonymous         bool       `json:"anonymous"`
+		Visibility        string     `json:"visibility"`
+		AllowGuests       bool       `json:"allow_guests"`
+		GuestDedupe       string     `json:"guest_dedupe"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -36,17 +57,39 @@ func (h *PollHandler) CreatePoll(w http.ResponseWriter, r *http.Request, _ httpr
 		return
 	}
 
-	// Validate options
-	if len(req.Options) < 2 {
+	// Validate options; proposal polls get fixed Yes/No/Abstain options
+	if req.VotingMethod != "proposal" && len(req.Options) < 2 {
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(map[string]string{"error": "poll must have at least 2 options"})
 		return
 	}
 
-	poll, err := h.service.CreatePoll(r.Context(), userID, req.Title, req.Description, req.Options)
+	settings := service.PollSettings{
+		MinChoices:        req.MinChoices,
+		MaxChoices:        req.MaxChoices,
+		VotingMethod:      req.VotingMethod,
+		TallyRule:         req.TallyRule,
+		ScoreMin:          req.ScoreMin,
+		ScoreMax:          req.ScoreMax,
+		PassThreshold:     req.PassThreshold,
+		PassPercentage:    req.PassPercentage,
+		OpensAt:           req.OpensAt,
+		ClosesAt:          req.ClosesAt,
+		ResultsVisibility: req.ResultsVisibility,
+		Anonymous:         req.Anonymous,
+		Visibility:        req.Visibility,
+		AllowGuests:       req.AllowGuests,
+		GuestDedupe:       req.GuestDedupe,
+	}
+
+	poll, err := h.service.CreatePoll(r.Context(), userID, orgID, req.Title, req.Description, req.Options, settings)
 	if err != nil {
 		log.Printf("CreatePoll error: %v", err)
-		w.WriteHeader(http.StatusBadRequest)
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			w.WriteHeader(http.StatusForbidden)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
 		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 		return
 	}
@@ -58,55 +101,114 @@ func (h *PollHandler) CreatePoll(w http.ResponseWriter, r *http.Request, _ httpr
 func (h *PollHandler) ListPolls(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	w.Header().Set("Content-Type", "application/json")
 	
-	polls, err := h.service.ListPolls(r.Context())
+	userID, _ := r.Context().Value("userID").(int)
+	orgID, _ := r.Context().Value("orgID").(int)
+	includeArchived := r.URL.Query().Get("include_archived") == "true"
+	polls, err := h.service.ListPolls(r.Context(), userID, orgID, includeArchived)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	// Add vote counts to each poll
+	// Add vote counts to each poll the caller may see results for
 	type OptionWithVotes struct {
 		ID        int    `json:"id"`
 		Text      string `json:"text"`
 		Order     int    `json:"order"`
-		VoteCount int    `json:"vote_count"`
+		VoteCount *int   `json:"vote_count,omitempty"`
 	}
 
 	type PollWithVotes struct {
-		ID          int               `json:"id"`
-		Title       string            `json:"title"`
-		Description string            `json:"description"`
-		CreatedBy   int               `json:"created_by"`
-		CreatedAt   string            `json:"created_at"`
-		Options     []OptionWithVotes `json:"options"`
+		ID                int               `json:"id"`
+		Title             string            `json:"title"`
+		Description       string            `json:"description"`
+		CreatedBy         int               `json:"created_by"`
+		CreatedAt         string            `json:"created_at"`
+		OpensAt           *string           `json:"opens_at"`
+		ClosesAt          *string           `json:"closes_at"`
+		Status            string            `json:"status"`
+		VotingMethod      string            `json:"voting_method"`
+		TallyRule         string            `json:"tally_rule"`
+		MinChoices        int               `json:"min_choices"`
+		MaxChoices        int               `json:"max_choices"`
+		ScoreMin          int               `json:"score_min"`
+		ScoreMax          int               `json:"score_max"`
+		PassThreshold     string            `json:"pass_threshold"`
+		PassPercentage    int               `json:"pass_percentage"`
+		Visibility        string            `json:"visibility"`
+		ResultsVisibility string            `json:"results_visibility"`
+		ResultsVisible    bool              `json:"results_visible"`
+		Anonymous         bool              `json:"anonymous"`
+		Revision          int               `json:"revision"`
+		VoterCount        *int              `json:"voter_count,omitempty"`
+		SelectionCount    *int              `json:"selection_count,omitempty"`
+		Options           []OptionWithVotes `json:"options"`
 	}
 
+	now := time.Now()
 	result := make([]PollWithVotes, len(polls))
 	for i, poll := range polls {
-		voteCounts, err := h.service.GetVoteCounts(r.Context(), poll.ID)
+		visible, err := h.service.CanSeeResults(r.Context(), poll, userID)
 		if err != nil {
-			voteCounts = make(map[int]int)
+			visible = false
+		}
+
+		voteCounts := make(map[int]int)
+		voterCount := 0
+		if visible {
+			if voteCounts, err = h.service.GetVoteCounts(r.Context(), poll.ID); err != nil {
+				voteCounts = make(map[int]int)
+			}
+			if voterCount, err = h.service.GetVoterCount(r.Context(), poll.ID); err != nil {
+				voterCount = 0
+			}
 		}
 
+		selectionCount := 0
 		options := make([]OptionWithVotes, 0)
 		if poll.Edges.Options != nil {
 			for _, option := range poll.Edges.Options {
-				options = append(options, OptionWithVotes{
-					ID:        option.ID,
-					Text:      option.OptionText,
-					Order:     option.Order,
-					VoteCount: voteCounts[option.ID],
-				})
+				o := OptionWithVotes{
+					ID:    option.ID,
+					Text:  option.OptionText,
+					Order: option.Order,
+				}
+				if visible {
+					count := voteCounts[option.ID]
+					selectionCount += count
+					o.VoteCount = &count
+				}
+				options = append(options, o)
 			}
 		}
 
 		result[i] = PollWithVotes{
-			ID:          poll.ID,
-			Title:       poll.Title,
-			Description: poll.Description,
-			CreatedBy:   poll.CreatedBy,
-			CreatedAt:   poll.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			Options:     options,
+			ID:                poll.ID,
+			Title:             poll.Title,
+			Description:       poll.Description,
+			CreatedBy:         poll.CreatedBy,
+			CreatedAt:         poll.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			OpensAt:           formatTime(poll.OpensAt),
+			ClosesAt:          formatTime(poll.ClosesAt),
+			Status:            service.PollStatus(poll, now),
+			VotingMethod:      poll.VotingMethod.String(),
+			TallyRule:         poll.TallyRule.String(),
+			MinChoices:        poll.MinChoices,
+			MaxChoices:        poll.MaxChoices,
+			ScoreMin:          poll.ScoreMin,
+			ScoreMax:          poll.ScoreMax,
+			PassThreshold:     poll.PassThreshold.String(),
+			PassPercentage:    poll.PassPercentage,
+			Visibility:        poll.Visibility.String(),
+			ResultsVisibility: poll.ResultsVisibility.String(),
+			ResultsVisible:    visible,
+			Anonymous:         poll.Anonymous,
+			Revision:          poll.Revision,
+			Options:           options,
+		}
+		if visible {
+			result[i].VoterCount = &voterCount
+			result[i].SelectionCount = &selectionCount
 		}
 	}
 
@@ -114,14 +216,71 @@ func (h *PollHandler) ListPolls(w http.ResponseWriter, r *http.Request, _ httpro
 }
 
 func (h *PollHandler) GetPoll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID, _ := r.Context().Value("userID").(int)
 	id, _ := strconv.Atoi(ps.ByName("id"))
-	poll, err := h.service.GetPoll(r.Context(), id)
+	poll, err := accessiblePoll(h.service, r, id, userID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
 
-	json.NewEncoder(w).Encode(poll)
+	visible, err := h.service.CanSeeResults(r.Context(), poll, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !visible || poll.Anonymous {
+		poll.Edges.Votes = nil
+		poll.Edges.Ballots = nil
+	}
+
+	writePoll(w, poll)
+}
+
+func (h *PollHandler) ClosePoll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.transition(w, r, ps, h.service.ClosePoll)
+}
+
+func (h *PollHandler) ReopenPoll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.transition(w, r, ps, h.service.ReopenPoll)
+}
+
+func (h *PollHandler) ArchivePoll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.transition(w, r, ps, h.service.ArchivePoll)
+}
+
+// transition runs one of the restricted lifecycle changes and responds with
+// the updated poll.
+func (h *PollHandler) transition(w http.ResponseWriter, r *http.Request, ps httprouter.Params, apply func(ctx context.Context, id, userID int) (*ent.Poll, error)) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	poll, err := apply(r.Context(), id, userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if ent.IsNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, service.ErrUnauthorized) {
+			statusCode = http.StatusForbidden
+		} else if errors.Is(err, service.ErrInvalidTransition) {
+			statusCode = http.StatusConflict
+		}
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	writePoll(w, poll)
+}
+
+// writePoll encodes a poll together with its computed status.
+func writePoll(w http.ResponseWriter, poll *ent.Poll) {
+	json.NewEncoder(w).Encode(struct {
+		*ent.Poll
+		Status string `json:"status"`
+	}{poll, service.PollStatus(poll, time.Now())})
 }
 
 func (h *PollHandler) UpdatePoll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -162,11 +321,19 @@ func (h *PollHandler) DeletePoll(w http.ResponseWriter, r *http.Request, ps http
 func (h *PollHandler) Vote(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	w.Header().Set("Content-Type", "application/json")
 	
-	userID := r.Context().Value("userID").(int)
+	// Either a user ID or, on polls that allow guests, a guest token
+	// subject is set
+	userID, _ := r.Context().Value("userID").(int)
+	guestSubject, _ := r.Context().Value("guestSubject").(string)
 	pollID, _ := strconv.Atoi(ps.ByName("id"))
 
 	var req struct {
-		PollOptionID int `json:"poll_option_id"`
+		PollOptionID  int         `json:"poll_option_id"`
+		PollOptionIDs []int       `json:"poll_option_ids"`
+		Ranking       []int       `json:"ranking"`
+		Scores        map[int]int `json:"scores"`
+		ReceiptToken  string      `json:"receipt_token"`
+		Revision      int         `json:"revision"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -175,23 +342,225 @@ func (h *PollHandler) Vote(w http.ResponseWriter, r *http.Request, ps httprouter
 		return
 	}
 
-	if err := h.service.Vote(r.Context(), pollID, req.PollOptionID, userID); err != nil {
+	// Single-choice clients still send a lone poll_option_id
+	optionIDs := req.PollOptionIDs
+	if optionIDs == nil && req.PollOptionID != 0 {
+		optionIDs = []int{req.PollOptionID}
+	}
+	if req.Ranking != nil {
+		optionIDs = req.Ranking
+	}
+
+	orgID, _ := r.Context().Value("orgID").(int)
+	shareLinkID, _ := r.Context().Value("shareLinkID").(int)
+	voter := service.Voter{UserID: userID, GuestSubject: guestSubject, OrgID: orgID, ShareLinkID: shareLinkID}
+	if guestSubject != "" {
+		voter.IP = audit.ClientIP(r)
+	}
+
+	receipt, err := h.service.Vote(r.Context(), pollID, voter, service.BallotInput{
+		OptionIDs:    optionIDs,
+		Scores:       req.Scores,
+		ReceiptToken: req.ReceiptToken,
+		Revision:     req.Revision,
+	})
+	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if err.Error() == "user not found" {
 			statusCode = http.StatusUnauthorized
+		} else if ent.IsNotFound(err) || errors.Is(err, service.ErrPollNotFound) || errors.Is(err, service.ErrShareLinkInvalid) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, service.ErrInvalidBallot) {
+			statusCode = http.StatusBadRequest
+		} else if errors.Is(err, service.ErrGuestsNotAllowed) {
+			statusCode = http.StatusForbidden
+		} else if errors.Is(err, service.ErrPollNotOpen) || errors.Is(err, service.ErrAlreadyVoted) || errors.Is(err, service.ErrDuplicateGuest) || errors.Is(err, service.ErrPollChanged) {
+			statusCode = http.StatusConflict
 		}
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 		return
 	}
 
-	// Return updated vote counts
+	// Return updated vote counts if the voter may see them
+	poll, err := h.service.GetPoll(r.Context(), pollID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	visible, err := h.service.CanSeeResults(r.Context(), poll, userID)
+	if guestSubject != "" && poll.ResultsVisibility == "after_vote" {
+		// The guest has just voted
+		visible, err = true, nil
+	}
+	if err != nil || !visible {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"receipt":         receipt,
+			"results_visible": false,
+		})
+		return
+	}
+
 	voteCounts, err := h.service.GetVoteCounts(r.Context(), pollID)
 	if err != nil {
 		voteCounts = make(map[int]int)
 	}
 
+	voterCount, err := h.service.GetVoterCount(r.Context(), pollID)
+	if err != nil {
+		voterCount = 0
+	}
+
+	selectionCount := 0
+	for _, count := range voteCounts {
+		selectionCount += count
+	}
+
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"vote_counts": voteCounts,
+		"receipt":         receipt,
+		"results_visible": true,
+		"vote_counts":     voteCounts,
+		"voter_count":     voterCount,
+		"selection_count": selectionCount,
 	})
 }
+
+func (h *PollHandler) GetResults(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, _ := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	poll, err := accessiblePoll(h.service, r, id, userID)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	visible, err := h.service.CanSeeResults(r.Context(), poll, userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	if !visible {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{"error": "results are not visible yet"})
+		return
+	}
+
+	results, err := h.service.GetResults(r.Context(), id)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(results)
+}
+
+// ParticipationCounts shows the poll's creator how many members and guests
+// voted.
+func (h *PollHandler) ParticipationCounts(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	counts, err := h.service.ParticipationCounts(r.Context(), id, userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if ent.IsNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, service.ErrUnauthorized) {
+			statusCode = http.StatusForbidden
+		}
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(counts)
+}
+
+// BallotLog publishes the poll's ballot hash chain. The number of entries
+// gives away roughly how many people voted, so it is only shown to those
+// who may see the results.
+func (h *PollHandler) BallotLog(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, _ := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	poll, err := accessiblePoll(h.service, r, id, userID)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	visible, err := h.service.CanSeeResults(r.Context(), poll, userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	if !visible {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{"error": "results are not visible yet"})
+		return
+	}
+
+	chain, err := h.service.BallotLog(r.Context(), id)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(chain)
+}
+
+func (h *PollHandler) VerifyReceipt(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID, _ := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	if _, err := accessiblePoll(h.service, r, id, userID); err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	var req struct {
+		BallotHash string `json:"ballot_hash"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.BallotHash == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "ballot_hash is required"})
+		return
+	}
+
+	check, err := h.service.VerifyReceipt(r.Context(), id, req.BallotHash)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if ent.IsNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(check)
+}
+
+// formatTime renders an optional timestamp the same way as created_at.
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format("2006-01-02T15:04:05Z07:00")
+	return &formatted
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/service"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// accessiblePoll loads the poll if the caller may see it, either under the
+// poll's own access rules, within their active organization, or through
+// the share link the request came in by.
+func accessiblePoll(s *service.PollService, r *http.Request, id, userID int) (*ent.Poll, error) {
+	if _, ok := r.Context().Value("shareLinkID").(int); ok {
+		return s.GetPoll(r.Context(), id)
+	}
+	orgID, _ := r.Context().Value("orgID").(int)
+	return s.AccessiblePoll(r.Context(), id, userID, orgID)
+}
+
+// CreateShareLink mints a /p/<token> link to the poll. The token is only
+// ever returned here.
+func (h *PollHandler) CreateShareLink(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	var req struct {
+		Grant     string     `json:"grant"`
+		ExpiresAt *time.Time `json:"expires_at"`
+		MaxUses   *int       `json:"max_uses"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	link, token, err := h.service.CreateShareLink(r.Context(), id, userID, service.ShareLinkSettings{
+		Grant:     req.Grant,
+		ExpiresAt: req.ExpiresAt,
+		MaxUses:   req.MaxUses,
+	})
+	if err != nil {
+		writeShareLinkError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"link":  link,
+		"token": token,
+		"path":  "/p/" + token,
+	})
+}
+
+func (h *PollHandler) ListShareLinks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+
+	links, err := h.service.ListShareLinks(r.Context(), id, userID)
+	if err != nil {
+		writeShareLinkError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(links)
+}
+
+func (h *PollHandler) RevokeShareLink(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := r.Context().Value("userID").(int)
+	id, _ := strconv.Atoi(ps.ByName("id"))
+	linkID, _ := strconv.Atoi(ps.ByName("linkID"))
+
+	if err := h.service.RevokeShareLink(r.Context(), id, userID, linkID); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		writeShareLinkError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeShareLinkError(w http.ResponseWriter, err error) {
+	statusCode := http.StatusBadRequest
+	if ent.IsNotFound(err) || errors.Is(err, service.ErrShareLinkInvalid) {
+		statusCode = http.StatusNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
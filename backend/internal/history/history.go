@@ -0,0 +1,230 @@
+// Package history keeps a revision trail of polls and their options. Its
+// ent hooks snapshot every poll and option before and after each change
+// and store the pair as a PollRevision through the client that made the
+// change, so a change made in a transaction is recorded in it and rolls
+// back with it. PollService makes its poll and option changes in
+// transactions for that reason.
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/hook"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/pollrevision"
+)
+
+// Register installs the history hooks on the client. Transactions started
+// from the client run them too.
+func Register(client *ent.Client) {
+	client.Poll.Use(pollHook)
+	client.PollOption.Use(optionHook)
+}
+
+func pollHook(next ent.Mutator) ent.Mutator {
+	return hook.PollFunc(func(ctx context.Context, m *ent.PollMutation) (ent.Value, error) {
+		client := m.Client()
+
+		var ids []int
+		before := map[int]*ent.Poll{}
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+			polls, err := client.Poll.Query().Where(poll.IDIn(ids...)).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range polls {
+				before[p.ID] = p
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			p := v.(*ent.Poll)
+			return v, record(ctx, client, p.ID, pollrevision.EntityPoll, p.ID, pollrevision.ActionCreate, nil, p)
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			for _, p := range before {
+				if err := record(ctx, client, p.ID, pollrevision.EntityPoll, p.ID, pollrevision.ActionDelete, p, nil); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		}
+
+		after, err := client.Poll.Query().Where(poll.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range after {
+			old := before[p.ID]
+			if err := record(ctx, client, p.ID, pollrevision.EntityPoll, p.ID, pollAction(old, p), old, p); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+func optionHook(next ent.Mutator) ent.Mutator {
+	return hook.PollOptionFunc(func(ctx context.Context, m *ent.PollOptionMutation) (ent.Value, error) {
+		client := m.Client()
+
+		var ids []int
+		before := map[int]*ent.PollOption{}
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+			options, err := client.PollOption.Query().Where(polloption.IDIn(ids...)).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range options {
+				before[o.ID] = o
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			o := v.(*ent.PollOption)
+			return v, record(ctx, client, o.PollID, pollrevision.EntityOption, o.ID, pollrevision.ActionCreate, nil, o)
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			for _, o := range before {
+				if err := record(ctx, client, o.PollID, pollrevision.EntityOption, o.ID, pollrevision.ActionDelete, o, nil); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		}
+
+		after, err := client.PollOption.Query().Where(polloption.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range after {
+			if err := record(ctx, client, o.PollID, pollrevision.EntityOption, o.ID, pollrevision.ActionUpdate, before[o.ID], o); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+// pollAction names a change to a poll after the lifecycle step it took, if
+// any.
+func pollAction(before, after *ent.Poll) pollrevision.Action {
+	switch {
+	case before == nil:
+		return pollrevision.ActionUpdate
+	case before.ArchivedAt == nil && after.ArchivedAt != nil:
+		return pollrevision.ActionArchive
+	case before.ClosedAt == nil && after.ClosedAt != nil:
+		return pollrevision.ActionClose
+	case before.ClosedAt != nil && after.ClosedAt == nil:
+		return pollrevision.ActionReopen
+	default:
+		return pollrevision.ActionUpdate
+	}
+}
+
+// record stores a revision unless nothing but the poll's revision counter
+// changed, which only marks that its options changed and those changes
+// have revisions of their own. The actor is the signed-in user, if the
+// change was made on behalf of one.
+func record(ctx context.Context, client *ent.Client, pollID int, entity pollrevision.Entity, entityID int, action pollrevision.Action, before, after any) error {
+	b, err := snapshot(before)
+	if err != nil {
+		return err
+	}
+	a, err := snapshot(after)
+	if err != nil {
+		return err
+	}
+	if b != nil && a != nil {
+		changed := Changed(b, a)
+		if len(changed) == 0 || (len(changed) == 1 && changed[0] == "revision") {
+			return nil
+		}
+	}
+
+	create := client.PollRevision.Create().
+		SetPollID(pollID).
+		SetEntity(entity).
+		SetEntityID(entityID).
+		SetAction(action)
+	if userID, ok := ctx.Value("userID").(int); ok {
+		create.SetActorID(userID)
+	}
+	if b != nil {
+		create.SetBefore(b)
+	}
+	if a != nil {
+		create.SetAfter(a)
+	}
+	return create.Exec(ctx)
+}
+
+// snapshot returns the entity's fields as they are encoded in API
+// responses, without its edges.
+func snapshot(v any) (map[string]any, error) {
+	switch e := v.(type) {
+	case nil:
+		return nil, nil
+	case *ent.Poll:
+		if e == nil {
+			return nil, nil
+		}
+	case *ent.PollOption:
+		if e == nil {
+			return nil, nil
+		}
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	delete(m, "edges")
+	return m, nil
+}
+
+// Changed returns the names of the fields that differ between two
+// snapshots, in alphabetical order.
+func Changed(before, after map[string]any) []string {
+	var fields []string
+	for k, v := range after {
+		if !reflect.DeepEqual(before[k], v) {
+			fields = append(fields, k)
+		}
+	}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,316 @@
+// Package jwtkeys holds the keys PollApp signs and verifies its JWTs with.
+// One key signs new tokens; it and any number of retired keys verify them,
+// picked by the token's kid header, so keys can be rotated without
+// signing everyone out. RSA and Ed25519 keys are published as a JWKS for
+// other services to verify tokens with; HMAC secrets never leave the
+// server.
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Config says where the keys come from.
+type Config struct {
+	// SigningKeyFile is a PEM file holding the RSA or Ed25519 private key
+	// new tokens are signed with. If empty, Secret signs them with HS256.
+	SigningKeyFile string
+	Secret         string
+	// VerifyKeyFiles and VerifySecrets are retired keys, as PEM public or
+	// private keys and HMAC secrets, whose tokens are still accepted.
+	VerifyKeyFiles []string
+	VerifySecrets  []string
+	// Issuer is set on every token and required of every token verified.
+	// Audience is the aud claim of access tokens; other kinds of token
+	// get it with the kind appended.
+	Issuer   string
+	Audience string
+}
+
+// key is a verification key and, for the signing key, what signs with it.
+type key struct {
+	id        string
+	method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+}
+
+// Set is the loaded keys.
+type Set struct {
+	signing  *key
+	keys     map[string]*key
+	order    []*key
+	issuer   string
+	audience string
+}
+
+// Load reads the configured keys.
+func Load(cfg Config) (*Set, error) {
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, errors.New("issuer and audience are required")
+	}
+	s := &Set{keys: map[string]*key{}, issuer: cfg.Issuer, audience: cfg.Audience}
+
+	var signing *key
+	var err error
+	switch {
+	case cfg.SigningKeyFile != "":
+		signing, err = loadKeyFile(cfg.SigningKeyFile)
+		if err == nil && signing.signKey == nil {
+			err = fmt.Errorf("%s holds no private key", cfg.SigningKeyFile)
+		}
+	case cfg.Secret != "":
+		signing, err = secretKey(cfg.Secret)
+	default:
+		err = errors.New("a signing key file or secret is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.signing = signing
+	s.add(signing)
+
+	for _, file := range cfg.VerifyKeyFiles {
+		k, err := loadKeyFile(file)
+		if err != nil {
+			return nil, err
+		}
+		k.signKey = nil
+		s.add(k)
+	}
+	for _, secret := range cfg.VerifySecrets {
+		k, err := secretKey(secret)
+		if err != nil {
+			return nil, err
+		}
+		k.signKey = nil
+		s.add(k)
+	}
+	return s, nil
+}
+
+func (s *Set) add(k *key) {
+	if _, ok := s.keys[k.id]; ok {
+		return
+	}
+	s.keys[k.id] = k
+	s.order = append(s.order, k)
+}
+
+// Kind is what a token is for. Each kind is signed for its own audience,
+// so a token of one kind is never accepted as another, neither here nor
+// by other services verifying access tokens against the JWKS.
+type Kind string
+
+const (
+	// Access tokens sign users in. They alone carry the configured
+	// audience.
+	Access Kind = ""
+	// Guest tokens let someone without an account vote on one poll.
+	Guest Kind = "guest"
+	// MFA tokens stand for a sign-in waiting for its second factor.
+	MFA Kind = "mfa"
+	// OIDCState tokens carry a single sign-on request between the login
+	// and callback requests.
+	OIDCState Kind = "oidc-state"
+)
+
+// audienceOf returns the aud claim of tokens of the kind: the configured
+// audience for access tokens, and that plus the kind for the rest.
+func (s *Set) audienceOf(kind Kind) string {
+	if kind == Access {
+		return s.audience
+	}
+	return s.audience + "-" + string(kind)
+}
+
+// Sign signs an access token with the signing key, adding the issuer and
+// audience.
+func (s *Set) Sign(claims jwt.MapClaims) (string, error) {
+	return s.SignKind(Access, claims)
+}
+
+// SignKind signs a token of the given kind, adding the issuer and the
+// kind's audience.
+func (s *Set) SignKind(kind Kind, claims jwt.MapClaims) (string, error) {
+	claims["iss"] = s.issuer
+	claims["aud"] = s.audienceOf(kind)
+
+	token := jwt.NewWithClaims(s.signing.method, claims)
+	token.Header["kid"] = s.signing.id
+	return token.SignedString(s.signing.signKey)
+}
+
+// Parse verifies an access token against the key its kid names and checks
+// its expiry, issuer and audience. Tokens of other kinds are refused.
+func (s *Set) Parse(tokenString string) (*jwt.Token, error) {
+	return s.ParseKind(Access, tokenString)
+}
+
+// ParseKind is Parse for tokens of the given kind.
+func (s *Set) ParseKind(kind Kind, tokenString string) (*jwt.Token, error) {
+	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		k, ok := s.keys[kid]
+		if !ok {
+			return nil, errors.New("unknown signing key")
+		}
+		if token.Method.Alg() != k.method.Alg() {
+			return nil, errors.New("unexpected signing method")
+		}
+		return k.verifyKey, nil
+	},
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(s.audienceOf(kind)),
+		jwt.WithExpirationRequired(),
+	)
+}
+
+// JWK is a public key in JSON Web Key form.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519 keys
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS is the body of /.well-known/jwks.json.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys, signing key first. HMAC secrets are left
+// out.
+func (s *Set) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for _, k := range s.order {
+		if jwk, ok := publicJWK(k.verifyKey); ok {
+			jwk.Kid = k.id
+			jwk.Alg = k.method.Alg()
+			jwks.Keys = append(jwks.Keys, jwk)
+		}
+	}
+	return jwks
+}
+
+func publicJWK(pub any) (JWK, bool) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Use: "sig",
+			N:   b64(pub.N.Bytes()),
+			E:   b64(big.NewInt(int64(pub.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return JWK{Kty: "OKP", Use: "sig", Crv: "Ed25519", X: b64(pub)}, true
+	}
+	return JWK{}, false
+}
+
+// loadKeyFile reads an RSA or Ed25519 key from a PEM file. A private key
+// also gives its public key.
+func loadKeyFile(file string) (*key, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not a PEM file", file)
+	}
+
+	var priv, pub any
+	switch block.Type {
+	case "PRIVATE KEY":
+		priv, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		priv, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		pub, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		pub, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		err = fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if signer, ok := priv.(crypto.Signer); ok {
+		pub = signer.Public()
+	}
+
+	k := &key{signKey: priv, verifyKey: pub}
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		k.method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		k.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("%s: only RSA and Ed25519 keys are supported", file)
+	}
+	k.id = thumbprint(pub)
+	return k, nil
+}
+
+func secretKey(secret string) (*key, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("HMAC secrets must be at least 32 characters")
+	}
+	// The kid must not give the secret away, so it is derived from a hash
+	// of it rather than the secret itself
+	sum := sha256.Sum256([]byte("pollapp kid " + secret))
+	return &key{
+		id:        "hs256-" + hex.EncodeToString(sum[:6]),
+		method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}, nil
+}
+
+// thumbprint returns the RFC 7638 thumbprint of a public key, which is
+// stable across restarts and servers sharing the key.
+func thumbprint(pub any) string {
+	jwk, _ := publicJWK(pub)
+	var members any
+	if jwk.Kty == "RSA" {
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	} else {
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return b64(sum[:])
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,61 @@
+package jwtkeys
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestKinds(t *testing.T) {
+	s, err := Load(Config{
+		Secret:   "test-secret-at-least-32-characters-long",
+		Issuer:   "pollapp",
+		Audience: "pollapp",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := []Kind{Access, Guest, MFA, OIDCState}
+	for _, signed := range kinds {
+		token, err := s.SignKind(signed, jwt.MapClaims{"exp": time.Now().Add(time.Minute).Unix()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, parsed := range kinds {
+			_, err := s.ParseKind(parsed, token)
+			if ok := err == nil; ok != (signed == parsed) {
+				t.Errorf("%q token parsed as %q: error %v", signed, parsed, err)
+			}
+		}
+		if _, err := s.Parse(token); (err == nil) != (signed == Access) {
+			t.Errorf("Parse of %q token: error %v", signed, err)
+		}
+	}
+}
+
+func TestAudience(t *testing.T) {
+	s, err := Load(Config{
+		Secret:   "test-secret-at-least-32-characters-long",
+		Issuer:   "pollapp",
+		Audience: "pollapp",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for kind, want := range map[Kind]string{Access: "pollapp", MFA: "pollapp-mfa", Guest: "pollapp-guest"} {
+		tokenString, err := s.SignKind(kind, jwt.MapClaims{"exp": time.Now().Add(time.Minute).Unix()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		token, err := s.ParseKind(kind, tokenString)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if aud, _ := token.Claims.GetAudience(); len(aud) != 1 || aud[0] != want {
+			t.Errorf("%q token aud = %v, want %q", kind, aud, want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package mail sends the emails PollApp sends users, such as password
+// reset and email verification links. Mailer is implemented over SMTP for
+// production, and by writing messages to files or the log for local
+// development and testing.
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Message is a plain-text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends a message to its recipient, returning once it has been
+// handed off. Implementations must be safe for concurrent use and must
+// give up when ctx is done.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPMailer sends mail through an SMTP server, upgrading to TLS with
+// STARTTLS and authenticating with PLAIN auth if Username is set. Servers
+// that don't offer STARTTLS are refused, since the messages carry password
+// reset links, unless AllowInsecure is set. Cancelling the context, or
+// reaching its deadline, aborts the exchange.
+type SMTPMailer struct {
+	Addr     string
+	Username string
+	Password string
+	From     string
+	// AllowInsecure sends in the clear through servers without STARTTLS,
+	// for relays on the local host or network only.
+	AllowInsecure bool
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	err := m.send(ctx, msg)
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (m *SMTPMailer) send(ctx context.Context, msg Message) error {
+	host, _, err := net.SplitHostPort(m.Addr)
+	if err != nil {
+		return err
+	}
+	// The envelope takes the bare address, not "Name <address>"
+	from, err := netmail.ParseAddress(m.From)
+	if err != nil {
+		return fmt.Errorf("sender %q: %w", m.From, err)
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", m.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	} else if !m.AllowInsecure {
+		return fmt.Errorf("%s doesn't offer STARTTLS; refusing to send in the clear", m.Addr)
+	}
+	if m.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.Username, m.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(format(m.From, msg)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// FileMailer writes each message to its own .eml file in Dir, where tests
+// and developers can pick up the links in them.
+type FileMailer struct {
+	Dir  string
+	From string
+
+	seq atomic.Int64
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if err := os.MkdirAll(m.Dir, 0o700); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%03d.eml", time.Now().UTC().Format("20060102T150405.000000000"), m.seq.Add(1))
+	return os.WriteFile(filepath.Join(m.Dir, name), format(m.From, msg), 0o600)
+}
+
+// LogMailer writes messages to the server log instead of sending them.
+type LogMailer struct{}
+
+func (LogMailer) Send(ctx context.Context, msg Message) error {
+	log.Printf("Email to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// format renders the message as an RFC 5322 email. Line breaks are
+// dropped from header values, since addresses come from users.
+func format(from string, msg Message) []byte {
+	header := strings.NewReplacer("\r", "", "\n", "")
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", header.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", header.Replace(msg.To))
+	fmt.Fprintf(&b, "Subject: %s\r\n", header.Replace(msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
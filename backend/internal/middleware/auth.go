@@ -2,7 +2,12 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"pollapp/backend/internal/service"
 
@@ -10,37 +15,286 @@ import (
 	"github.com/julienschmidt/httprouter"
 )
 
+// AuthMiddleware rejects requests without a valid token. Handlers find the
+// user ID in the context as "userID", their active organization as "orgID",
+// 0 for none, their site role as "role" and their session as "sessionID".
 func AuthMiddleware(authService *service.AuthService, handler httprouter.Handle) httprouter.Handle {
 	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-		tokenString := r.Header.Get("Authorization")
-		if tokenString == "" {
+		id, ok := authenticate(authService, r, true)
+		if !ok {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
-
-		if len(tokenString) > 7 && tokenString[:7] == "Bearer " {
-			tokenString = tokenString[7:]
+		if err := keyAllowed(r, id); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
 		}
 
-		token, err := authService.ValidateToken(tokenString)
-		if err != nil || !token.Valid {
+		handler(w, r.WithContext(withUser(r.Context(), id)), ps)
+	}
+}
+
+// RequirePermission works like AuthMiddleware but also rejects users whose
+// role doesn't grant perm.
+func RequirePermission(authService *service.AuthService, perm service.Permission, handler httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		id, ok := authenticate(authService, r, true)
+		if !ok {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
+		if id.apiKey {
+			http.Error(w, "API keys can't be used for site administration", http.StatusForbidden)
+			return
+		}
+		if !service.RoleHas(id.role, perm) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
 
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		handler(w, r.WithContext(withUser(r.Context(), id)), ps)
+	}
+}
+
+// OptionalAuthMiddleware identifies the caller when a valid token is
+// supplied but lets anonymous requests through. Handlers find the user ID
+// in the context only for authenticated callers. API keys without the
+// route's scope are treated as anonymous. Invalid tokens aren't audited
+// here, since clients routinely send stale ones to public pages.
+func OptionalAuthMiddleware(authService *service.AuthService, handler httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if id, ok := authenticate(authService, r, false); ok && keyAllowed(r, id) == nil {
+			r = r.WithContext(withUser(r.Context(), id))
+		}
+		handler(w, r, ps)
+	}
+}
+
+// APIKeyHeader is where scripts may send an API key instead of as a bearer
+// token.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyScope marks the route as one API keys with scope may use. It
+// wraps the route's auth middleware, which enforces it. API keys may make
+// GET requests anywhere their user may, but no other requests unless the
+// route names a scope they have.
+func APIKeyScope(scope string, handler httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		ctx := context.WithValue(r.Context(), "apiKeyScope", scope)
+		handler(w, r.WithContext(ctx), ps)
+	}
+}
+
+// keyAllowed checks an API key against the route's scope. Tokens always
+// pass.
+func keyAllowed(r *http.Request, id identity) error {
+	if !id.apiKey || r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return nil
+	}
+	scope, _ := r.Context().Value("apiKeyScope").(string)
+	if scope == "" {
+		return errors.New("API keys can't be used for this request")
+	}
+	for _, s := range id.scopes {
+		if s == scope {
+			return nil
+		}
+	}
+	return fmt.Errorf("API key lacks the %s scope", scope)
+}
+
+// Guest voter tokens travel in this header or, for browsers, in this
+// cookie scoped to the poll's API path.
+const (
+	GuestTokenHeader = "X-Guest-Token"
+	GuestTokenCookie = "guest_token"
+)
+
+// VoterMiddleware admits signed-in users and, failing that, guests holding
+// a guest token for the poll named by the :id route parameter. Guests find
+// their token subject in the context as "guestSubject" instead of a user
+// ID.
+func VoterMiddleware(authService *service.AuthService, handler httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if id, ok := authenticate(authService, r, true); ok {
+			if err := keyAllowed(r, id); err != nil {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			handler(w, r.WithContext(withUser(r.Context(), id)), ps)
 			return
 		}
 
-		userID, ok := claims["user_id"].(float64)
+		pollID, _ := strconv.Atoi(ps.ByName("id"))
+		subject, ok := GuestSubject(authService, r, pollID)
 		if !ok {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), "userID", int(userID))
+		ctx := context.WithValue(r.Context(), "guestSubject", subject)
+		handler(w, r.WithContext(ctx), ps)
+	}
+}
+
+// GuestSubject returns the subject of the request's guest token for the
+// poll, if it carries a valid one.
+func GuestSubject(authService *service.AuthService, r *http.Request, pollID int) (string, bool) {
+	tokenString := r.Header.Get(GuestTokenHeader)
+	if tokenString == "" {
+		if cookie, err := r.Cookie(GuestTokenCookie); err == nil {
+			tokenString = cookie.Value
+		}
+	}
+	if tokenString == "" {
+		return "", false
+	}
+
+	subject, err := authService.GuestSubject(tokenString, pollID)
+	if err != nil {
+		return "", false
+	}
+	return subject, true
+}
+
+// ShareLinkMiddleware resolves the share link in the :token route
+// parameter, for voting if forVote, and hands the request on as if it had
+// been made to the poll's own route: the poll ID becomes the :id parameter
+// and the link ID is put in the context as "shareLinkID".
+func ShareLinkMiddleware(pollService *service.PollService, forVote bool, handler httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		link, err := pollService.OpenShareLink(r.Context(), ps.ByName("token"), forVote)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if errors.Is(err, service.ErrShareLinkInvalid) {
+				statusCode = http.StatusNotFound
+			} else if errors.Is(err, service.ErrShareLinkReadOnly) {
+				statusCode = http.StatusForbidden
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+
+		ps = append(ps, httprouter.Param{Key: "id", Value: strconv.Itoa(link.PollID)})
+		ctx := context.WithValue(r.Context(), "shareLinkID", link.ID)
 		handler(w, r.WithContext(ctx), ps)
 	}
 }
+
+// identity is who a valid token was issued to.
+type identity struct {
+	userID    int
+	orgID     int
+	role      string
+	sessionID int
+	// apiKey is set when the caller used an API key rather than a token,
+	// and scopes are then the key's scopes.
+	apiKey bool
+	scopes []string
+}
+
+// withUser stores the authenticated user, their active organization, their
+// role and their session in the context.
+func withUser(ctx context.Context, id identity) context.Context {
+	ctx = context.WithValue(ctx, "userID", id.userID)
+	ctx = context.WithValue(ctx, "orgID", id.orgID)
+	ctx = context.WithValue(ctx, "role", id.role)
+	return context.WithValue(ctx, "sessionID", id.sessionID)
+}
+
+// authenticate extracts and validates the bearer token or API key on the
+// request, returning the user it was issued to, their active organization,
+// 0 for none, and their role. Tokens of revoked sessions and banned users,
+// tokens whose role is out of date, revoked keys and tokens or keys for an
+// organization the user no longer belongs to are rejected. When record is
+// set, because the route requires signing in, rejected tokens are recorded
+// in the audit log.
+func authenticate(authService *service.AuthService, r *http.Request, record bool) (identity, bool) {
+	tokenString := r.Header.Get("Authorization")
+	if len(tokenString) > 7 && tokenString[:7] == "Bearer " {
+		tokenString = tokenString[7:]
+	}
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		tokenString = key
+	}
+	if tokenString == "" {
+		return identity{}, false
+	}
+
+	var id identity
+	var err error
+	if strings.HasPrefix(tokenString, service.APIKeyPrefix) {
+		id, err = verifyAPIKey(authService, r, tokenString)
+	} else {
+		id, err = verifyToken(authService, r, tokenString)
+	}
+	if err != nil {
+		if record {
+			authService.RecordRejectedToken(r.Context(), id.userID, err.Error())
+		}
+		return identity{}, false
+	}
+	return id, true
+}
+
+// verifyAPIKey checks an API key.
+func verifyAPIKey(authService *service.AuthService, r *http.Request, key string) (identity, error) {
+	k, err := authService.AuthenticateAPIKey(r.Context(), key)
+	if err != nil {
+		return identity{userID: k.UserID}, err
+	}
+	return identity{
+		userID: k.UserID,
+		orgID:  k.OrgID,
+		role:   k.Role,
+		apiKey: true,
+		scopes: k.Scopes,
+	}, nil
+}
+
+// verifyToken checks a bearer token. On failure the identity holds the
+// user ID the token claims, if it got that far.
+func verifyToken(authService *service.AuthService, r *http.Request, tokenString string) (identity, error) {
+	token, err := authService.ValidateToken(r.Context(), tokenString)
+	if err != nil {
+		return identity{}, err
+	}
+	if !token.Valid {
+		return identity{}, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return identity{}, errors.New("unexpected claims")
+	}
+
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return identity{}, errors.New("token has no user_id")
+	}
+	sessionID, _ := claims["sid"].(float64)
+	id := identity{userID: int(userID), role: "user", sessionID: int(sessionID)}
+
+	// Tokens from before roles existed carry none and stand for plain users
+	if role, ok := claims["role"].(string); ok {
+		id.role = role
+	}
+	if err := authService.CheckAccount(r.Context(), id.userID, id.role); err != nil {
+		return identity{userID: id.userID}, err
+	}
+
+	if v, ok := claims["org_id"].(float64); ok {
+		id.orgID = int(v)
+		member, err := authService.IsOrgMember(r.Context(), id.orgID, id.userID)
+		if err != nil {
+			return identity{userID: id.userID}, err
+		}
+		if !member {
+			return identity{userID: id.userID}, errors.New("no longer a member of the token's organization")
+		}
+	}
+
+	return id, nil
+}
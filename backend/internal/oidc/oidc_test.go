@@ -0,0 +1,119 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"pollapp/backend/internal/oidc/oidctest"
+)
+
+const testRedirectURL = "http://localhost:8080/api/auth/oidc/callback"
+
+// authorize runs the browser's part of the flow against the provider and
+// returns the query of the redirect back to the client.
+func authorize(t *testing.T, authURL string) url.Values {
+	t.Helper()
+	browser := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	resp, err := browser.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize returned %s", resp.Status)
+	}
+	back, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := back.Scheme + "://" + back.Host + back.Path; got != testRedirectURL {
+		t.Fatalf("redirected to %s, want %s", got, testRedirectURL)
+	}
+	return back.Query()
+}
+
+func TestExchange(t *testing.T) {
+	srv, idp, err := oidctest.NewServer("pollapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	idp.SignInAs("bob@example.com", false)
+
+	tests := []struct {
+		name string
+		// verifier and nonce are what the client passes to Exchange; the
+		// flow was started with "verifier" and "nonce"
+		verifier string
+		nonce    string
+		wantErr  string
+	}{
+		{name: "ok", verifier: "verifier", nonce: "nonce"},
+		{name: "PKCE verifier mismatch", verifier: "other-verifier", nonce: "nonce", wantErr: "PKCE verification failed"},
+		{name: "nonce mismatch", verifier: "verifier", nonce: "other-nonce", wantErr: "nonce mismatch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			p := New(Config{Issuer: idp.Issuer(), ClientID: "pollapp", RedirectURL: testRedirectURL})
+
+			authURL, err := p.AuthCodeURL(ctx, "state", "nonce", "verifier")
+			if err != nil {
+				t.Fatal(err)
+			}
+			back := authorize(t, authURL)
+			if back.Get("state") != "state" {
+				t.Fatalf("state = %q, want %q", back.Get("state"), "state")
+			}
+
+			claims, err := p.Exchange(ctx, back.Get("code"), tt.verifier, tt.nonce)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Exchange error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := Claims{
+				Issuer:            idp.Issuer(),
+				Subject:           claims.Subject,
+				Email:             "bob@example.com",
+				EmailVerified:     false,
+				PreferredUsername: "bob",
+			}
+			if claims.Subject == "" || *claims != want {
+				t.Errorf("claims = %+v, want %+v", *claims, want)
+			}
+		})
+	}
+}
+
+func TestExchangeCodeUsedOnce(t *testing.T) {
+	srv, idp, err := oidctest.NewServer("pollapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	ctx := context.Background()
+	p := New(Config{Issuer: idp.Issuer(), ClientID: "pollapp", RedirectURL: testRedirectURL})
+	authURL, err := p.AuthCodeURL(ctx, "state", "nonce", "verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := authorize(t, authURL).Get("code")
+
+	if _, err := p.Exchange(ctx, code, "verifier", "nonce"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Exchange(ctx, code, "verifier", "nonce"); err == nil {
+		t.Error("second Exchange of the same code succeeded")
+	}
+}
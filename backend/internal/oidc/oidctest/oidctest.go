@@ -0,0 +1,273 @@
+// Package oidctest is a stand-in OpenID Connect provider for testing
+// PollApp's single sign-on, in tests and locally through the mock-oidc
+// command. It signs in whoever asks, as whatever email it is told, so
+// never expose it.
+package oidctest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const keyID = "mock-oidc"
+
+// authorization is what an issued code stands for.
+type authorization struct {
+	clientID      string
+	redirectURI   string
+	challenge     string
+	nonce         string
+	email         string
+	emailVerified bool
+	expiresAt     time.Time
+}
+
+// Provider serves the discovery document, keys, authorization and token
+// endpoints of an OpenID provider at its issuer URL.
+type Provider struct {
+	issuer   string
+	clientID string
+	key      ed25519.PrivateKey
+	mux      *http.ServeMux
+
+	mu            sync.Mutex
+	codes         map[string]authorization
+	email         string
+	emailVerified bool
+	signInPage    bool
+}
+
+var signInPage = template.Must(template.New("signin").Parse(`<!doctype html>
+<title>Mock OpenID provider</title>
+<h1>Mock OpenID provider</h1>
+<form method="post">
+  {{range $k, $v := .Query}}<input type="hidden" name="{{$k}}" value="{{index $v 0}}">{{end}}
+  <label>Email <input name="email" value="{{.Email}}"></label>
+  <label><input type="checkbox" name="email_verified" value="true" checked> Email verified</label>
+  <button>Sign in</button>
+</form>
+`))
+
+// New returns a provider for the given issuer URL that accepts one
+// client. It signs in alice@example.com, with a verified email, straight
+// away; see SignInAs and ShowSignInPage.
+func New(issuer, clientID string) (*Provider, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	p := &Provider{
+		issuer:        strings.TrimRight(issuer, "/"),
+		clientID:      clientID,
+		key:           key,
+		codes:         map[string]authorization{},
+		email:         "alice@example.com",
+		emailVerified: true,
+	}
+
+	p.mux = http.NewServeMux()
+	p.mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	p.mux.HandleFunc("/jwks", p.jwks)
+	p.mux.HandleFunc("/authorize", p.authorize)
+	p.mux.HandleFunc("/token", p.token)
+	return p, nil
+}
+
+// NewServer starts a provider on a local port for tests. The caller
+// closes the server when done.
+func NewServer(clientID string) (*httptest.Server, *Provider, error) {
+	srv := httptest.NewUnstartedServer(nil)
+	p, err := New("http://"+srv.Listener.Addr().String(), clientID)
+	if err != nil {
+		srv.Close()
+		return nil, nil, err
+	}
+	srv.Config.Handler = p
+	srv.Start()
+	return srv, p, nil
+}
+
+// Issuer returns the provider's issuer URL.
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+// SignInAs sets who the provider signs in from now on, and whether it
+// vouches for their email.
+func (p *Provider) SignInAs(email string, verified bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.email, p.emailVerified = email, verified
+}
+
+// ShowSignInPage makes the provider ask for the email to sign in as,
+// prefilled with the one from SignInAs, instead of signing in straight
+// away.
+func (p *Provider) ShowSignInPage() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.signInPage = true
+}
+
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mux.ServeHTTP(w, r)
+}
+
+func (p *Provider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"EdDSA"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *Provider) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := p.key.Public().(ed25519.PublicKey)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "EdDSA",
+			"x":   base64.RawURLEncoding.EncodeToString(pub),
+		}},
+	})
+}
+
+// authorize signs the user in, after the sign-in page if it is shown,
+// issues a code and sends the browser back to the client.
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	email, verified, showPage := p.email, p.emailVerified, p.signInPage
+	p.mu.Unlock()
+
+	if r.Method == http.MethodGet && showPage {
+		signInPage.Execute(w, map[string]any{"Query": r.URL.Query(), "Email": email})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if r.Method == http.MethodPost {
+		email, verified = r.PostForm.Get("email"), r.PostForm.Get("email_verified") == "true"
+	}
+
+	q := r.Form
+	if q.Get("response_type") != "code" || q.Get("client_id") != p.clientID {
+		http.Error(w, "unsupported response_type or unknown client_id", http.StatusBadRequest)
+		return
+	}
+	if q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "PKCE with S256 is required", http.StatusBadRequest)
+		return
+	}
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirectURI.Host == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	code := randomString()
+	p.mu.Lock()
+	p.codes[code] = authorization{
+		clientID:      p.clientID,
+		redirectURI:   redirectURI.String(),
+		challenge:     q.Get("code_challenge"),
+		nonce:         q.Get("nonce"),
+		email:         email,
+		emailVerified: verified,
+		expiresAt:     time.Now().Add(time.Minute),
+	}
+	p.mu.Unlock()
+
+	back := redirectURI.Query()
+	back.Set("code", code)
+	back.Set("state", q.Get("state"))
+	redirectURI.RawQuery = back.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// token redeems a code for an ID token, checking the PKCE verifier.
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	p.mu.Lock()
+	auth, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	switch {
+	case !ok || time.Now().After(auth.expiresAt):
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "unknown or expired code"})
+		return
+	case r.PostForm.Get("redirect_uri") != auth.redirectURI:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "redirect_uri mismatch"})
+		return
+	case base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+		return
+	}
+
+	subject := sha256.Sum256([]byte(auth.email))
+	name, _, _ := strings.Cut(auth.email, "@")
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"iss":                p.issuer,
+		"aud":                auth.clientID,
+		"sub":                fmt.Sprintf("%x", subject[:8]),
+		"email":              auth.email,
+		"email_verified":     auth.emailVerified,
+		"preferred_username": name,
+		"nonce":              auth.nonce,
+		"iat":                time.Now().Unix(),
+		"exp":                time.Now().Add(5 * time.Minute).Unix(),
+	})
+	token.Header["kid"] = keyID
+	idToken, err := token.SignedString(p.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func randomString() string {
+	b := make([]byte, 24)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
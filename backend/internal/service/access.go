@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+	"pollapp/backend/ent/pollmember"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/user"
+)
+
+var (
+	// ErrPollNotFound is returned for polls the caller may not see, so
+	// that private polls can't be told apart from missing ones.
+	ErrPollNotFound = errors.New("poll not found")
+	// ErrUserNotFound is returned when inviting or removing someone who
+	// has no account.
+	ErrUserNotFound = errors.New("no user with that username or email")
+)
+
+// Member is a user invited to a poll.
+type Member struct {
+	UserID   int       `json:"user_id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+// CanAccess reports whether the user may view and vote on the poll by its
+// ID. userID is 0 for anonymous callers and orgID is the caller's active
+// organization, 0 for none. Polls in an organization are only open to
+// callers working in it. Beyond that, public polls are open to anyone;
+// unlisted and private ones only to their owner, collaborators and
+// members, so they can't be found by counting through IDs. Unlisted polls
+// are shared with everyone else through share links.
+func (s *PollService) CanAccess(ctx context.Context, p *ent.Poll, userID, orgID int) (bool, error) {
+	if p.OrganizationID != nil && *p.OrganizationID != orgID {
+		return false, nil
+	}
+	if p.Visibility == poll.VisibilityPublic {
+		return true, nil
+	}
+	if userID == 0 {
+		return false, nil
+	}
+	if p.CreatedBy == userID {
+		return true, nil
+	}
+	collaborator, err := s.client.PollCollaborator.Query().
+		Where(pollcollaborator.PollIDEQ(p.ID), pollcollaborator.UserIDEQ(userID)).
+		Exist(ctx)
+	if err != nil || collaborator {
+		return collaborator, err
+	}
+	return s.client.PollMember.Query().
+		Where(pollmember.PollIDEQ(p.ID), pollmember.UserIDEQ(userID)).
+		Exist(ctx)
+}
+
+// AccessiblePoll is GetPoll for a caller who must be allowed to see the
+// poll.
+func (s *PollService) AccessiblePoll(ctx context.Context, id, userID, orgID int) (*ent.Poll, error) {
+	p, err := s.GetPoll(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.CanAccess(ctx, p, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrPollNotFound
+	}
+	return p, nil
+}
+
+// listedFor matches the polls ListPolls shows the user: those in their
+// active organization, or outside any organization if orgID is 0, that are
+// public, their own, shared with them as a collaborator, or that they were
+// invited to.
+func listedFor(userID, orgID int) predicate.Poll {
+	tenant := poll.OrganizationIDIsNil()
+	if orgID != 0 {
+		tenant = poll.OrganizationIDEQ(orgID)
+	}
+	if userID == 0 {
+		return poll.And(tenant, poll.VisibilityEQ(poll.VisibilityPublic))
+	}
+	return poll.And(tenant, poll.Or(
+		poll.VisibilityEQ(poll.VisibilityPublic),
+		poll.CreatedByEQ(userID),
+		poll.HasCollaboratorsWith(pollcollaborator.UserIDEQ(userID)),
+		poll.HasMembersWith(pollmember.UserIDEQ(userID)),
+	))
+}
+
+// SetVisibility changes who can find and open the poll.
+func (s *PollService) SetVisibility(ctx context.Context, id, userID int, visibility string) (*ent.Poll, error) {
+	if _, err := s.ownedPoll(ctx, id, userID); err != nil {
+		return nil, err
+	}
+	v := poll.Visibility(visibility)
+	if err := poll.VisibilityValidator(v); err != nil {
+		return nil, fmt.Errorf("unknown visibility %q", visibility)
+	}
+
+	var updated *ent.Poll
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.Poll.UpdateOneID(id).
+			SetVisibility(v).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// ListMembers returns the users invited to the poll.
+func (s *PollService) ListMembers(ctx context.Context, id, userID int) ([]Member, error) {
+	if _, err := s.ownedPoll(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.client.PollMember.Query().
+		Where(pollmember.PollIDEQ(id)).
+		WithUser().
+		Order(ent.Asc(pollmember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, len(rows))
+	for i, row := range rows {
+		members[i] = Member{
+			UserID:   row.UserID,
+			Username: row.Edges.User.Username,
+			Email:    row.Edges.User.Email,
+			AddedAt:  row.CreatedAt,
+		}
+	}
+	return members, nil
+}
+
+// AddMember invites the user with the given username or email to the
+// poll. Inviting someone twice is not an error.
+func (s *PollService) AddMember(ctx context.Context, id, userID int, who string) (*Member, error) {
+	if _, err := s.ownedPoll(ctx, id, userID); err != nil {
+		return nil, err
+	}
+	u, err := findUser(ctx, s.client, who)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.client.PollMember.Query().
+		Where(pollmember.PollIDEQ(id), pollmember.UserIDEQ(u.ID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		m, err = s.client.PollMember.Create().
+			SetPollID(id).
+			SetUserID(u.ID).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Member{
+		UserID:   u.ID,
+		Username: u.Username,
+		Email:    u.Email,
+		AddedAt:  m.CreatedAt,
+	}, nil
+}
+
+// RemoveMember withdraws the invitation of the user with the given
+// username or email. Votes they already cast are kept.
+func (s *PollService) RemoveMember(ctx context.Context, id, userID int, who string) error {
+	if _, err := s.ownedPoll(ctx, id, userID); err != nil {
+		return err
+	}
+	u, err := findUser(ctx, s.client, who)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.PollMember.Delete().
+		Where(pollmember.PollIDEQ(id), pollmember.UserIDEQ(u.ID)).
+		Exec(ctx)
+	return err
+}
+
+// findUser looks a user up by email if who contains an @, by username
+// otherwise.
+func findUser(ctx context.Context, client *ent.Client, who string) (*ent.User, error) {
+	pred := user.UsernameEQ(who)
+	if strings.Contains(who, "@") {
+		pred = user.EmailEQ(who)
+	}
+
+	u, err := client.User.Query().
+		Where(pred).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	}
+	return u, err
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/polltransition"
+	"pollapp/backend/ent/session"
+	"pollapp/backend/ent/user"
+	"pollapp/backend/internal/audit"
+)
+
+// ErrLastAdmin is returned when a change would leave the site without an
+// admin.
+var ErrLastAdmin = errors.New("the site must keep at least one admin")
+
+// AdminService holds the site-wide moderation tools. Every method checks
+// the acting user's permissions itself, on top of the route middleware.
+type AdminService struct {
+	client *ent.Client
+	audit  *audit.Log
+}
+
+// Account is a user as seen by moderators.
+type Account struct {
+	ID         int        `json:"id"`
+	Username   string     `json:"username"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	BannedAt   *time.Time `json:"banned_at,omitempty"`
+	MFAEnabled bool       `json:"mfa_enabled"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func NewAdminService(client *ent.Client) *AdminService {
+	return &AdminService{client: client, audit: audit.New(client)}
+}
+
+// ListUsers returns all users, or those whose username or email contains
+// search.
+func (s *AdminService) ListUsers(ctx context.Context, actorID int, search string) ([]Account, error) {
+	if err := requirePermission(ctx, s.client, actorID, PermBanUsers); err != nil {
+		return nil, err
+	}
+
+	q := s.client.User.Query()
+	if search != "" {
+		q = q.Where(user.Or(
+			user.UsernameContainsFold(search),
+			user.EmailContainsFold(search),
+		))
+	}
+	users, err := q.Order(ent.Asc(user.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, len(users))
+	for i, u := range users {
+		accounts[i] = account(u)
+	}
+	return accounts, nil
+}
+
+// SetRole changes a user's site role. Their existing access tokens stop
+// working, so the new role applies once they refresh them.
+func (s *AdminService) SetRole(ctx context.Context, actorID, userID int, role string) (*Account, error) {
+	r := user.Role(role)
+	if err := user.RoleValidator(r); err != nil {
+		return nil, fmt.Errorf("unknown role %q", role)
+	}
+	if err := requirePermission(ctx, s.client, actorID, PermManageRoles); err != nil {
+		return nil, err
+	}
+
+	var u *ent.User
+	var from user.Role
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		u, err = tx.User.Get(ctx, userID)
+		if err != nil {
+			return err
+		}
+		from = u.Role
+
+		if u.Role == user.RoleAdmin && r != user.RoleAdmin {
+			others, err := tx.User.Query().
+				Where(user.RoleEQ(user.RoleAdmin), user.IDNEQ(userID)).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !others {
+				return ErrLastAdmin
+			}
+		}
+
+		u, err = u.Update().
+			SetRole(r).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, audit.RoleChanged, actorID, map[string]any{
+		"user_id": userID,
+		"from":    from.String(),
+		"to":      r.String(),
+	})
+	a := account(u)
+	return &a, nil
+}
+
+// BanUser stops a user from signing in and revokes their sessions. Users
+// can't ban themselves, and only those who manage roles can ban other
+// moderators and admins.
+func (s *AdminService) BanUser(ctx context.Context, actorID, userID int) (*Account, error) {
+	return s.setBanned(ctx, actorID, userID, true)
+}
+
+// UnbanUser lifts a user's ban.
+func (s *AdminService) UnbanUser(ctx context.Context, actorID, userID int) (*Account, error) {
+	return s.setBanned(ctx, actorID, userID, false)
+}
+
+func (s *AdminService) setBanned(ctx context.Context, actorID, userID int, banned bool) (*Account, error) {
+	if err := requirePermission(ctx, s.client, actorID, PermBanUsers); err != nil {
+		return nil, err
+	}
+	if actorID == userID {
+		return nil, errors.New("you can't ban yourself")
+	}
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Role != user.RoleUser {
+		if err := requirePermission(ctx, s.client, actorID, PermManageRoles); err != nil {
+			return nil, err
+		}
+	}
+
+	update := u.Update()
+	if banned {
+		if u.BannedAt != nil {
+			a := account(u)
+			return &a, nil
+		}
+		update.SetBannedAt(time.Now())
+	} else {
+		update.ClearBannedAt()
+	}
+	u, err = update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if banned {
+		if err := revokeSessions(ctx, s.client, "banned", session.UserIDEQ(userID)); err != nil {
+			return nil, err
+		}
+	}
+
+	event := audit.UserUnbanned
+	if banned {
+		event = audit.UserBanned
+	}
+	s.audit.Record(ctx, event, actorID, map[string]any{"user_id": userID})
+
+	a := account(u)
+	return &a, nil
+}
+
+// Transitions returns the manual poll lifecycle changes, newest first,
+// optionally only those of one poll or one acting user.
+func (s *AdminService) Transitions(ctx context.Context, actorID, pollID, byUserID int) ([]*ent.PollTransition, error) {
+	if err := requirePermission(ctx, s.client, actorID, PermViewAudit); err != nil {
+		return nil, err
+	}
+
+	q := s.client.PollTransition.Query()
+	if pollID != 0 {
+		q = q.Where(polltransition.PollIDEQ(pollID))
+	}
+	if byUserID != 0 {
+		q = q.Where(polltransition.ActorIDEQ(byUserID))
+	}
+	return q.Order(ent.Desc(polltransition.FieldCreatedAt), ent.Desc(polltransition.FieldID)).All(ctx)
+}
+
+// PromoteAdmins makes the users with the given emails admins. It is how
+// the server bootstraps its first admins from configuration; emails
+// without an account yet are skipped.
+func (s *AdminService) PromoteAdmins(ctx context.Context, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	return s.client.User.Update().
+		Where(user.EmailIn(emails...), user.RoleNEQ(user.RoleAdmin)).
+		SetRole(user.RoleAdmin).
+		Exec(ctx)
+}
+
+func account(u *ent.User) Account {
+	return Account{
+		ID:         u.ID,
+		Username:   u.Username,
+		Email:      u.Email,
+		Role:       u.Role.String(),
+		BannedAt:   u.BannedAt,
+		MFAEnabled: u.TotpEnabledAt != nil,
+		CreatedAt:  u.CreatedAt,
+	}
+}
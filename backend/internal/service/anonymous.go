@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/participation"
+)
+
+// castAnonymousBallot records that the voter took part in the poll and,
+// separately, what was chosen, returning the receipt token for the ballot.
+// The two rows share nothing but the poll ID: the ballot has a random ID
+// and only the day it was cast, so they can't be joined afterwards. A
+// returning voter must present the receipt token from their first vote to
+// replace their ballot.
+func castAnonymousBallot(ctx context.Context, tx *ent.Tx, p *ent.Poll, owner ballotOwner, in BallotInput) (string, error) {
+	castOn := time.Now().UTC().Truncate(24 * time.Hour)
+
+	voted, err := tx.Participation.Query().
+		Where(participation.PollIDEQ(p.ID), owner.participations()).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if in.ReceiptToken != "" {
+		if !voted {
+			return "", fmt.Errorf("%w: no earlier ballot to replace", ErrInvalidBallot)
+		}
+		n, err := tx.AnonymousBallot.Update().
+			Where(
+				anonymousballot.PollIDEQ(p.ID),
+				anonymousballot.ReceiptHashEQ(hashToken(in.ReceiptToken)),
+			).
+			SetChoices(in.OptionIDs).
+			SetScores(in.Scores).
+			SetCastOn(castOn).
+			Save(ctx)
+		if err != nil {
+			return "", err
+		}
+		if n == 0 {
+			return "", fmt.Errorf("%w: unknown receipt token", ErrInvalidBallot)
+		}
+		return in.ReceiptToken, nil
+	}
+
+	if voted {
+		return "", ErrAlreadyVoted
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := tx.Participation.Create().
+		SetPollID(p.ID).
+		SetNillableUserID(owner.user()).
+		SetNillableGuestVoterID(owner.guest()).
+		Save(ctx); err != nil {
+		return "", err
+	}
+
+	if _, err := tx.AnonymousBallot.Create().
+		SetPollID(p.ID).
+		SetChoices(in.OptionIDs).
+		SetScores(in.Scores).
+		SetReceiptHash(hashToken(token)).
+		SetCastOn(castOn).
+		Save(ctx); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// newToken returns a random URL-safe secret.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken is how secrets handed to clients are stored.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/apikey"
+	"pollapp/backend/internal/audit"
+)
+
+// Scopes an API key can be given. Every key may read what its user can;
+// writes need the matching scope.
+const (
+	ScopePollsWrite = "polls:write"
+	ScopeVotesWrite = "votes:write"
+)
+
+var knownScopes = map[string]bool{
+	ScopePollsWrite: true,
+	ScopeVotesWrite: true,
+}
+
+const (
+	// APIKeyPrefix starts every API key, telling them apart from JWTs.
+	APIKeyPrefix = "pollapp_"
+	// apiKeyShownPrefix is how much of a key is kept to show in lists.
+	apiKeyShownPrefix = len(APIKeyPrefix) + 6
+	// apiKeyUseInterval is how stale last_used_at may get, so a busy key
+	// doesn't write on every request.
+	apiKeyUseInterval = time.Minute
+)
+
+// ErrInvalidAPIKey is returned for keys that are unknown or revoked.
+var ErrInvalidAPIKey = errors.New("invalid or revoked API key")
+
+// APIKey is an API key as shown to its owner, without the key itself.
+type APIKey struct {
+	ID             int        `json:"id"`
+	Name           string     `json:"name"`
+	Prefix         string     `json:"prefix"`
+	Scopes         []string   `json:"scopes"`
+	OrganizationID *int       `json:"organization_id,omitempty"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// KeyIdentity is who an API key acts for.
+type KeyIdentity struct {
+	UserID int
+	OrgID  int
+	Role   string
+	Scopes []string
+}
+
+// HasScope reports whether the key was given the scope.
+func (k KeyIdentity) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateAPIKey creates a key for the user, acting in orgID, 0 for none.
+// The key is returned only here; only its hash is stored.
+func (s *AuthService) CreateAPIKey(ctx context.Context, userID, orgID int, name string, scopes []string) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("name is required")
+	}
+	seen := map[string]bool{}
+	var clean []string
+	for _, scope := range scopes {
+		if !knownScopes[scope] {
+			return nil, "", fmt.Errorf("unknown scope %q", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			clean = append(clean, scope)
+		}
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, "", err
+	}
+	key := APIKeyPrefix + token
+
+	create := s.client.APIKey.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetPrefix(key[:apiKeyShownPrefix]).
+		SetTokenHash(hashToken(key)).
+		SetScopes(clean)
+	if orgID != 0 {
+		create.SetOrgID(orgID)
+	}
+	k, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.audit.Record(ctx, audit.APIKeyCreated, userID, map[string]any{
+		"api_key_id": k.ID,
+		"name":       k.Name,
+		"scopes":     clean,
+	})
+	view := apiKeyView(k)
+	return &view, key, nil
+}
+
+// ListAPIKeys returns the user's keys, newest first, revoked ones
+// included.
+func (s *AuthService) ListAPIKeys(ctx context.Context, userID int) ([]APIKey, error) {
+	keys, err := s.client.APIKey.Query().
+		Where(apikey.UserIDEQ(userID)).
+		Order(ent.Desc(apikey.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]APIKey, len(keys))
+	for i, k := range keys {
+		views[i] = apiKeyView(k)
+	}
+	return views, nil
+}
+
+// RevokeAPIKey stops one of the user's keys from working.
+func (s *AuthService) RevokeAPIKey(ctx context.Context, userID, keyID int) error {
+	k, err := s.client.APIKey.Query().
+		Where(apikey.IDEQ(keyID), apikey.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if k.RevokedAt != nil {
+		return nil
+	}
+
+	if err := k.Update().SetRevokedAt(time.Now()).Exec(ctx); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, audit.APIKeyRevoked, userID, map[string]any{"api_key_id": k.ID})
+	return nil
+}
+
+// AuthenticateAPIKey returns who the key acts for. Revoked keys, keys of
+// banned users and keys for an organization the user has left are
+// refused.
+func (s *AuthService) AuthenticateAPIKey(ctx context.Context, key string) (KeyIdentity, error) {
+	k, err := s.client.APIKey.Query().
+		Where(apikey.TokenHashEQ(hashToken(key))).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return KeyIdentity{}, ErrInvalidAPIKey
+	} else if err != nil {
+		return KeyIdentity{}, err
+	}
+	u := k.Edges.User
+	if k.RevokedAt != nil {
+		return KeyIdentity{UserID: u.ID}, ErrInvalidAPIKey
+	}
+	if u.BannedAt != nil {
+		return KeyIdentity{UserID: u.ID}, ErrUserBanned
+	}
+
+	id := KeyIdentity{UserID: u.ID, Role: u.Role.String(), Scopes: k.Scopes}
+	if k.OrgID != nil {
+		member, err := s.IsOrgMember(ctx, *k.OrgID, u.ID)
+		if err != nil {
+			return KeyIdentity{UserID: u.ID}, err
+		}
+		if !member {
+			return KeyIdentity{UserID: u.ID}, errors.New("no longer a member of the key's organization")
+		}
+		id.OrgID = *k.OrgID
+	}
+
+	if k.LastUsedAt == nil || time.Since(*k.LastUsedAt) > apiKeyUseInterval {
+		if err := k.Update().SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+			return KeyIdentity{}, err
+		}
+	}
+	return id, nil
+}
+
+func apiKeyView(k *ent.APIKey) APIKey {
+	scopes := k.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return APIKey{
+		ID:             k.ID,
+		Name:           k.Name,
+		Prefix:         k.Prefix,
+		Scopes:         scopes,
+		OrganizationID: k.OrgID,
+		LastUsedAt:     k.LastUsedAt,
+		RevokedAt:      k.RevokedAt,
+		CreatedAt:      k.CreatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/auditevent"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+// AuditFilter narrows down an audit log query. Zero values match
+// everything.
+type AuditFilter struct {
+	// Event is an event name, or a prefix of one followed by "*", such as
+	// "auth.*".
+	Event   string
+	ActorID int
+	IP      string
+	Since   time.Time
+	Until   time.Time
+	// BeforeID returns only entries older than the one with this ID, to
+	// page through the log.
+	BeforeID int
+	Limit    int
+}
+
+// AuditLog returns audit log entries matching the filter, newest first.
+func (s *AdminService) AuditLog(ctx context.Context, actorID int, f AuditFilter) ([]*ent.AuditEvent, error) {
+	if err := requirePermission(ctx, s.client, actorID, PermViewAudit); err != nil {
+		return nil, err
+	}
+
+	q := s.client.AuditEvent.Query()
+	if prefix, ok := strings.CutSuffix(f.Event, "*"); ok {
+		q = q.Where(auditevent.EventHasPrefix(prefix))
+	} else if f.Event != "" {
+		q = q.Where(auditevent.EventEQ(f.Event))
+	}
+	if f.ActorID != 0 {
+		q = q.Where(auditevent.ActorIDEQ(f.ActorID))
+	}
+	if f.IP != "" {
+		q = q.Where(auditevent.IPEQ(f.IP))
+	}
+	if !f.Since.IsZero() {
+		q = q.Where(auditevent.CreatedAtGTE(f.Since))
+	}
+	if !f.Until.IsZero() {
+		q = q.Where(auditevent.CreatedAtLT(f.Until))
+	}
+	if f.BeforeID != 0 {
+		q = q.Where(auditevent.IDLT(f.BeforeID))
+	}
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	} else if limit > maxAuditLimit {
+		limit = maxAuditLimit
+	}
+	return q.Order(ent.Desc(auditevent.FieldID)).Limit(limit).All(ctx)
+}
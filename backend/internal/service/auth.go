@@ -3,24 +3,42 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
 	"time"
 
 	"pollapp/backend/ent"
+	"pollapp/backend/ent/organizationmember"
 	"pollapp/backend/ent/user"
+	"pollapp/backend/internal/audit"
+	"pollapp/backend/internal/jwtkeys"
+	"pollapp/backend/internal/mail"
+	"pollapp/backend/internal/oidc"
 
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// guestTokenTTL is how long a guest voter token stays valid.
+const guestTokenTTL = 30 * 24 * time.Hour
+
 type AuthService struct {
 	client *ent.Client
-	secret []byte
+	audit  *audit.Log
+	keys   *jwtkeys.Set
+	oidc   *oidc.Provider
+	mailer mail.Mailer
+	appURL string
+	// mfaRoles are the roles that must use a second factor.
+	mfaRoles map[user.Role]bool
 }
 
-func NewAuthService(client *ent.Client) *AuthService {
+func NewAuthService(client *ent.Client, keys *jwtkeys.Set) *AuthService {
 	return &AuthService{
 		client: client,
-		secret: []byte("your-secret-key-change-in-production"),
+		audit:  audit.New(client),
+		keys:   keys,
+		mailer: mail.LogMailer{},
+		appURL: "http://localhost:3000",
 	}
 }
 
@@ -39,41 +57,223 @@ func (s *AuthService) Register(ctx context.Context, username, email, password st
 		return nil, err
 	}
 
+	s.audit.Record(ctx, audit.UserRegistered, u.ID, map[string]any{
+		"username": u.Username,
+		"email":    u.Email,
+	})
+	if err := s.sendVerification(ctx, u); err != nil {
+		log.Printf("Failed to send verification email to user %d: %v", u.ID, err)
+	}
 	return u, nil
 }
 
-func (s *AuthService) Login(ctx context.Context, email, password string) (string, error) {
+// Login checks the user's credentials and starts a session, or, if they
+// have a second factor or their role requires one, hands back an mfa
+// token for VerifyMFA or FinishMFAEnrollment instead.
+func (s *AuthService) Login(ctx context.Context, email, password string) (*LoginResult, error) {
 	u, err := s.client.User.Query().
 		Where(user.EmailEQ(email)).
 		Only(ctx)
 	if err != nil {
-		return "", errors.New("invalid credentials")
+		s.audit.Record(ctx, audit.LoginFailed, 0, map[string]any{"email": email, "reason": "unknown email"})
+		return nil, errors.New("invalid credentials")
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password))
 	if err != nil {
-		return "", errors.New("invalid credentials")
+		s.audit.Record(ctx, audit.LoginFailed, u.ID, map[string]any{"email": email, "reason": "wrong password"})
+		return nil, errors.New("invalid credentials")
+	}
+	if u.BannedAt != nil {
+		s.audit.Record(ctx, audit.LoginFailed, u.ID, map[string]any{"email": email, "reason": "banned"})
+		return nil, ErrUserBanned
+	}
+
+	return s.beginSignIn(ctx, u, "password")
+}
+
+// signIn starts a session for a user whose identity has been checked, in
+// the first organization they joined, if any.
+func (s *AuthService) signIn(ctx context.Context, u *ent.User) (*Tokens, error) {
+	orgID := 0
+	m, err := s.client.OrganizationMember.Query().
+		Where(organizationmember.UserIDEQ(u.ID), organizationmember.PendingEQ(false)).
+		Order(ent.Asc(organizationmember.FieldCreatedAt)).
+		First(ctx)
+	if err == nil {
+		orgID = m.OrganizationID
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return s.startSession(ctx, u, orgID)
+}
+
+// SwitchOrganization makes orgID, or none if it is 0, the active
+// organization of the session and returns a new access token for it.
+// Tokens the session is refreshed to later keep it.
+func (s *AuthService) SwitchOrganization(ctx context.Context, userID, sessionID, orgID int) (string, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if orgID != 0 {
+		member, err := s.IsOrgMember(ctx, orgID, userID)
+		if err != nil {
+			return "", err
+		}
+		if !member {
+			return "", ErrNotOrgMember
+		}
+	}
+
+	update := s.client.Session.UpdateOneID(sessionID)
+	if orgID != 0 {
+		update.SetOrgID(orgID)
+	} else {
+		update.ClearOrgID()
+	}
+	if err := update.Exec(ctx); err != nil {
+		return "", err
+	}
+
+	token, _, err := s.issueToken(u, sessionID, orgID)
+	return token, err
+}
+
+// RecordRejectedToken adds a bearer token that failed validation to the
+// audit log, with the reason it was refused and the user it names, 0 if
+// unknown.
+func (s *AuthService) RecordRejectedToken(ctx context.Context, userID int, reason string) {
+	s.audit.Record(ctx, audit.TokenRejected, userID, map[string]any{"reason": reason})
+}
+
+// CheckAccount verifies that the user a token was issued to still exists,
+// isn't banned and still has the role the token claims, so that bans and
+// role changes take effect on tokens already handed out.
+func (s *AuthService) CheckAccount(ctx context.Context, userID int, role string) error {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.BannedAt != nil {
+		return ErrUserBanned
 	}
+	if u.Role.String() != role {
+		return errors.New("role has changed since the token was issued")
+	}
+	return nil
+}
+
+// IsOrgMember reports whether the user belongs to the organization. Tokens
+// naming an organization the user has since left are refused.
+func (s *AuthService) IsOrgMember(ctx context.Context, orgID, userID int) (bool, error) {
+	return isOrgMember(ctx, s.client, orgID, userID)
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+// issueToken signs an access token for the user in the session, carrying
+// their site role and the active organization as org_id unless it is 0,
+// and returns when it expires.
+func (s *AuthService) issueToken(u *ent.User, sessionID, orgID int) (string, time.Time, error) {
+	expiresAt := time.Now().Add(accessTokenTTL)
+	claims := jwt.MapClaims{
 		"user_id":  u.ID,
 		"username": u.Username,
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
-	})
+		"role":     u.Role.String(),
+		"sid":      sessionID,
+		"exp":      expiresAt.Unix(),
+	}
+	if orgID != 0 {
+		claims["org_id"] = orgID
+	}
 
-	tokenString, err := token.SignedString(s.secret)
+	tokenString, err := s.keys.Sign(claims)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
-	return tokenString, nil
+	return tokenString, expiresAt, nil
 }
 
-func (s *AuthService) ValidateToken(tokenString string) (*jwt.Token, error) {
-	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return s.secret, nil
+// ValidateToken parses and verifies an access token. Tokens of revoked
+// sessions, and tokens from before sessions existed, are rejected.
+func (s *AuthService) ValidateToken(ctx context.Context, tokenString string) (*jwt.Token, error) {
+	token, err := s.parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("unexpected claims")
+	}
+	sessionID, ok := claims["sid"].(float64)
+	if !ok {
+		return nil, errors.New("token has no session")
+	}
+	if err := s.checkSession(ctx, int(sessionID)); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// parseToken checks an access token's signature, expiry, issuer and
+// audience.
+func (s *AuthService) parseToken(tokenString string) (*jwt.Token, error) {
+	return s.keys.Parse(tokenString)
+}
+
+// JWKS returns the public keys tokens can be verified with.
+func (s *AuthService) JWKS() jwtkeys.JWKS {
+	return s.keys.JWKS()
+}
+
+// IssueGuestToken returns a signed token letting someone without an account
+// vote on the given poll, and when it expires. Its random subject is what
+// identifies the guest voter. It is signed for the guest audience, so it
+// is never taken for an access token.
+func (s *AuthService) IssueGuestToken(pollID int) (string, time.Time, error) {
+	subject, err := newToken()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Now().Add(guestTokenTTL)
+
+	tokenString, err := s.keys.SignKind(jwtkeys.Guest, jwt.MapClaims{
+		"sub":     subject,
+		"poll_id": pollID,
+		"exp":     expiresAt.Unix(),
 	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
+// GuestSubject validates a guest token for the given poll and returns its
+// subject.
+func (s *AuthService) GuestSubject(tokenString string, pollID int) (string, error) {
+	token, err := s.keys.ParseKind(jwtkeys.Guest, tokenString)
+	if err != nil || !token.Valid {
+		return "", errors.New("invalid guest token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", errors.New("invalid guest token")
+	}
+
+	tokenPollID, ok := claims["poll_id"].(float64)
+	if !ok || int(tokenPollID) != pollID {
+		return "", errors.New("guest token is for another poll")
+	}
+
+	subject, ok := claims["sub"].(string)
+	if !ok || subject == "" {
+		return "", errors.New("invalid guest token")
+	}
+
+	return subject, nil
 }
@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/vote"
+)
+
+// usesBallots reports whether the poll stores its votes as Ballot rows
+// rather than one Vote per selected option.
+func usesBallots(p *ent.Poll) bool {
+	switch p.VotingMethod {
+	case poll.VotingMethodRanked, poll.VotingMethodScore, poll.VotingMethodStar:
+		return true
+	}
+	return false
+}
+
+// validateBallot checks the input against the poll's voting method: a
+// selection within min/max choices, a ranking of every option, or a score
+// within range for every option.
+func (s *PollService) validateBallot(ctx context.Context, p *ent.Poll, in BallotInput) error {
+	optionIDs, err := s.client.PollOption.Query().
+		Where(polloption.PollIDEQ(p.ID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	valid := make(map[int]bool, len(optionIDs))
+	for _, id := range optionIDs {
+		valid[id] = true
+	}
+
+	switch p.VotingMethod {
+	case poll.VotingMethodScore, poll.VotingMethodStar:
+		if in.Scores == nil {
+			return fmt.Errorf("%w: this poll expects a score for every option", ErrInvalidBallot)
+		}
+		for id, score := range in.Scores {
+			if !valid[id] {
+				return fmt.Errorf("%w: poll option not found or doesn't belong to this poll", ErrInvalidBallot)
+			}
+			if score < p.ScoreMin || score > p.ScoreMax {
+				return fmt.Errorf("%w: scores must be between %d and %d", ErrInvalidBallot, p.ScoreMin, p.ScoreMax)
+			}
+		}
+		if len(in.Scores) != len(optionIDs) {
+			return fmt.Errorf("%w: score all %d options", ErrInvalidBallot, len(optionIDs))
+		}
+		return nil
+	}
+
+	if in.Scores != nil {
+		return fmt.Errorf("%w: this poll doesn't take scores", ErrInvalidBallot)
+	}
+
+	seen := make(map[int]bool, len(in.OptionIDs))
+	for _, id := range in.OptionIDs {
+		if !valid[id] {
+			return fmt.Errorf("%w: poll option not found or doesn't belong to this poll", ErrInvalidBallot)
+		}
+		if seen[id] {
+			return fmt.Errorf("%w: option %d chosen more than once", ErrInvalidBallot, id)
+		}
+		seen[id] = true
+	}
+
+	if p.VotingMethod == poll.VotingMethodRanked {
+		if len(in.OptionIDs) != len(optionIDs) {
+			return fmt.Errorf("%w: rank all %d options", ErrInvalidBallot, len(optionIDs))
+		}
+		return nil
+	}
+
+	if len(in.OptionIDs) < p.MinChoices || len(in.OptionIDs) > p.MaxChoices {
+		if p.MinChoices == p.MaxChoices {
+			return fmt.Errorf("%w: select exactly %d option(s)", ErrInvalidBallot, p.MinChoices)
+		}
+		return fmt.Errorf("%w: select between %d and %d options", ErrInvalidBallot, p.MinChoices, p.MaxChoices)
+	}
+	return nil
+}
+
+// storeVotes replaces the user's selection on a poll that records one Vote
+// per selected option.
+func storeVotes(ctx context.Context, tx *ent.Tx, p *ent.Poll, owner ballotOwner, optionIDs []int) error {
+	if _, err := tx.Vote.Delete().
+		Where(vote.PollIDEQ(p.ID), owner.votes()).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	builders := make([]*ent.VoteCreate, len(optionIDs))
+	for i, optionID := range optionIDs {
+		builders[i] = tx.Vote.Create().
+			SetPollID(p.ID).
+			SetPollOptionID(optionID).
+			SetNillableUserID(owner.user()).
+			SetNillableGuestVoterID(owner.guest())
+	}
+	return tx.Vote.CreateBulk(builders...).Exec(ctx)
+}
+
+// storeRankedBallot replaces the user's ranked ballot on p.
+func storeRankedBallot(ctx context.Context, tx *ent.Tx, p *ent.Poll, owner ballotOwner, ranking []int) error {
+	if err := deleteBallots(ctx, tx, ballot.PollIDEQ(p.ID), owner.ballots()); err != nil {
+		return err
+	}
+
+	b, err := tx.Ballot.Create().
+		SetPollID(p.ID).
+		SetNillableUserID(owner.user()).
+		SetNillableGuestVoterID(owner.guest()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*ent.BallotEntryCreate, len(ranking))
+	for i, optionID := range ranking {
+		builders[i] = tx.BallotEntry.Create().
+			SetBallotID(b.ID).
+			SetPollOptionID(optionID).
+			SetRank(i + 1)
+	}
+	return tx.BallotEntry.CreateBulk(builders...).Exec(ctx)
+}
+
+// storeScoreBallot replaces the user's score ballot on p.
+func storeScoreBallot(ctx context.Context, tx *ent.Tx, p *ent.Poll, owner ballotOwner, scores map[int]int) error {
+	optionIDs := make([]int, 0, len(scores))
+	for id := range scores {
+		optionIDs = append(optionIDs, id)
+	}
+	sort.Ints(optionIDs)
+
+	if err := deleteBallots(ctx, tx, ballot.PollIDEQ(p.ID), owner.ballots()); err != nil {
+		return err
+	}
+
+	b, err := tx.Ballot.Create().
+		SetPollID(p.ID).
+		SetNillableUserID(owner.user()).
+		SetNillableGuestVoterID(owner.guest()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*ent.BallotEntryCreate, len(optionIDs))
+	for i, optionID := range optionIDs {
+		builders[i] = tx.BallotEntry.Create().
+			SetBallotID(b.ID).
+			SetPollOptionID(optionID).
+			SetScore(scores[optionID])
+	}
+	return tx.BallotEntry.CreateBulk(builders...).Exec(ctx)
+}
+
+// deleteBallots removes the matching ballots together with their entries.
+func deleteBallots(ctx context.Context, tx *ent.Tx, ps ...predicate.Ballot) error {
+	if _, err := tx.BallotEntry.Delete().
+		Where(ballotentry.HasBallotWith(ps...)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Ballot.Delete().
+		Where(ps...).
+		Exec(ctx)
+	return err
+}
+
+// selectionCounts returns how many voters selected each option on a poll
+// that isn't ranked or scored.
+func (s *PollService) selectionCounts(ctx context.Context, p *ent.Poll) (map[int]int, error) {
+	optionIDs, err := s.client.PollOption.Query().
+		Where(polloption.PollIDEQ(p.ID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(optionIDs))
+	for _, id := range optionIDs {
+		counts[id] = 0
+	}
+
+	if p.Anonymous {
+		ballots, err := s.client.AnonymousBallot.Query().
+			Where(anonymousballot.PollIDEQ(p.ID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range ballots {
+			for _, id := range b.Choices {
+				counts[id]++
+			}
+		}
+		return counts, nil
+	}
+
+	for _, id := range optionIDs {
+		count, err := s.client.Vote.Query().
+			Where(vote.PollOptionIDEQ(id)).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		counts[id] = count
+	}
+	return counts, nil
+}
+
+// rankedBallots loads every ballot cast on the poll as an ordered ranking.
+func (s *PollService) rankedBallots(ctx context.Context, p *ent.Poll) ([]RankedBallot, error) {
+	if p.Anonymous {
+		anonymous, err := s.client.AnonymousBallot.Query().
+			Where(anonymousballot.PollIDEQ(p.ID)).
+			Order(ent.Asc(anonymousballot.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ballots := make([]RankedBallot, len(anonymous))
+		for i, b := range anonymous {
+			ballots[i] = RankedBallot(b.Choices)
+		}
+		return ballots, nil
+	}
+
+	entries, err := s.client.BallotEntry.Query().
+		Where(ballotentry.HasBallotWith(ballot.PollIDEQ(p.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byBallot := make(map[int][]*ent.BallotEntry)
+	var ballotIDs []int
+	for _, e := range entries {
+		if _, ok := byBallot[e.BallotID]; !ok {
+			ballotIDs = append(ballotIDs, e.BallotID)
+		}
+		byBallot[e.BallotID] = append(byBallot[e.BallotID], e)
+	}
+	sort.Ints(ballotIDs)
+
+	ballots := make([]RankedBallot, 0, len(ballotIDs))
+	for _, id := range ballotIDs {
+		es := byBallot[id]
+		sort.Slice(es, func(i, j int) bool { return es[i].Rank < es[j].Rank })
+		ranking := make(RankedBallot, len(es))
+		for i, e := range es {
+			ranking[i] = e.PollOptionID
+		}
+		ballots = append(ballots, ranking)
+	}
+	return ballots, nil
+}
+
+// firstPreferenceCounts returns how many ranked ballots put each option
+// first.
+func (s *PollService) firstPreferenceCounts(ctx context.Context, p *ent.Poll) (map[int]int, error) {
+	optionIDs, err := s.client.PollOption.Query().
+		Where(polloption.PollIDEQ(p.ID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(optionIDs))
+	for _, id := range optionIDs {
+		counts[id] = 0
+	}
+
+	ballots, err := s.rankedBallots(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range ballots {
+		if len(b) > 0 {
+			counts[b[0]]++
+		}
+	}
+	return counts, nil
+}
+
+// scoreBallots loads every ballot cast on a score or STAR poll.
+func (s *PollService) scoreBallots(ctx context.Context, p *ent.Poll) ([]ScoreBallot, error) {
+	if p.Anonymous {
+		anonymous, err := s.client.AnonymousBallot.Query().
+			Where(anonymousballot.PollIDEQ(p.ID)).
+			Order(ent.Asc(anonymousballot.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ballots := make([]ScoreBallot, len(anonymous))
+		for i, b := range anonymous {
+			ballots[i] = ScoreBallot(b.Scores)
+		}
+		return ballots, nil
+	}
+
+	entries, err := s.client.BallotEntry.Query().
+		Where(ballotentry.HasBallotWith(ballot.PollIDEQ(p.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byBallot := make(map[int]ScoreBallot)
+	var ballotIDs []int
+	for _, e := range entries {
+		b, ok := byBallot[e.BallotID]
+		if !ok {
+			b = make(ScoreBallot)
+			byBallot[e.BallotID] = b
+			ballotIDs = append(ballotIDs, e.BallotID)
+		}
+		b[e.PollOptionID] = e.Score
+	}
+	sort.Ints(ballotIDs)
+
+	ballots := make([]ScoreBallot, 0, len(ballotIDs))
+	for _, id := range ballotIDs {
+		ballots = append(ballots, byBallot[id])
+	}
+	return ballots, nil
+}
+
+// scoreTotals returns the total score each option received.
+func (s *PollService) scoreTotals(ctx context.Context, p *ent.Poll) (map[int]int, error) {
+	optionIDs, err := s.client.PollOption.Query().
+		Where(polloption.PollIDEQ(p.ID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[int]int, len(optionIDs))
+	for _, id := range optionIDs {
+		totals[id] = 0
+	}
+
+	ballots, err := s.scoreBallots(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range ballots {
+		for id, score := range b {
+			totals[id] += score
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/ballotlogentry"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/internal/ballotlog"
+)
+
+// BallotChain is a poll's published ballot log.
+type BallotChain struct {
+	PollID       int               `json:"poll_id"`
+	VotingMethod poll.VotingMethod `json:"voting_method"`
+	Entries      []ballotlog.Entry `json:"entries"`
+	Head         string            `json:"head"`
+}
+
+// ReceiptCheck is the outcome of VerifyReceipt.
+type ReceiptCheck struct {
+	Included    bool   `json:"included"`
+	Sequence    int    `json:"sequence,omitempty"`
+	ChainIntact bool   `json:"chain_intact"`
+	Problem     string `json:"problem,omitempty"`
+	Head        string `json:"head"`
+}
+
+// ballotHash hashes the ballot the way the voter can recompute it from
+// their receipt: selections are sorted, rankings keep their order.
+func ballotHash(p *ent.Poll, nonce string, in BallotInput) string {
+	choices := append([]int(nil), in.OptionIDs...)
+	if p.VotingMethod != poll.VotingMethodRanked {
+		sort.Ints(choices)
+	}
+	return ballotlog.BallotHash(p.ID, nonce, choices, in.Scores)
+}
+
+// errBallotLogConflict marks a ballot log append that lost the race for
+// its sequence number to a concurrent vote. It wraps the constraint error.
+var errBallotLogConflict = errors.New("ballot log sequence taken by a concurrent vote")
+
+// appendBallotLog links ballotHash onto the end of the poll's chain. Two
+// concurrent appends pick the same sequence number and the unique index
+// fails the later one with errBallotLogConflict, which the caller retries.
+func appendBallotLog(ctx context.Context, tx *ent.Tx, pollID int, ballotHash string) (*ent.BallotLogEntry, error) {
+	sequence, prev := 1, ballotlog.Genesis(pollID)
+	last, err := tx.BallotLogEntry.Query().
+		Where(ballotlogentry.PollIDEQ(pollID)).
+		Order(ent.Desc(ballotlogentry.FieldSequence)).
+		First(ctx)
+	switch {
+	case err == nil:
+		sequence, prev = last.Sequence+1, last.Hash
+	case !ent.IsNotFound(err):
+		return nil, err
+	}
+
+	entry, err := tx.BallotLogEntry.Create().
+		SetPollID(pollID).
+		SetSequence(sequence).
+		SetBallotHash(ballotHash).
+		SetHash(ballotlog.Link(prev, ballotHash)).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("%w: %w", errBallotLogConflict, err)
+	}
+	return entry, err
+}
+
+// BallotLog returns the poll's ballot log as stored. Replaced ballots stay
+// in the log; only a voter's latest receipt refers to a counted ballot.
+func (s *PollService) BallotLog(ctx context.Context, pollID int) (*BallotChain, error) {
+	p, err := s.client.Poll.Get(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.client.BallotLogEntry.Query().
+		Where(ballotlogentry.PollIDEQ(pollID)).
+		Order(ent.Asc(ballotlogentry.FieldSequence)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &BallotChain{
+		PollID:       p.ID,
+		VotingMethod: p.VotingMethod,
+		Entries:      make([]ballotlog.Entry, len(rows)),
+		Head:         ballotlog.Genesis(p.ID),
+	}
+	for i, row := range rows {
+		chain.Entries[i] = ballotlog.Entry{
+			Sequence:   row.Sequence,
+			BallotHash: row.BallotHash,
+			Hash:       row.Hash,
+		}
+		chain.Head = row.Hash
+	}
+	return chain, nil
+}
+
+// VerifyReceipt reports whether ballotHash is recorded in the poll's
+// ballot log and whether the log still hashes up to its head.
+func (s *PollService) VerifyReceipt(ctx context.Context, pollID int, ballotHash string) (*ReceiptCheck, error) {
+	chain, err := s.BallotLog(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &ReceiptCheck{Head: chain.Head}
+	if _, err := ballotlog.Verify(pollID, chain.Entries); err != nil {
+		check.Problem = err.Error()
+	} else {
+		check.ChainIntact = true
+	}
+	if e, ok := ballotlog.Find(chain.Entries, ballotHash); ok {
+		check.Included = true
+		check.Sequence = e.Sequence
+	}
+	return check, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/pollcollaborator"
+)
+
+// Collaborator is a user sharing control of a poll with its creator.
+type Collaborator struct {
+	UserID   int       `json:"user_id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+// pollRight is what a user may do to a poll beyond viewing and voting on
+// it. Each right includes the ones before it.
+type pollRight int
+
+const (
+	rightNone pollRight = iota
+	// rightViewResults lets a user see the results whatever the poll's
+	// results visibility.
+	rightViewResults
+	// rightEdit lets a user change the poll and close or reopen it.
+	rightEdit
+	// rightManage lets a user do everything the creator can, including
+	// deleting the poll and deciding who else may manage it.
+	rightManage
+)
+
+// collaboratorRights maps each collaborator role to its right.
+var collaboratorRights = map[pollcollaborator.Role]pollRight{
+	pollcollaborator.RoleCoOwner:       rightManage,
+	pollcollaborator.RoleEditor:        rightEdit,
+	pollcollaborator.RoleResultsViewer: rightViewResults,
+}
+
+// ownedPoll loads the poll and checks that userID may manage it as a whole.
+func (s *PollService) ownedPoll(ctx context.Context, id, userID int) (*ent.Poll, error) {
+	return s.pollWithRight(ctx, id, userID, rightManage)
+}
+
+// pollWithRight loads the poll and checks that userID holds right on it,
+// as its creator, as a collaborator or through a site role that lets them
+// manage any poll.
+func (s *PollService) pollWithRight(ctx context.Context, id, userID int, right pollRight) (*ent.Poll, error) {
+	p, err := s.client.Poll.Query().
+		Where(poll.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.hasRight(ctx, p, userID, right)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := requirePermission(ctx, s.client, userID, PermManageAnyPoll); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// hasRight reports whether the user holds right on the poll as its creator
+// or a collaborator. Creators and collaborators who have left the poll's
+// organization lose their rights.
+func (s *PollService) hasRight(ctx context.Context, p *ent.Poll, userID int, right pollRight) (bool, error) {
+	if userID == 0 {
+		return false, nil
+	}
+
+	granted := rightManage
+	if p.CreatedBy != userID {
+		c, err := s.client.PollCollaborator.Query().
+			Where(pollcollaborator.PollIDEQ(p.ID), pollcollaborator.UserIDEQ(userID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		granted = collaboratorRights[c.Role]
+	}
+	if granted < right {
+		return false, nil
+	}
+
+	if p.OrganizationID != nil {
+		return isOrgMember(ctx, s.client, *p.OrganizationID, userID)
+	}
+	return true, nil
+}
+
+// ListCollaborators returns the users sharing control of the poll.
+func (s *PollService) ListCollaborators(ctx context.Context, id, userID int) ([]Collaborator, error) {
+	if _, err := s.ownedPoll(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.client.PollCollaborator.Query().
+		Where(pollcollaborator.PollIDEQ(id)).
+		WithUser().
+		Order(ent.Asc(pollcollaborator.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]Collaborator, len(rows))
+	for i, row := range rows {
+		collaborators[i] = collaborator(row, row.Edges.User)
+	}
+	return collaborators, nil
+}
+
+// SetCollaborator gives the user with the given username or email a role
+// on the poll, replacing any role they had.
+func (s *PollService) SetCollaborator(ctx context.Context, id, userID int, who, role string) (*Collaborator, error) {
+	r := pollcollaborator.Role(role)
+	if err := pollcollaborator.RoleValidator(r); err != nil {
+		return nil, fmt.Errorf("unknown role %q", role)
+	}
+
+	p, err := s.ownedPoll(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := findUser(ctx, s.client, who)
+	if err != nil {
+		return nil, err
+	}
+	if u.ID == p.CreatedBy {
+		return nil, errors.New("the creator already owns the poll")
+	}
+
+	c, err := s.client.PollCollaborator.Query().
+		Where(pollcollaborator.PollIDEQ(id), pollcollaborator.UserIDEQ(u.ID)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		c, err = s.client.PollCollaborator.Create().
+			SetPollID(id).
+			SetUserID(u.ID).
+			SetRole(r).
+			Save(ctx)
+	case err == nil:
+		c, err = c.Update().
+			SetRole(r).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := collaborator(c, u)
+	return &result, nil
+}
+
+// RemoveCollaborator takes away the role of the user with the given
+// username or email. Collaborators may always step down themselves.
+func (s *PollService) RemoveCollaborator(ctx context.Context, id, userID int, who string) error {
+	u, err := findUser(ctx, s.client, who)
+	if err != nil {
+		return err
+	}
+	if u.ID != userID {
+		if _, err := s.ownedPoll(ctx, id, userID); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.client.PollCollaborator.Delete().
+		Where(pollcollaborator.PollIDEQ(id), pollcollaborator.UserIDEQ(u.ID)).
+		Exec(ctx)
+	return err
+}
+
+func collaborator(c *ent.PollCollaborator, u *ent.User) Collaborator {
+	return Collaborator{
+		UserID:   u.ID,
+		Username: u.Username,
+		Email:    u.Email,
+		Role:     c.Role.String(),
+		AddedAt:  c.CreatedAt,
+	}
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/emailtoken"
+	"pollapp/backend/ent/session"
+	"pollapp/backend/ent/user"
+	"pollapp/backend/internal/audit"
+	"pollapp/backend/internal/mail"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// How long mailed links stay valid.
+const (
+	resetTokenTTL  = time.Hour
+	verifyTokenTTL = 48 * time.Hour
+)
+
+var (
+	// ErrInvalidEmailToken is returned for password reset and verification
+	// tokens that are unknown, used or expired.
+	ErrInvalidEmailToken = errors.New("this link is invalid or has expired")
+	// ErrEmailAlreadyVerified is returned when asking to verify an email
+	// that already is.
+	ErrEmailAlreadyVerified = errors.New("email is already verified")
+	// ErrEmailNotVerified is returned when an action needs a verified
+	// email.
+	ErrEmailNotVerified = errors.New("verify your email first")
+)
+
+// UseMailer sets how emails are sent, and the frontend URL the links in
+// them point to.
+func (s *AuthService) UseMailer(mailer mail.Mailer, appURL string) {
+	s.mailer = mailer
+	s.appURL = strings.TrimRight(appURL, "/")
+}
+
+// RequestPasswordReset mails a password reset link to the user with the
+// email, if there is one. Whether there is isn't revealed, and links sent
+// before stop working.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	u, err := s.client.User.Query().
+		Where(user.EmailEQ(strings.TrimSpace(email))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if u.BannedAt != nil {
+		return nil
+	}
+
+	token, err := s.newEmailToken(ctx, u, emailtoken.PurposeResetPassword, resetTokenTTL)
+	if err != nil {
+		return err
+	}
+	s.send(mail.Message{
+		To:      u.Email,
+		Subject: "Reset your PollApp password",
+		Body: fmt.Sprintf("Hi %s,\n\nTo choose a new password, open this link within the next hour:\n\n%s\n\nIf you didn't ask for this, you can ignore this email.\n",
+			u.Username, s.link("/reset-password", token)),
+	})
+	s.audit.Record(ctx, audit.PasswordResetRequested, u.ID, nil)
+	return nil
+}
+
+// ResetPassword sets a new password using a mailed reset token and signs
+// the user out everywhere. Having received the mail, the user's email
+// counts as verified too.
+func (s *AuthService) ResetPassword(ctx context.Context, token, password string) error {
+	if password == "" {
+		return errors.New("password is required")
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	var userID int
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		u, err := consumeEmailToken(ctx, tx, token, emailtoken.PurposeResetPassword)
+		if err != nil {
+			return err
+		}
+		userID = u.ID
+
+		update := u.Update().SetPasswordHash(string(hashedPassword))
+		if u.EmailVerifiedAt == nil {
+			update.SetEmailVerifiedAt(time.Now())
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+		return revokeSessions(ctx, tx.Client(), "password reset", session.UserIDEQ(u.ID))
+	})
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, audit.PasswordReset, userID, nil)
+	return nil
+}
+
+// VerifyEmail marks the user's email verified using a mailed verification
+// token.
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	var userID int
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		u, err := consumeEmailToken(ctx, tx, token, emailtoken.PurposeVerifyEmail)
+		if err != nil {
+			return err
+		}
+		userID = u.ID
+
+		if u.EmailVerifiedAt != nil {
+			return nil
+		}
+		return u.Update().SetEmailVerifiedAt(time.Now()).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, audit.EmailVerified, userID, nil)
+	return nil
+}
+
+// ResendVerification mails the user a new verification link.
+func (s *AuthService) ResendVerification(ctx context.Context, userID int) error {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.EmailVerifiedAt != nil {
+		return ErrEmailAlreadyVerified
+	}
+	return s.sendVerification(ctx, u)
+}
+
+func (s *AuthService) sendVerification(ctx context.Context, u *ent.User) error {
+	token, err := s.newEmailToken(ctx, u, emailtoken.PurposeVerifyEmail, verifyTokenTTL)
+	if err != nil {
+		return err
+	}
+	s.send(mail.Message{
+		To:      u.Email,
+		Subject: "Verify your PollApp email",
+		Body: fmt.Sprintf("Hi %s,\n\nTo confirm this is your email, open this link within the next two days:\n\n%s\n",
+			u.Username, s.link("/verify-email", token)),
+	})
+	return nil
+}
+
+// newEmailToken stores a new token for the user, retiring their unused
+// ones for the same purpose.
+func (s *AuthService) newEmailToken(ctx context.Context, u *ent.User, purpose emailtoken.Purpose, ttl time.Duration) (string, error) {
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := tx.EmailToken.Update().
+			Where(
+				emailtoken.UserIDEQ(u.ID),
+				emailtoken.PurposeEQ(purpose),
+				emailtoken.UsedAtIsNil(),
+			).
+			SetUsedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.EmailToken.Create().
+			SetUserID(u.ID).
+			SetPurpose(purpose).
+			SetTokenHash(hashToken(token)).
+			SetEmail(u.Email).
+			SetExpiresAt(time.Now().Add(ttl)).
+			Exec(ctx)
+	})
+	return token, err
+}
+
+// consumeEmailToken marks a token used and returns its user. Each token
+// works once, before it expires, and only while the user still has the
+// email it was sent to.
+func consumeEmailToken(ctx context.Context, tx *ent.Tx, token string, purpose emailtoken.Purpose) (*ent.User, error) {
+	t, err := tx.EmailToken.Query().
+		Where(emailtoken.TokenHashEQ(hashToken(token)), emailtoken.PurposeEQ(purpose)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidEmailToken
+	} else if err != nil {
+		return nil, err
+	}
+	if t.UsedAt != nil || time.Now().After(t.ExpiresAt) || t.Email != t.Edges.User.Email {
+		return nil, ErrInvalidEmailToken
+	}
+
+	n, err := tx.EmailToken.Update().
+		Where(emailtoken.IDEQ(t.ID), emailtoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidEmailToken
+	}
+	return t.Edges.User, nil
+}
+
+func (s *AuthService) link(path, token string) string {
+	return s.appURL + path + "?token=" + url.QueryEscape(token)
+}
+
+// send mails the message in the background, so that responses take as
+// long whether or not a mail was sent. Failures are logged.
+func (s *AuthService) send(msg mail.Message) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := s.mailer.Send(ctx, msg); err != nil {
+			log.Printf("Failed to send %q to %s: %v", msg.Subject, msg.To, err)
+		}
+	}()
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/guestvoter"
+	"pollapp/backend/ent/participation"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/vote"
+)
+
+var (
+	// ErrGuestsNotAllowed is returned when a guest votes on a poll that
+	// doesn't allow guests.
+	ErrGuestsNotAllowed = errors.New("this poll doesn't accept guest votes")
+	// ErrDuplicateGuest is returned under the token_ip dedupe policy when
+	// a new guest token is used from an address that already voted.
+	ErrDuplicateGuest = errors.New("a guest from this address has already voted on this poll")
+)
+
+// Voter is who casts a ballot: a signed-in user, or a guest holding a
+// poll-scoped guest token.
+type Voter struct {
+	UserID int
+	// GuestSubject is the random subject of a verified guest token.
+	GuestSubject string
+	// IP is the guest's client address, used by the token_ip dedupe
+	// policy.
+	IP string
+	// OrgID is the voter's active organization, 0 for none.
+	OrgID int
+	// ShareLinkID is set when voting through a share link, which stands
+	// in for the poll's access rules and is charged one use.
+	ShareLinkID int
+}
+
+// ParticipationCounts splits a poll's voters into members and guests.
+type ParticipationCounts struct {
+	Members int `json:"members"`
+	Guests  int `json:"guests"`
+}
+
+// ballotOwner is who stored votes, ballots and participations belong to:
+// a user or a guest voter, never both.
+type ballotOwner struct {
+	userID  int
+	guestID int
+}
+
+func (o ballotOwner) user() *int {
+	if o.guestID != 0 {
+		return nil
+	}
+	return &o.userID
+}
+
+func (o ballotOwner) guest() *int {
+	if o.guestID == 0 {
+		return nil
+	}
+	return &o.guestID
+}
+
+func (o ballotOwner) votes() predicate.Vote {
+	if o.guestID != 0 {
+		return vote.GuestVoterIDEQ(o.guestID)
+	}
+	return vote.UserIDEQ(o.userID)
+}
+
+func (o ballotOwner) ballots() predicate.Ballot {
+	if o.guestID != 0 {
+		return ballot.GuestVoterIDEQ(o.guestID)
+	}
+	return ballot.UserIDEQ(o.userID)
+}
+
+func (o ballotOwner) participations() predicate.Participation {
+	if o.guestID != 0 {
+		return participation.GuestVoterIDEQ(o.guestID)
+	}
+	return participation.UserIDEQ(o.userID)
+}
+
+// guestVoter returns the guest voter holding the token subject on p,
+// registering them on their first vote.
+func guestVoter(ctx context.Context, tx *ent.Tx, p *ent.Poll, voter Voter) (int, error) {
+	tokenHash := hashToken(voter.GuestSubject)
+	g, err := tx.GuestVoter.Query().
+		Where(guestvoter.PollIDEQ(p.ID), guestvoter.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err == nil {
+		return g.ID, nil
+	}
+	if !ent.IsNotFound(err) {
+		return 0, err
+	}
+
+	// IPs are hashed per poll so they can't be matched across polls.
+	ipHash := hashToken(fmt.Sprintf("%d:%s", p.ID, voter.IP))
+	if p.GuestDedupe == poll.GuestDedupeTokenIP {
+		seen, err := tx.GuestVoter.Query().
+			Where(guestvoter.PollIDEQ(p.ID), guestvoter.IPHashEQ(ipHash)).
+			Exist(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if seen {
+			return 0, ErrDuplicateGuest
+		}
+	}
+
+	g, err = tx.GuestVoter.Create().
+		SetPollID(p.ID).
+		SetTokenHash(tokenHash).
+		SetIPHash(ipHash).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return g.ID, nil
+}
+
+// ParticipationCounts returns how many members and guests voted on the
+// poll. Only the poll's creator may see the split.
+func (s *PollService) ParticipationCounts(ctx context.Context, id, userID int) (*ParticipationCounts, error) {
+	p, err := s.ownedPoll(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.participationCounts(ctx, p)
+}
+
+func (s *PollService) participationCounts(ctx context.Context, p *ent.Poll) (*ParticipationCounts, error) {
+	var (
+		counts ParticipationCounts
+		err    error
+	)
+
+	switch {
+	case p.Anonymous:
+		if counts.Members, err = s.client.Participation.Query().
+			Where(participation.PollIDEQ(p.ID), participation.UserIDNotNil()).
+			Count(ctx); err != nil {
+			return nil, err
+		}
+		counts.Guests, err = s.client.Participation.Query().
+			Where(participation.PollIDEQ(p.ID), participation.GuestVoterIDNotNil()).
+			Count(ctx)
+	case usesBallots(p):
+		if counts.Members, err = s.client.Ballot.Query().
+			Where(ballot.PollIDEQ(p.ID), ballot.UserIDNotNil()).
+			Count(ctx); err != nil {
+			return nil, err
+		}
+		counts.Guests, err = s.client.Ballot.Query().
+			Where(ballot.PollIDEQ(p.ID), ballot.GuestVoterIDNotNil()).
+			Count(ctx)
+	default:
+		var ids []int
+		if ids, err = s.client.Vote.Query().
+			Where(vote.PollIDEQ(p.ID), vote.UserIDNotNil()).
+			Unique(true).
+			Select(vote.FieldUserID).
+			Ints(ctx); err != nil {
+			return nil, err
+		}
+		counts.Members = len(ids)
+		ids, err = s.client.Vote.Query().
+			Where(vote.PollIDEQ(p.ID), vote.GuestVoterIDNotNil()).
+			Unique(true).
+			Select(vote.FieldGuestVoterID).
+			Ints(ctx)
+		counts.Guests = len(ids)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &counts, nil
+}
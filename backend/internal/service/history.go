@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/pollrevision"
+	"pollapp/backend/ent/user"
+	"pollapp/backend/internal/history"
+)
+
+// Revision is one recorded change to a poll or one of its options.
+type Revision struct {
+	ID       int    `json:"id"`
+	Entity   string `json:"entity"`
+	EntityID int    `json:"entity_id"`
+	Action   string `json:"action"`
+	// ActorID and ActorUsername are unset for changes made by the server
+	// itself.
+	ActorID       *int           `json:"actor_id,omitempty"`
+	ActorUsername string         `json:"actor_username,omitempty"`
+	Changed       []string       `json:"changed,omitempty"`
+	Before        map[string]any `json:"before,omitempty"`
+	After         map[string]any `json:"after,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// History returns the changes made to the poll and its options, oldest
+// first, to those who may edit it.
+func (s *PollService) History(ctx context.Context, id, userID int) ([]Revision, error) {
+	if _, err := s.pollWithRight(ctx, id, userID, rightEdit); err != nil {
+		return nil, err
+	}
+	return pollHistory(ctx, s.client, id)
+}
+
+// PollHistory returns the changes made to any poll, including deleted
+// ones.
+func (s *AdminService) PollHistory(ctx context.Context, actorID, pollID int) ([]Revision, error) {
+	if err := requirePermission(ctx, s.client, actorID, PermViewAudit); err != nil {
+		return nil, err
+	}
+	return pollHistory(ctx, s.client, pollID)
+}
+
+func pollHistory(ctx context.Context, client *ent.Client, pollID int) ([]Revision, error) {
+	rows, err := client.PollRevision.Query().
+		Where(pollrevision.PollIDEQ(pollID)).
+		Order(ent.Asc(pollrevision.FieldCreatedAt), ent.Asc(pollrevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var actorIDs []int
+	for _, row := range rows {
+		if row.ActorID != nil {
+			actorIDs = append(actorIDs, *row.ActorID)
+		}
+	}
+	actors, err := client.User.Query().
+		Where(user.IDIn(actorIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[int]string, len(actors))
+	for _, u := range actors {
+		usernames[u.ID] = u.Username
+	}
+
+	revisions := make([]Revision, len(rows))
+	for i, row := range rows {
+		revisions[i] = Revision{
+			ID:        row.ID,
+			Entity:    row.Entity.String(),
+			EntityID:  row.EntityID,
+			Action:    row.Action.String(),
+			ActorID:   row.ActorID,
+			Before:    row.Before,
+			After:     row.After,
+			CreatedAt: row.CreatedAt,
+		}
+		if row.ActorID != nil {
+			revisions[i].ActorUsername = usernames[*row.ActorID]
+		}
+		if row.Before != nil && row.After != nil {
+			revisions[i].Changed = history.Changed(row.Before, row.After)
+		}
+	}
+	return revisions, nil
+}
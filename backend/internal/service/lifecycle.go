@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/polltransition"
+	"pollapp/backend/internal/event"
+)
+
+// ClosePoll stops a poll from accepting votes ahead of its schedule.
+func (s *PollService) ClosePoll(ctx context.Context, id, userID int) (*ent.Poll, error) {
+	p, err := s.pollWithRight(ctx, id, userID, rightEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if status := PollStatus(p, now); status == PollStatusClosed || status == PollStatusArchived {
+		return nil, fmt.Errorf("%w: poll is already %s", ErrInvalidTransition, status)
+	}
+
+	p, err = s.transition(ctx, p, userID, polltransition.ActionClose, func(u *ent.PollUpdateOne) {
+		u.SetClosedAt(now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(event.Event{
+		Type:   event.PollClosed,
+		PollID: p.ID,
+		At:     now,
+	})
+	return p, nil
+}
+
+// ReopenPoll lets a closed or archived poll accept votes again. A poll that
+// closed on schedule loses its closes_at so it stays open.
+func (s *PollService) ReopenPoll(ctx context.Context, id, userID int) (*ent.Poll, error) {
+	p, err := s.pollWithRight(ctx, id, userID, rightEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if status := PollStatus(p, now); status != PollStatusClosed && status != PollStatusArchived {
+		return nil, fmt.Errorf("%w: poll is %s", ErrInvalidTransition, status)
+	}
+
+	return s.transition(ctx, p, userID, polltransition.ActionReopen, func(u *ent.PollUpdateOne) {
+		u.ClearClosedAt().ClearArchivedAt()
+		if p.ClosesAt != nil && !now.Before(*p.ClosesAt) {
+			u.ClearClosesAt()
+		}
+	})
+}
+
+// ArchivePoll closes the poll if needed and hides it from the default poll
+// list. Archived polls can still be fetched by ID. Like closing, it's up
+// to anyone who may edit the poll, since they may reopen it anyway.
+func (s *PollService) ArchivePoll(ctx context.Context, id, userID int) (*ent.Poll, error) {
+	p, err := s.pollWithRight(ctx, id, userID, rightEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	status := PollStatus(p, now)
+	if status == PollStatusArchived {
+		return nil, fmt.Errorf("%w: poll is already archived", ErrInvalidTransition)
+	}
+
+	p, err = s.transition(ctx, p, userID, polltransition.ActionArchive, func(u *ent.PollUpdateOne) {
+		u.SetArchivedAt(now)
+		if p.ClosedAt == nil {
+			u.SetClosedAt(now)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status != PollStatusClosed {
+		s.bus.Publish(event.Event{
+			Type:   event.PollClosed,
+			PollID: p.ID,
+			At:     now,
+		})
+	}
+	return p, nil
+}
+
+// transition applies a lifecycle change to p and records who made it, in a
+// single transaction.
+func (s *PollService) transition(ctx context.Context, p *ent.Poll, userID int, action polltransition.Action, apply func(*ent.PollUpdateOne)) (*ent.Poll, error) {
+	var updated *ent.Poll
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		u := tx.Poll.UpdateOneID(p.ID)
+		apply(u)
+		var err error
+		if updated, err = u.Save(ctx); err != nil {
+			return err
+		}
+
+		_, err = tx.PollTransition.Create().
+			SetPollID(p.ID).
+			SetActorID(userID).
+			SetAction(action).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,500 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/recoverycode"
+	"pollapp/backend/ent/user"
+	"pollapp/backend/internal/audit"
+	"pollapp/backend/internal/jwtkeys"
+	"pollapp/backend/internal/totp"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// mfaTokenTTL is how long a user has to enter their code after their
+	// password, or to enroll when their role requires a second factor.
+	mfaTokenTTL = 5 * time.Minute
+	// After maxMFAFailures wrong codes in a row, second factors are
+	// refused until mfaLockout has passed since the last one.
+	maxMFAFailures = 5
+	mfaLockout     = 15 * time.Minute
+	// recoveryCodeCount recovery codes are issued at a time.
+	recoveryCodeCount = 10
+	// totpIssuer names the app in authenticator apps.
+	totpIssuer = "PollApp"
+)
+
+var (
+	// ErrInvalidMFAToken is returned for mfa tokens that are malformed or
+	// expired; the user has to sign in again.
+	ErrInvalidMFAToken = errors.New("invalid or expired mfa token; sign in again")
+	// ErrInvalidMFACode is returned for wrong, reused or expired codes.
+	ErrInvalidMFACode = errors.New("invalid code")
+	// ErrMFALocked is returned while second factors are refused after too
+	// many wrong codes.
+	ErrMFALocked = errors.New("too many wrong codes; try again later")
+	// ErrMFAAlreadyEnabled is returned when enrolling a user who already
+	// has a second factor.
+	ErrMFAAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrMFANotEnabled is returned when managing a second factor the user
+	// doesn't have, or confirming one they haven't enrolled.
+	ErrMFANotEnabled = errors.New("two-factor authentication is not enabled")
+	// ErrMFARequired is returned when a user whose role requires a second
+	// factor tries to turn it off, or to refresh a session without one.
+	ErrMFARequired = errors.New("your role requires two-factor authentication")
+)
+
+// LoginResult is what signing in hands to the client: either tokens, or,
+// when a second factor is needed, an mfa token to present with the code.
+// With MFAEnrollmentRequired the user's role requires a second factor they
+// don't have yet, and the mfa token lets them enroll one.
+type LoginResult struct {
+	*Tokens
+	MFARequired           bool   `json:"mfa_required,omitempty"`
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required,omitempty"`
+	MFAToken              string `json:"mfa_token,omitempty"`
+}
+
+// TOTPEnrollment is a new authenticator secret, to be confirmed with a
+// code before it is required.
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+// MFAEnrollment is what confirming an authenticator hands back: the
+// recovery codes, shown only this once, and tokens if the user enrolled
+// while signing in.
+type MFAEnrollment struct {
+	*Tokens
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// RequireMFA makes users with any of the roles set up a second factor
+// before they can sign in.
+func (s *AuthService) RequireMFA(roles ...user.Role) {
+	s.mfaRoles = make(map[user.Role]bool)
+	for _, role := range roles {
+		s.mfaRoles[role] = true
+	}
+}
+
+func (s *AuthService) mfaRequired(u *ent.User) bool {
+	return s.mfaRoles[u.Role]
+}
+
+// beginSignIn signs the user in after their first factor, unless they
+// have a second factor or their role requires one; then it hands back an
+// mfa token instead. method is recorded with the sign-in.
+func (s *AuthService) beginSignIn(ctx context.Context, u *ent.User, method string) (*LoginResult, error) {
+	enabled := u.TotpEnabledAt != nil
+	if !enabled && !s.mfaRequired(u) {
+		tokens, err := s.signIn(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		s.recordLogin(ctx, u, method, "")
+		return &LoginResult{Tokens: tokens}, nil
+	}
+
+	// Signed for the MFA audience and without a user_id, so that neither
+	// PollApp nor services verifying its access tokens take it for one
+	mfaToken, err := s.keys.SignKind(jwtkeys.MFA, jwt.MapClaims{
+		"sub":    strconv.Itoa(u.ID),
+		"method": method,
+		"exp":    time.Now().Add(mfaTokenTTL).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{
+		MFARequired:           enabled,
+		MFAEnrollmentRequired: !enabled,
+		MFAToken:              mfaToken,
+	}, nil
+}
+
+func (s *AuthService) recordLogin(ctx context.Context, u *ent.User, method, mfa string) {
+	details := map[string]any{}
+	if method != "password" {
+		details["method"] = method
+	}
+	if mfa != "" {
+		details["mfa"] = mfa
+	}
+	if len(details) == 0 {
+		details = nil
+	}
+	s.audit.Record(ctx, audit.Login, u.ID, details)
+}
+
+// pendingMFA returns the user and sign-in method an mfa token was issued
+// for.
+func (s *AuthService) pendingMFA(ctx context.Context, mfaToken string) (*ent.User, string, error) {
+	token, err := s.keys.ParseKind(jwtkeys.MFA, mfaToken)
+	if err != nil {
+		return nil, "", ErrInvalidMFAToken
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, "", ErrInvalidMFAToken
+	}
+	subject, _ := claims["sub"].(string)
+	userID, err := strconv.Atoi(subject)
+	if err != nil {
+		return nil, "", ErrInvalidMFAToken
+	}
+	method, _ := claims["method"].(string)
+
+	u, err := s.client.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return nil, "", ErrInvalidMFAToken
+	} else if err != nil {
+		return nil, "", err
+	}
+	if u.BannedAt != nil {
+		return nil, "", ErrUserBanned
+	}
+	return u, method, nil
+}
+
+// PendingMFAUser returns the user an mfa token was issued for, so that
+// users required to have a second factor can enroll one while signing in.
+func (s *AuthService) PendingMFAUser(ctx context.Context, mfaToken string) (int, error) {
+	u, _, err := s.pendingMFA(ctx, mfaToken)
+	if err != nil {
+		return 0, err
+	}
+	return u.ID, nil
+}
+
+// VerifyMFA finishes signing in with the mfa token from Login and a code
+// from the user's authenticator or one of their recovery codes.
+func (s *AuthService) VerifyMFA(ctx context.Context, mfaToken, code string) (*Tokens, error) {
+	u, method, err := s.pendingMFA(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabledAt == nil {
+		return nil, ErrMFANotEnabled
+	}
+
+	factor, err := s.checkSecondFactor(ctx, u, code)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.signIn(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	s.recordLogin(ctx, u, method, factor)
+	return tokens, nil
+}
+
+// EnrollTOTP starts setting up an authenticator app for the user. Until a
+// code from it is confirmed, enrolling again replaces the secret.
+func (s *AuthService) EnrollTOTP(ctx context.Context, userID int) (*TOTPEnrollment, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabledAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := u.Update().SetTotpSecret(secret).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(totpIssuer, u.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP turns on the second factor enrolled with EnrollTOTP once the
+// user shows a code from it works, and returns their recovery codes.
+// Wrong codes count towards the same lockout as at sign-in.
+func (s *AuthService) ConfirmTOTP(ctx context.Context, userID int, code string) ([]string, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabledAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if u.TotpSecret == nil {
+		return nil, ErrMFANotEnabled
+	}
+	if mfaLocked(u) {
+		return nil, ErrMFALocked
+	}
+	step, ok := totp.Validate(*u.TotpSecret, normalizeCode(code), time.Now())
+	if !ok {
+		return nil, s.recordMFAFailure(ctx, u, "totp")
+	}
+
+	var codes []string
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		n, err := tx.User.Update().
+			Where(user.IDEQ(u.ID), user.TotpEnabledAtIsNil()).
+			SetTotpEnabledAt(time.Now()).
+			SetTotpLastStep(step).
+			SetTotpFailures(0).
+			ClearTotpFailedAt().
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrMFAAlreadyEnabled
+		}
+		codes, err = replaceRecoveryCodes(ctx, tx, u.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, audit.MFAEnabled, u.ID, nil)
+	return codes, nil
+}
+
+// FinishMFAEnrollment confirms the second factor of a user enrolling
+// while signing in, and completes the sign-in.
+func (s *AuthService) FinishMFAEnrollment(ctx context.Context, mfaToken, code string) (*MFAEnrollment, error) {
+	u, method, err := s.pendingMFA(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := s.ConfirmTOTP(ctx, u.ID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.signIn(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	s.recordLogin(ctx, u, method, "totp")
+	return &MFAEnrollment{Tokens: tokens, RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP turns off the user's second factor, given a current code.
+// Users whose role requires one can't.
+func (s *AuthService) DisableTOTP(ctx context.Context, userID int, code string) error {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.TotpEnabledAt == nil {
+		return ErrMFANotEnabled
+	}
+	if s.mfaRequired(u) {
+		return ErrMFARequired
+	}
+	if _, err := s.checkSecondFactor(ctx, u, code); err != nil {
+		return err
+	}
+
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := tx.User.UpdateOneID(u.ID).
+			ClearTotpSecret().
+			ClearTotpEnabledAt().
+			SetTotpLastStep(0).
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.RecoveryCode.Delete().
+			Where(recoverycode.UserIDEQ(u.ID)).
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, audit.MFADisabled, u.ID, nil)
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes, given a
+// current code.
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, userID int, code string) ([]string, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabledAt == nil {
+		return nil, ErrMFANotEnabled
+	}
+	if _, err := s.checkSecondFactor(ctx, u, code); err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		codes, err = replaceRecoveryCodes(ctx, tx, u.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, audit.RecoveryCodesRegenerated, u.ID, nil)
+	return codes, nil
+}
+
+// checkSecondFactor accepts a code from the user's authenticator, or one
+// of their unused recovery codes, and returns which it was. Each works
+// once, and wrong codes count towards a lockout.
+func (s *AuthService) checkSecondFactor(ctx context.Context, u *ent.User, code string) (string, error) {
+	if mfaLocked(u) {
+		return "", ErrMFALocked
+	}
+
+	code = normalizeCode(code)
+	factor := "totp"
+	ok := false
+	var err error
+	if len(code) == totp.Digits {
+		ok, err = s.useTOTPCode(ctx, u, code)
+	} else {
+		factor = "recovery_code"
+		ok, err = s.useRecoveryCode(ctx, u, code)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", s.recordMFAFailure(ctx, u, factor)
+	}
+
+	if u.TotpFailures != 0 {
+		if err := u.Update().SetTotpFailures(0).ClearTotpFailedAt().Exec(ctx); err != nil {
+			return "", err
+		}
+	}
+	return factor, nil
+}
+
+// mfaLocked reports whether the user made maxMFAFailures wrong codes in
+// a row, the last less than mfaLockout ago.
+func mfaLocked(u *ent.User) bool {
+	return u.TotpFailures >= maxMFAFailures && u.TotpFailedAt != nil && time.Since(*u.TotpFailedAt) < mfaLockout
+}
+
+// recordMFAFailure counts a wrong code towards the lockout and returns
+// ErrInvalidMFACode, or the error that kept it from being counted.
+func (s *AuthService) recordMFAFailure(ctx context.Context, u *ent.User, factor string) error {
+	failures := u.TotpFailures + 1
+	if u.TotpFailedAt != nil && time.Since(*u.TotpFailedAt) >= mfaLockout {
+		failures = 1
+	}
+	if err := u.Update().
+		SetTotpFailures(failures).
+		SetTotpFailedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, audit.MFAFailed, u.ID, map[string]any{"factor": factor, "failures": failures})
+	return ErrInvalidMFACode
+}
+
+// useTOTPCode checks an authenticator code, refusing codes from the step
+// of the last accepted one or earlier.
+func (s *AuthService) useTOTPCode(ctx context.Context, u *ent.User, code string) (bool, error) {
+	if u.TotpSecret == nil {
+		return false, nil
+	}
+	step, ok := totp.Validate(*u.TotpSecret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	// Of two concurrent uses of one code, only one wins
+	n, err := s.client.User.Update().
+		Where(user.IDEQ(u.ID), user.TotpLastStepLT(step)).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (s *AuthService) useRecoveryCode(ctx context.Context, u *ent.User, code string) (bool, error) {
+	n, err := s.client.RecoveryCode.Update().
+		Where(
+			recoverycode.UserIDEQ(u.ID),
+			recoverycode.CodeHashEQ(hashToken(code)),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	remaining, err := s.client.RecoveryCode.Query().
+		Where(recoverycode.UserIDEQ(u.ID), recoverycode.UsedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	s.audit.Record(ctx, audit.RecoveryCodeUsed, u.ID, map[string]any{"remaining": remaining})
+	return true, nil
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// replaceRecoveryCodes deletes the user's recovery codes and stores new
+// ones, returned formatted as xxxxx-xxxxx.
+func replaceRecoveryCodes(ctx context.Context, tx *ent.Tx, userID int) ([]string, error) {
+	if _, err := tx.RecoveryCode.Delete().
+		Where(recoverycode.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	creates := make([]*ent.RecoveryCodeCreate, recoveryCodeCount)
+	for i := range codes {
+		// 10 base32 characters, 50 random bits
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(b))[:10]
+		codes[i] = code[:5] + "-" + code[5:]
+		creates[i] = tx.RecoveryCode.Create().
+			SetUserID(userID).
+			SetCodeHash(hashToken(code))
+	}
+	if err := tx.RecoveryCode.CreateBulk(creates...).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// normalizeCode drops the spaces and dashes people type into codes.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/internal/totp"
+)
+
+const testPassword = "correct horse battery staple"
+
+// newMFAUser registers a user and turns on their authenticator with a code
+// from the step before the current one, leaving the current step's code
+// unused.
+func newMFAUser(t *testing.T, s *AuthService) (*ent.User, string) {
+	t.Helper()
+	ctx := context.Background()
+	u, err := s.Register(ctx, "dave", "dave@example.com", testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err := s.EnrollTOTP(ctx, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ConfirmTOTP(ctx, u.ID, code(t, enrollment.Secret, totp.Step(time.Now())-1)); err != nil {
+		t.Fatal(err)
+	}
+	return u, enrollment.Secret
+}
+
+func code(t *testing.T, secret string, step int64) string {
+	t.Helper()
+	c, err := totp.Code(secret, step)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestMFAToken(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	_, secret := newMFAUser(t, s)
+
+	result, err := s.Login(ctx, "dave@example.com", testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.MFARequired || result.MFAToken == "" || result.Tokens != nil {
+		t.Fatalf("login result = %+v, want only an mfa token", result)
+	}
+
+	// A password alone must not pass for a signed-in user
+	if _, err := s.ValidateToken(ctx, result.MFAToken); err == nil {
+		t.Error("mfa token accepted as an access token")
+	}
+	if _, err := s.keys.Parse(result.MFAToken); err == nil {
+		t.Error("mfa token verifies as an access token")
+	}
+
+	tokens, err := s.VerifyMFA(ctx, result.MFAToken, code(t, secret, totp.Step(time.Now())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ValidateToken(ctx, tokens.AccessToken); err != nil {
+		t.Errorf("access token after the second factor: %v", err)
+	}
+	if _, err := s.VerifyMFA(ctx, tokens.AccessToken, code(t, secret, totp.Step(time.Now())+1)); !errors.Is(err, ErrInvalidMFAToken) {
+		t.Errorf("access token as mfa token: error %v, want %v", err, ErrInvalidMFAToken)
+	}
+}
+
+func TestVerifyMFAReplay(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	_, secret := newMFAUser(t, s)
+	now := totp.Step(time.Now())
+
+	tests := []struct {
+		name    string
+		step    int64
+		wantErr error
+	}{
+		{name: "unused code", step: now},
+		{name: "same code again", step: now, wantErr: ErrInvalidMFACode},
+		{name: "code from before the last used one", step: now - 1, wantErr: ErrInvalidMFACode},
+		{name: "code from the next step", step: now + 1},
+		{name: "code from the next step again", step: now + 1, wantErr: ErrInvalidMFACode},
+	}
+	for _, tt := range tests {
+		result, err := s.Login(ctx, "dave@example.com", testPassword)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.VerifyMFA(ctx, result.MFAToken, code(t, secret, tt.step))
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestConfirmTOTPLockout(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	u, err := s.Register(ctx, "dave", "dave@example.com", testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err := s.EnrollTOTP(ctx, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	right := code(t, enrollment.Secret, totp.Step(time.Now()))
+	wrong := "000000"
+	if wrong == right {
+		wrong = "000001"
+	}
+
+	for i := 0; i < maxMFAFailures; i++ {
+		if _, err := s.ConfirmTOTP(ctx, u.ID, wrong); !errors.Is(err, ErrInvalidMFACode) {
+			t.Fatalf("wrong code %d: error %v, want %v", i+1, err, ErrInvalidMFACode)
+		}
+	}
+	if _, err := s.ConfirmTOTP(ctx, u.ID, right); !errors.Is(err, ErrMFALocked) {
+		t.Errorf("right code after %d wrong ones: error %v, want %v", maxMFAFailures, err, ErrMFALocked)
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/user"
+	"pollapp/backend/ent/useridentity"
+	"pollapp/backend/internal/audit"
+	"pollapp/backend/internal/jwtkeys"
+	"pollapp/backend/internal/oidc"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// oidcStateTTL is how long a user has to sign in at the provider.
+const oidcStateTTL = 10 * time.Minute
+
+var (
+	// ErrOIDCDisabled is returned when no OpenID provider is configured.
+	ErrOIDCDisabled = errors.New("single sign-on is not configured")
+	// ErrOIDCState is returned when a provider callback doesn't match a
+	// sign-in this browser started.
+	ErrOIDCState = errors.New("single sign-on request expired or didn't come from this browser; try again")
+	// ErrOIDCEmailTaken is returned when the provider's email belongs to
+	// an existing account but the provider hasn't verified it, so the
+	// accounts can't safely be linked.
+	ErrOIDCEmailTaken = errors.New("an account with this email already exists; sign in with your password")
+)
+
+// EnableOIDC lets users sign in through the OpenID provider.
+func (s *AuthService) EnableOIDC(provider *oidc.Provider) {
+	s.oidc = provider
+}
+
+// StartOIDC begins a sign-in at the provider. It returns the URL to send
+// the browser to, and a signed state token holding the PKCE verifier,
+// state and nonce, which the browser keeps in a cookie until it comes back
+// to FinishOIDC.
+func (s *AuthService) StartOIDC(ctx context.Context) (string, string, error) {
+	if s.oidc == nil {
+		return "", "", ErrOIDCDisabled
+	}
+
+	var secrets [3]string
+	for i := range secrets {
+		v, err := oidc.NewVerifier()
+		if err != nil {
+			return "", "", err
+		}
+		secrets[i] = v
+	}
+	state, nonce, verifier := secrets[0], secrets[1], secrets[2]
+
+	authURL, err := s.oidc.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", "", err
+	}
+	stateToken, err := s.keys.SignKind(jwtkeys.OIDCState, jwt.MapClaims{
+		"state":    state,
+		"nonce":    nonce,
+		"verifier": verifier,
+		"exp":      time.Now().Add(oidcStateTTL).Unix(),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return authURL, stateToken, nil
+}
+
+// FinishOIDC completes a sign-in: it checks that the callback's state
+// matches the browser's state token, redeems the code, finds or creates
+// the user and signs them in like Login.
+//
+// Users are found by the provider's subject. The first time a subject
+// signs in, it is linked to the account with the same email if the
+// provider verified that email; otherwise a new account is created.
+func (s *AuthService) FinishOIDC(ctx context.Context, stateToken, state, code string) (*LoginResult, error) {
+	if s.oidc == nil {
+		return nil, ErrOIDCDisabled
+	}
+
+	token, err := s.keys.ParseKind(jwtkeys.OIDCState, stateToken)
+	if err != nil {
+		return nil, ErrOIDCState
+	}
+	flow, ok := token.Claims.(jwt.MapClaims)
+	if !ok || flow["state"] != state || state == "" {
+		return nil, ErrOIDCState
+	}
+	nonce, _ := flow["nonce"].(string)
+	verifier, _ := flow["verifier"].(string)
+
+	claims, err := s.oidc.Exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.oidcUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if u.BannedAt != nil {
+		s.audit.Record(ctx, audit.LoginFailed, u.ID, map[string]any{"method": "oidc", "reason": "banned"})
+		return nil, ErrUserBanned
+	}
+
+	return s.beginSignIn(ctx, u, "oidc")
+}
+
+// oidcUser returns the user the provider's subject is linked to, linking
+// or creating one on their first sign-in.
+func (s *AuthService) oidcUser(ctx context.Context, c *oidc.Claims) (*ent.User, error) {
+	identity, err := s.client.UserIdentity.Query().
+		Where(useridentity.IssuerEQ(c.Issuer), useridentity.SubjectEQ(c.Subject)).
+		WithUser().
+		Only(ctx)
+	if err == nil {
+		if err := identity.Update().
+			SetEmail(c.Email).
+			SetLastLoginAt(time.Now()).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		return identity.Edges.User, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if c.Email == "" {
+		return nil, errors.New("the identity provider didn't share an email address")
+	}
+
+	var u *ent.User
+	created := false
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		existing, err := tx.User.Query().
+			Where(user.EmailEQ(c.Email)).
+			Only(ctx)
+		switch {
+		case err == nil && c.EmailVerified:
+			u = existing
+			if u.EmailVerifiedAt == nil {
+				if err := u.Update().SetEmailVerifiedAt(time.Now()).Exec(ctx); err != nil {
+					return err
+				}
+			}
+		case err == nil:
+			return ErrOIDCEmailTaken
+		case ent.IsNotFound(err):
+			u, err = provisionUser(ctx, tx, c)
+			if err != nil {
+				return err
+			}
+			created = true
+		default:
+			return err
+		}
+
+		return tx.UserIdentity.Create().
+			SetUserID(u.ID).
+			SetIssuer(c.Issuer).
+			SetSubject(c.Subject).
+			SetEmail(c.Email).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if created {
+		s.audit.Record(ctx, audit.UserRegistered, u.ID, map[string]any{
+			"username": u.Username,
+			"email":    u.Email,
+			"method":   "oidc",
+		})
+	}
+	return u, nil
+}
+
+var usernameUnsafe = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// provisionUser creates an account for someone signing in through the
+// provider for the first time. It has no usable password; the user signs
+// in through the provider.
+func provisionUser(ctx context.Context, tx *ent.Tx, c *oidc.Claims) (*ent.User, error) {
+	base := c.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(c.Email, "@")
+	}
+	base = strings.Trim(usernameUnsafe.ReplaceAllString(base, "_"), "_")
+	if base == "" {
+		base = "user"
+	}
+
+	username := base
+	for i := 2; ; i++ {
+		taken, err := tx.User.Query().
+			Where(user.UsernameEQ(username)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !taken {
+			break
+		}
+		username = fmt.Sprintf("%s%d", base, i)
+	}
+
+	password, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.User.Create().
+		SetUsername(username).
+		SetEmail(c.Email).
+		SetPasswordHash(string(hashedPassword))
+	if c.EmailVerified {
+		create.SetEmailVerifiedAt(time.Now())
+	}
+	return create.Save(ctx)
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/enttest"
+	"pollapp/backend/ent/user"
+	"pollapp/backend/ent/useridentity"
+	"pollapp/backend/internal/jwtkeys"
+	"pollapp/backend/internal/mail"
+	"pollapp/backend/internal/oidc"
+	"pollapp/backend/internal/oidc/oidctest"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+var testDBs atomic.Int64
+
+// newTestClient returns a client for a fresh in-memory database with the
+// schema created.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:test%d?mode=memory&cache=shared&_pragma=foreign_keys(1)", testDBs.Add(1))
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// newTestAuthService returns an AuthService over a fresh database.
+func newTestAuthService(t *testing.T) *AuthService {
+	t.Helper()
+	keys, err := jwtkeys.Load(jwtkeys.Config{
+		Secret:   "test-secret-at-least-32-characters-long",
+		Issuer:   "pollapp",
+		Audience: "pollapp",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewAuthService(newTestClient(t), keys)
+	s.UseMailer(&mail.FileMailer{Dir: t.TempDir()}, "http://localhost:3000")
+	return s
+}
+
+// newOIDCService returns an AuthService signing in through a mock
+// provider, which the caller tells who to sign in.
+func newOIDCService(t *testing.T) (*AuthService, *oidctest.Provider) {
+	t.Helper()
+	srv, idp, err := oidctest.NewServer("pollapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+
+	s := newTestAuthService(t)
+	s.EnableOIDC(oidc.New(oidc.Config{
+		Issuer:      idp.Issuer(),
+		ClientID:    "pollapp",
+		RedirectURL: "http://localhost:8080/api/auth/oidc/callback",
+	}))
+	return s, idp
+}
+
+// startOIDC starts a sign-in and follows the browser to the provider and
+// back, returning the state token and the callback's state and code.
+func startOIDC(t *testing.T, s *AuthService) (stateToken, state, code string) {
+	t.Helper()
+	authURL, stateToken, err := s.StartOIDC(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	resp, err := browser.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	back, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stateToken, back.Query().Get("state"), back.Query().Get("code")
+}
+
+func TestFinishOIDC(t *testing.T) {
+	tests := []struct {
+		name string
+		// existing is the email of an account registered beforehand
+		existing string
+		email    string
+		verified bool
+		wantErr  error
+		// wantLinked means the existing account signs in
+		wantLinked   bool
+		wantUsername string
+	}{
+		{name: "new user", email: "carol@example.com", verified: true, wantUsername: "carol"},
+		{name: "new user with unverified email", email: "carol@example.com", wantUsername: "carol"},
+		{name: "new user with taken username", existing: "carol@example.org", email: "carol@example.com", verified: true, wantUsername: "carol2"},
+		{name: "links existing user by verified email", existing: "carol@example.com", email: "carol@example.com", verified: true, wantLinked: true, wantUsername: "carol"},
+		{name: "refuses existing user with unverified email", existing: "carol@example.com", email: "carol@example.com", wantErr: ErrOIDCEmailTaken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, idp := newOIDCService(t)
+			var existing *ent.User
+			if tt.existing != "" {
+				var err error
+				existing, err = s.Register(ctx, "carol", tt.existing, "correct horse battery staple")
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			idp.SignInAs(tt.email, tt.verified)
+
+			stateToken, state, code := startOIDC(t, s)
+			result, err := s.FinishOIDC(ctx, stateToken, state, code)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("FinishOIDC error = %v, want %v", err, tt.wantErr)
+				}
+				if n := s.client.UserIdentity.Query().CountX(ctx); n != 0 {
+					t.Errorf("%d identities linked, want none", n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Tokens == nil || result.AccessToken == "" {
+				t.Fatalf("result = %+v, want tokens", result)
+			}
+
+			identity := s.client.UserIdentity.Query().
+				Where(useridentity.EmailEQ(tt.email)).
+				WithUser().
+				OnlyX(ctx)
+			u := identity.Edges.User
+			if tt.wantLinked != (existing != nil && u.ID == existing.ID) {
+				t.Errorf("signed in user %d, existing user %v, want linked %v", u.ID, existing, tt.wantLinked)
+			}
+			if u.Username != tt.wantUsername || u.Email != tt.email {
+				t.Errorf("user = %s <%s>, want %s <%s>", u.Username, u.Email, tt.wantUsername, tt.email)
+			}
+			if (u.EmailVerifiedAt != nil) != tt.verified {
+				t.Errorf("email verified = %v, want %v", u.EmailVerifiedAt != nil, tt.verified)
+			}
+		})
+	}
+}
+
+func TestFinishOIDCReturningUser(t *testing.T) {
+	ctx := context.Background()
+	s, idp := newOIDCService(t)
+	idp.SignInAs("carol@example.com", true)
+
+	for i := 0; i < 2; i++ {
+		stateToken, state, code := startOIDC(t, s)
+		if _, err := s.FinishOIDC(ctx, stateToken, state, code); err != nil {
+			t.Fatalf("sign-in %d: %v", i+1, err)
+		}
+	}
+	if n := s.client.User.Query().Where(user.EmailEQ("carol@example.com")).CountX(ctx); n != 1 {
+		t.Errorf("%d users, want 1", n)
+	}
+	if n := s.client.UserIdentity.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d identities, want 1", n)
+	}
+}
+
+func TestFinishOIDCState(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newOIDCService(t)
+	otherToken, _, _ := startOIDC(t, s)
+
+	tests := []struct {
+		name  string
+		token func(stateToken string) string
+		state func(state string) string
+	}{
+		{
+			name:  "state mismatch",
+			token: func(stateToken string) string { return stateToken },
+			state: func(string) string { return "other-state" },
+		},
+		{
+			name:  "no state",
+			token: func(stateToken string) string { return stateToken },
+			state: func(string) string { return "" },
+		},
+		{
+			name:  "state token of another sign-in",
+			token: func(string) string { return otherToken },
+			state: func(state string) string { return state },
+		},
+		{
+			name:  "no state token",
+			token: func(string) string { return "" },
+			state: func(state string) string { return state },
+		},
+		{
+			name:  "tampered state token",
+			token: func(stateToken string) string { return stateToken + "x" },
+			state: func(state string) string { return state },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateToken, state, code := startOIDC(t, s)
+			_, err := s.FinishOIDC(ctx, tt.token(stateToken), tt.state(state), code)
+			if !errors.Is(err, ErrOIDCState) {
+				t.Errorf("FinishOIDC error = %v, want %v", err, ErrOIDCState)
+			}
+		})
+	}
+	if n := s.client.User.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d users created, want none", n)
+	}
+}
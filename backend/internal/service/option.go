@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/anonymousballot"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/ballotentry"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/vote"
+)
+
+var (
+	// ErrOptionsLocked is returned when a poll's options can't be changed
+	// in the requested way.
+	ErrOptionsLocked = errors.New("poll options can't be changed")
+	// ErrPollChanged is returned when a ballot was filled in against an
+	// older revision of the poll than the current one.
+	ErrPollChanged = errors.New("poll has changed since it was loaded; reload it and vote again")
+)
+
+// AddOption appends an option to the poll. Ranked, score and STAR polls
+// only take new options until the first ballot, since earlier ballots
+// couldn't rank or score them.
+func (s *PollService) AddOption(ctx context.Context, id, userID int, text string) (*ent.Poll, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.New("option text is required")
+	}
+
+	p, err := s.editableOptions(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		// Checked in the transaction that adds the option, so a ballot
+		// cast since the poll was loaded still locks the options
+		if usesBallots(p) {
+			voted, err := tx.Ballot.Query().
+				Where(ballot.PollIDEQ(p.ID)).
+				Exist(ctx)
+			if err == nil && !voted && p.Anonymous {
+				voted, err = tx.AnonymousBallot.Query().
+					Where(anonymousballot.PollIDEQ(p.ID)).
+					Exist(ctx)
+			}
+			if err != nil {
+				return err
+			}
+			if voted {
+				return fmt.Errorf("%w: ballots already rank or score every option", ErrOptionsLocked)
+			}
+		}
+
+		options, err := tx.PollOption.Query().
+			Where(polloption.PollIDEQ(p.ID)).
+			Order(ent.Asc(polloption.FieldOrder), ent.Asc(polloption.FieldID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		order := 0
+		if len(options) > 0 {
+			order = options[len(options)-1].Order + 1
+		}
+		if _, err := tx.PollOption.Create().
+			SetPollID(p.ID).
+			SetOptionText(text).
+			SetOrder(order).
+			Save(ctx); err != nil {
+			return err
+		}
+
+		return bumpRevision(ctx, tx, p, len(options)+1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPoll(ctx, id)
+}
+
+// RenameOption changes an option's text. Votes for it are kept.
+func (s *PollService) RenameOption(ctx context.Context, id, userID, optionID int, text string) (*ent.Poll, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.New("option text is required")
+	}
+
+	p, err := s.editableOptions(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		o, err := tx.PollOption.Query().
+			Where(polloption.IDEQ(optionID), polloption.PollIDEQ(p.ID)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if err := o.Update().
+			SetOptionText(text).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return bumpRevision(ctx, tx, p, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPoll(ctx, id)
+}
+
+// ReorderOptions puts the poll's options in the order given, which must
+// list every option exactly once.
+func (s *PollService) ReorderOptions(ctx context.Context, id, userID int, optionIDs []int) (*ent.Poll, error) {
+	p, err := s.editableOptions(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		current, err := tx.PollOption.Query().
+			Where(polloption.PollIDEQ(p.ID)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		valid := make(map[int]bool, len(current))
+		for _, optionID := range current {
+			valid[optionID] = true
+		}
+		if len(optionIDs) != len(current) {
+			return fmt.Errorf("list all %d options", len(current))
+		}
+		for _, optionID := range optionIDs {
+			if !valid[optionID] {
+				return fmt.Errorf("option %d is missing, repeated or not on this poll", optionID)
+			}
+			valid[optionID] = false
+		}
+
+		for i, optionID := range optionIDs {
+			if err := tx.PollOption.UpdateOneID(optionID).
+				SetOrder(i).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return bumpRevision(ctx, tx, p, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPoll(ctx, id)
+}
+
+// RemoveOption deletes an option nobody has voted for. Polls keep at least
+// two options, and min_choices and max_choices shrink with the options if
+// needed.
+func (s *PollService) RemoveOption(ctx context.Context, id, userID, optionID int) (*ent.Poll, error) {
+	p, err := s.editableOptions(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		o, err := tx.PollOption.Query().
+			Where(polloption.IDEQ(optionID), polloption.PollIDEQ(p.ID)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		voted, err := optionHasVotes(ctx, tx, p, o.ID)
+		if err != nil {
+			return err
+		}
+		if voted {
+			return fmt.Errorf("%w: option %q has votes", ErrOptionsLocked, o.OptionText)
+		}
+
+		count, err := tx.PollOption.Query().
+			Where(polloption.PollIDEQ(p.ID)).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count <= 2 {
+			return fmt.Errorf("%w: a poll must have at least 2 options", ErrOptionsLocked)
+		}
+
+		if err := tx.PollOption.DeleteOne(o).Exec(ctx); err != nil {
+			return err
+		}
+		return bumpRevision(ctx, tx, p, count-1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPoll(ctx, id)
+}
+
+// editableOptions loads a poll whose options the user may change. Proposal
+// polls keep their fixed options, and closed polls keep the options their
+// results were counted on.
+func (s *PollService) editableOptions(ctx context.Context, id, userID int) (*ent.Poll, error) {
+	p, err := s.pollWithRight(ctx, id, userID, rightEdit)
+	if err != nil {
+		return nil, err
+	}
+	if p.VotingMethod == poll.VotingMethodProposal {
+		return nil, fmt.Errorf("%w: proposal polls always offer Yes, No and Abstain", ErrOptionsLocked)
+	}
+	if status := PollStatus(p, time.Now()); status == PollStatusClosed || status == PollStatusArchived {
+		return nil, fmt.Errorf("%w: poll is %s", ErrOptionsLocked, status)
+	}
+	return p, nil
+}
+
+// optionHasVotes reports whether any ballot on the poll chose or ranked
+// the option.
+func optionHasVotes(ctx context.Context, tx *ent.Tx, p *ent.Poll, optionID int) (bool, error) {
+	if p.Anonymous {
+		ballots, err := tx.AnonymousBallot.Query().
+			Where(anonymousballot.PollIDEQ(p.ID)).
+			All(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, b := range ballots {
+			if _, scored := b.Scores[optionID]; scored {
+				return true, nil
+			}
+			for _, choice := range b.Choices {
+				if choice == optionID {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+	if usesBallots(p) {
+		return tx.BallotEntry.Query().
+			Where(ballotentry.PollOptionIDEQ(optionID)).
+			Exist(ctx)
+	}
+	return tx.Vote.Query().
+		Where(vote.PollOptionIDEQ(optionID)).
+		Exist(ctx)
+}
+
+// bumpRevision records that the poll changed. If optionCount is not 0 the
+// number of options changed to it: approval polls keep letting voters
+// approve of every option, and other polls cap min_choices and max_choices
+// at the number of options.
+func bumpRevision(ctx context.Context, tx *ent.Tx, p *ent.Poll, optionCount int) error {
+	update := tx.Poll.UpdateOneID(p.ID).AddRevision(1)
+	if optionCount != 0 {
+		switch {
+		case p.VotingMethod == poll.VotingMethodApproval:
+			update.SetMaxChoices(optionCount)
+		case p.MaxChoices > optionCount:
+			update.SetMaxChoices(optionCount)
+			if p.MinChoices > optionCount {
+				update.SetMinChoices(optionCount)
+			}
+		}
+	}
+	return update.Exec(ctx)
+}
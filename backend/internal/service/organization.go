@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/organization"
+	"pollapp/backend/ent/organizationmember"
+)
+
+var (
+	// ErrNotOrgMember is returned when a user acts on an organization they
+	// don't belong to.
+	ErrNotOrgMember = errors.New("not a member of this organization")
+	// ErrLastOwner is returned when a change would leave an organization
+	// without an owner.
+	ErrLastOwner = errors.New("an organization must keep at least one owner")
+	// ErrNoInvitation is returned when a user accepts an invitation they
+	// don't have.
+	ErrNoInvitation = errors.New("no pending invitation to this organization")
+)
+
+type OrgService struct {
+	client *ent.Client
+}
+
+// Organization is an organization as seen by one of its members, or by a
+// user invited to it.
+type Organization struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	Pending   bool      `json:"pending,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrgMember is a user's membership in an organization, or their
+// invitation to it. Email is only shown to owners and admins, and only
+// once the user has accepted.
+type OrgMember struct {
+	UserID   int       `json:"user_id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email,omitempty"`
+	Role     string    `json:"role"`
+	Pending  bool      `json:"pending,omitempty"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+func NewOrgService(client *ent.Client) *OrgService {
+	return &OrgService{client: client}
+}
+
+// CreateOrganization creates an organization owned by the user.
+func (s *OrgService) CreateOrganization(ctx context.Context, userID int, name string) (*Organization, error) {
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	var org *ent.Organization
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		org, err = tx.Organization.Create().
+			SetName(name).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.OrganizationMember.Create().
+			SetOrganizationID(org.ID).
+			SetUserID(userID).
+			SetRole(organizationmember.RoleOwner).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Organization{
+		ID:        org.ID,
+		Name:      org.Name,
+		Role:      organizationmember.RoleOwner.String(),
+		CreatedAt: org.CreatedAt,
+	}, nil
+}
+
+// ListOrganizations returns the organizations the user belongs to or is
+// invited to, in the order they joined or were invited.
+func (s *OrgService) ListOrganizations(ctx context.Context, userID int) ([]Organization, error) {
+	rows, err := s.client.OrganizationMember.Query().
+		Where(organizationmember.UserIDEQ(userID)).
+		WithOrganization().
+		Order(ent.Asc(organizationmember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]Organization, len(rows))
+	for i, row := range rows {
+		orgs[i] = Organization{
+			ID:        row.OrganizationID,
+			Name:      row.Edges.Organization.Name,
+			Role:      row.Role.String(),
+			Pending:   row.Pending,
+			CreatedAt: row.Edges.Organization.CreatedAt,
+		}
+	}
+	return orgs, nil
+}
+
+// ListMembers returns the organization's members to one of them. Owners
+// and admins also see pending invitations, and members' emails.
+func (s *OrgService) ListMembers(ctx context.Context, orgID, userID int) ([]OrgMember, error) {
+	role, err := s.role(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	manager := role != organizationmember.RoleMember
+
+	query := s.client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(orgID))
+	if !manager {
+		query.Where(organizationmember.PendingEQ(false))
+	}
+	rows, err := query.
+		WithUser().
+		Order(ent.Asc(organizationmember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]OrgMember, len(rows))
+	for i, row := range rows {
+		members[i] = orgMember(row, row.Edges.User, manager)
+	}
+	return members, nil
+}
+
+// SetMember invites the user with the given username or email to the
+// organization, or changes their role if they already belong or are
+// invited to it. Owners and admins manage members; only owners grant or
+// take away ownership. Invited users join once they accept.
+func (s *OrgService) SetMember(ctx context.Context, orgID, userID int, who, role string) (*OrgMember, error) {
+	r := organizationmember.RoleMember
+	if role != "" {
+		r = organizationmember.Role(role)
+	}
+	if err := organizationmember.RoleValidator(r); err != nil {
+		return nil, fmt.Errorf("unknown role %q", role)
+	}
+
+	actorRole, err := s.role(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := findUser(ctx, s.client, who)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *ent.OrganizationMember
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		existing, err := tx.OrganizationMember.Query().
+			Where(
+				organizationmember.OrganizationIDEQ(orgID),
+				organizationmember.UserIDEQ(u.ID),
+			).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if err := checkRoleChange(actorRole, existing, r); err != nil {
+			return err
+		}
+
+		if existing == nil {
+			m, err = tx.OrganizationMember.Create().
+				SetOrganizationID(orgID).
+				SetUserID(u.ID).
+				SetRole(r).
+				SetPending(true).
+				Save(ctx)
+			return err
+		}
+
+		if existing.Role == organizationmember.RoleOwner && r != organizationmember.RoleOwner && !existing.Pending {
+			if err := checkOtherOwner(ctx, tx, orgID, u.ID); err != nil {
+				return err
+			}
+		}
+		m, err = existing.Update().
+			SetRole(r).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	member := orgMember(m, u, true)
+	return &member, nil
+}
+
+// AcceptInvitation makes the user a member of an organization they were
+// invited to.
+func (s *OrgService) AcceptInvitation(ctx context.Context, orgID, userID int) (*Organization, error) {
+	n, err := s.client.OrganizationMember.Update().
+		Where(
+			organizationmember.OrganizationIDEQ(orgID),
+			organizationmember.UserIDEQ(userID),
+			organizationmember.PendingEQ(true),
+		).
+		SetPending(false).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrNoInvitation
+	}
+
+	m, err := s.client.OrganizationMember.Query().
+		Where(
+			organizationmember.OrganizationIDEQ(orgID),
+			organizationmember.UserIDEQ(userID),
+		).
+		WithOrganization().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Organization{
+		ID:        m.OrganizationID,
+		Name:      m.Edges.Organization.Name,
+		Role:      m.Role.String(),
+		CreatedAt: m.Edges.Organization.CreatedAt,
+	}, nil
+}
+
+// RemoveMember takes the user with the given username or email out of the
+// organization, or withdraws their invitation. Members may always leave
+// and invited users decline; removing others takes an owner or admin, and
+// removing an owner takes an owner.
+func (s *OrgService) RemoveMember(ctx context.Context, orgID, userID int, who string) error {
+	u, err := findUser(ctx, s.client, who)
+	if err != nil {
+		return err
+	}
+	var actorRole organizationmember.Role
+	if u.ID != userID {
+		if actorRole, err = s.role(ctx, orgID, userID); err != nil {
+			return err
+		}
+	}
+
+	return withTx(ctx, s.client, func(tx *ent.Tx) error {
+		m, err := tx.OrganizationMember.Query().
+			Where(
+				organizationmember.OrganizationIDEQ(orgID),
+				organizationmember.UserIDEQ(u.ID),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return ErrNotOrgMember
+		}
+		if err != nil {
+			return err
+		}
+
+		if u.ID != userID {
+			if err := checkRoleChange(actorRole, m, organizationmember.RoleMember); err != nil {
+				return err
+			}
+		}
+		if m.Role == organizationmember.RoleOwner && !m.Pending {
+			if err := checkOtherOwner(ctx, tx, orgID, u.ID); err != nil {
+				return err
+			}
+		}
+
+		return tx.OrganizationMember.DeleteOne(m).Exec(ctx)
+	})
+}
+
+// role returns the user's role in the organization. Invited users who
+// haven't accepted have none.
+func (s *OrgService) role(ctx context.Context, orgID, userID int) (organizationmember.Role, error) {
+	if _, err := s.client.Organization.Query().
+		Where(organization.IDEQ(orgID)).
+		Only(ctx); err != nil {
+		return "", err
+	}
+
+	m, err := s.client.OrganizationMember.Query().
+		Where(
+			organizationmember.OrganizationIDEQ(orgID),
+			organizationmember.UserIDEQ(userID),
+			organizationmember.PendingEQ(false),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", ErrNotOrgMember
+	}
+	if err != nil {
+		return "", err
+	}
+	return m.Role, nil
+}
+
+// checkRoleChange checks that a member with actorRole may give role to the
+// member m, or add them with it if m is nil.
+func checkRoleChange(actorRole organizationmember.Role, m *ent.OrganizationMember, role organizationmember.Role) error {
+	switch actorRole {
+	case organizationmember.RoleOwner:
+		return nil
+	case organizationmember.RoleAdmin:
+		if role == organizationmember.RoleOwner || (m != nil && m.Role == organizationmember.RoleOwner) {
+			return ErrUnauthorized
+		}
+		return nil
+	default:
+		return ErrUnauthorized
+	}
+}
+
+// checkOtherOwner fails if userID is the organization's only owner.
+func checkOtherOwner(ctx context.Context, tx *ent.Tx, orgID, userID int) error {
+	others, err := tx.OrganizationMember.Query().
+		Where(
+			organizationmember.OrganizationIDEQ(orgID),
+			organizationmember.RoleEQ(organizationmember.RoleOwner),
+			organizationmember.PendingEQ(false),
+			organizationmember.UserIDNEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !others {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// isOrgMember reports whether the user belongs to the organization,
+// having accepted their invitation.
+func isOrgMember(ctx context.Context, client *ent.Client, orgID, userID int) (bool, error) {
+	return client.OrganizationMember.Query().
+		Where(
+			organizationmember.OrganizationIDEQ(orgID),
+			organizationmember.UserIDEQ(userID),
+			organizationmember.PendingEQ(false),
+		).
+		Exist(ctx)
+}
+
+// orgMember shows the membership, with the user's email if showEmail is
+// set and they have accepted.
+func orgMember(m *ent.OrganizationMember, u *ent.User, showEmail bool) OrgMember {
+	member := OrgMember{
+		UserID:   u.ID,
+		Username: u.Username,
+		Role:     m.Role.String(),
+		Pending:  m.Pending,
+		JoinedAt: m.CreatedAt,
+	}
+	if showEmail && !m.Pending {
+		member.Email = u.Email
+	}
+	return member
+}
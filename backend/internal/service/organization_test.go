@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"pollapp/backend/ent"
+)
+
+func TestOrgInvitation(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	s := NewOrgService(client)
+	users := map[string]*ent.User{}
+	for _, name := range []string{"owner", "member", "invitee"} {
+		users[name] = client.User.Create().
+			SetUsername(name).
+			SetEmail(name + "@example.com").
+			SetPasswordHash("x").
+			SaveX(ctx)
+	}
+	owner, member, invitee := users["owner"].ID, users["member"].ID, users["invitee"].ID
+
+	org, err := s.CreateOrganization(ctx, owner, "Platform Team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"member", "invitee"} {
+		m, err := s.SetMember(ctx, org.ID, owner, name, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !m.Pending || m.Email != "" {
+			t.Errorf("new member %s = %+v, want pending without email", name, m)
+		}
+	}
+	if _, err := s.AcceptInvitation(ctx, org.ID, member); err != nil {
+		t.Fatal(err)
+	}
+
+	// Until they accept, the invitee has no access
+	if _, err := s.ListMembers(ctx, org.ID, invitee); !errors.Is(err, ErrNotOrgMember) {
+		t.Errorf("invitee listing members: error %v, want %v", err, ErrNotOrgMember)
+	}
+	if ok, err := isOrgMember(ctx, client, org.ID, invitee); err != nil || ok {
+		t.Errorf("invitee is a member: %v, %v", ok, err)
+	}
+	orgs, err := s.ListOrganizations(ctx, invitee)
+	if err != nil || len(orgs) != 1 || !orgs[0].Pending {
+		t.Errorf("invitee's organizations = %+v, %v, want one pending", orgs, err)
+	}
+
+	emails := func(viewer int) map[string]string {
+		t.Helper()
+		members, err := s.ListMembers(ctx, org.ID, viewer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string]string{}
+		for _, m := range members {
+			got[m.Username] = m.Email
+		}
+		return got
+	}
+	if got, want := emails(owner), map[string]string{
+		"owner":   "owner@example.com",
+		"member":  "member@example.com",
+		"invitee": "",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("members shown to the owner = %v, want %v", got, want)
+	}
+	if got, want := emails(member), map[string]string{"owner": "", "member": ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("members shown to a member = %v, want %v", got, want)
+	}
+
+	if _, err := s.AcceptInvitation(ctx, org.ID, invitee); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AcceptInvitation(ctx, org.ID, invitee); !errors.Is(err, ErrNoInvitation) {
+		t.Errorf("accepting twice: error %v, want %v", err, ErrNoInvitation)
+	}
+	if ok, err := isOrgMember(ctx, client, org.ID, invitee); err != nil || !ok {
+		t.Errorf("invitee is a member after accepting: %v, %v", ok, err)
+	}
+}
+
+func TestOrgInvitationDecline(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	s := NewOrgService(client)
+	owner := client.User.Create().SetUsername("owner").SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	invitee := client.User.Create().SetUsername("invitee").SetEmail("invitee@example.com").SetPasswordHash("x").SaveX(ctx)
+
+	org, err := s.CreateOrganization(ctx, owner.ID, "Platform Team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SetMember(ctx, org.ID, owner.ID, "invitee", "owner"); err != nil {
+		t.Fatal(err)
+	}
+	// A pending owner doesn't count towards keeping one
+	if _, err := s.SetMember(ctx, org.ID, owner.ID, "owner", "member"); !errors.Is(err, ErrLastOwner) {
+		t.Errorf("demoting the only owner who accepted: error %v, want %v", err, ErrLastOwner)
+	}
+
+	if err := s.RemoveMember(ctx, org.ID, invitee.ID, "invitee"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AcceptInvitation(ctx, org.ID, invitee.ID); !errors.Is(err, ErrNoInvitation) {
+		t.Errorf("accepting a declined invitation: error %v, want %v", err, ErrNoInvitation)
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/user"
+)
+
+// ErrUserBanned is returned when a banned user tries to sign in or use a
+// token issued before the ban.
+var ErrUserBanned = errors.New("account is banned")
+
+// Permission is something a site-wide role allows beyond what every user
+// may do.
+type Permission string
+
+const (
+	// PermManageAnyPoll lets a user edit, close, reopen, archive and
+	// delete polls they didn't create.
+	PermManageAnyPoll Permission = "polls:manage_any"
+	// PermBanUsers lets a user ban and unban other users.
+	PermBanUsers Permission = "users:ban"
+	// PermManageRoles lets a user change other users' roles.
+	PermManageRoles Permission = "users:manage_roles"
+	// PermViewAudit lets a user read the site's audit data.
+	PermViewAudit Permission = "audit:view"
+)
+
+// rolePermissions lists what each role may do. Plain users have no
+// permissions beyond their own polls.
+var rolePermissions = map[user.Role][]Permission{
+	user.RoleModerator: {PermManageAnyPoll, PermBanUsers},
+	user.RoleAdmin:     {PermManageAnyPoll, PermBanUsers, PermManageRoles, PermViewAudit},
+}
+
+// RoleHas reports whether the role grants perm.
+func RoleHas(role string, perm Permission) bool {
+	for _, p := range rolePermissions[user.Role(role)] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPermission reports whether the user's current role grants perm.
+func hasPermission(ctx context.Context, client *ent.Client, userID int, perm Permission) (bool, error) {
+	u, err := client.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return u.BannedAt == nil && RoleHas(u.Role.String(), perm), nil
+}
+
+// requirePermission fails with ErrUnauthorized unless the user's current
+// role grants perm.
+func requirePermission(ctx context.Context, client *ent.Client, userID int, perm Permission) error {
+	ok, err := hasPermission(ctx, client, userID, perm)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUnauthorized
+	}
+	return nil
+}
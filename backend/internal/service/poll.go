@@ -4,57 +4,284 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"pollapp/backend/ent"
 	"pollapp/backend/ent/poll"
 	"pollapp/backend/ent/polloption"
+	"pollapp/backend/ent/sharelink"
 	"pollapp/backend/ent/user"
-	"pollapp/backend/ent/vote"
+	"pollapp/backend/internal/audit"
+	"pollapp/backend/internal/event"
 )
 
+var (
+	// ErrInvalidBallot is returned when a vote doesn't satisfy the poll's
+	// selection rules.
+	ErrInvalidBallot = errors.New("invalid ballot")
+	// ErrPollNotOpen is returned when voting on a poll outside its voting
+	// window.
+	ErrPollNotOpen = errors.New("poll is not open for voting")
+	// ErrAlreadyVoted is returned when voting again on an anonymous poll
+	// without the receipt token of the earlier ballot.
+	ErrAlreadyVoted = errors.New("already voted on this poll; present your receipt token to change your vote")
+	// ErrUnauthorized is returned when a user changes a poll they don't own.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrInvalidTransition is returned when a lifecycle change doesn't
+	// apply to the poll's current status.
+	ErrInvalidTransition = errors.New("invalid poll transition")
+)
+
+// Poll statuses derived from a poll's voting window.
+const (
+	PollStatusScheduled = "scheduled"
+	PollStatusOpen      = "open"
+	PollStatusClosed    = "closed"
+	PollStatusArchived  = "archived"
+)
+
+// proposalOptions are created, in this order, for every proposal poll.
+var proposalOptions = []string{"Yes", "No", "Abstain"}
+
 type PollService struct {
 	client *ent.Client
+	bus    *event.Bus
+	audit  *audit.Log
+	// requireVerifiedEmail keeps users from creating polls until they have
+	// verified their email.
+	requireVerifiedEmail bool
 }
 
-func NewPollService(client *ent.Client) *PollService {
-	return &PollService{client: client}
+// PollSettings holds the per-poll voting rules chosen at creation time.
+// Zero values fall back to a classic single-choice poll.
+type PollSettings struct {
+	MinChoices   int
+	MaxChoices   int
+	VotingMethod string
+	TallyRule    string
+	// ScoreMin and ScoreMax bound score and STAR ballots; both zero means
+	// the default 0-5 range.
+	ScoreMin int
+	ScoreMax int
+	// PassThreshold and PassPercentage decide whether a proposal passes.
+	PassThreshold  string
+	PassPercentage int
+	// OpensAt and ClosesAt bound the voting window; nil leaves that end
+	// open.
+	OpensAt  *time.Time
+	ClosesAt *time.Time
+	// ResultsVisibility decides who may see the results.
+	ResultsVisibility string
+	// Anonymous keeps voters' identities apart from their ballots.
+	Anonymous bool
+	// Visibility decides who can find and open the poll.
+	Visibility string
+	// AllowGuests lets people without an account vote; GuestDedupe picks
+	// how guests are told apart.
+	AllowGuests bool
+	GuestDedupe string
 }
 
-func (s *PollService) CreatePoll(ctx context.Context, userID int, title, description string, options []string) (*ent.Poll, error) {
+func NewPollService(client *ent.Client, bus *event.Bus) *PollService {
+	return &PollService{client: client, bus: bus, audit: audit.New(client)}
+}
+
+// RequireVerifiedEmail makes CreatePoll refuse users who haven't verified
+// their email.
+func (s *PollService) RequireVerifiedEmail() {
+	s.requireVerifiedEmail = true
+}
+
+// CreatePoll creates a poll in the organization orgID, or outside any
+// organization if it is 0.
+func (s *PollService) CreatePoll(ctx context.Context, userID, orgID int, title, description string, options []string, settings PollSettings) (*ent.Poll, error) {
+	if s.requireVerifiedEmail {
+		verified, err := s.client.User.Query().
+			Where(user.IDEQ(userID), user.EmailVerifiedAtNotNil()).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !verified {
+			return nil, ErrEmailNotVerified
+		}
+	}
+
+	votingMethod := poll.VotingMethodPlurality
+	if settings.VotingMethod != "" {
+		votingMethod = poll.VotingMethod(settings.VotingMethod)
+	}
+	if err := poll.VotingMethodValidator(votingMethod); err != nil {
+		return nil, fmt.Errorf("unknown voting method %q", settings.VotingMethod)
+	}
+
+	if votingMethod == poll.VotingMethodProposal {
+		for _, optionText := range options {
+			if optionText != "" {
+				return nil, errors.New("proposal polls create their own Yes/No/Abstain options")
+			}
+		}
+		options = proposalOptions
+	}
+
 	if len(options) < 2 {
 		return nil, errors.New("poll must have at least 2 options")
 	}
 
-	poll, err := s.client.Poll.Create().
-		SetTitle(title).
-		SetDescription(description).
-		SetCreatedBy(userID).
-		Save(ctx)
-	if err != nil {
-		return nil, err
+	optionCount := 0
+	for _, optionText := range options {
+		if optionText != "" {
+			optionCount++
+		}
+	}
+
+	tallyRule := poll.TallyRuleInstantRunoff
+	if settings.TallyRule != "" {
+		tallyRule = poll.TallyRule(settings.TallyRule)
+	}
+	if err := poll.TallyRuleValidator(tallyRule); err != nil {
+		return nil, fmt.Errorf("unknown tally rule %q", settings.TallyRule)
+	}
+
+	if settings.ScoreMin == 0 && settings.ScoreMax == 0 {
+		settings.ScoreMax = 5
+	}
+	if settings.ScoreMin < 0 || settings.ScoreMax <= settings.ScoreMin {
+		return nil, errors.New("score range must be non-negative with score_max above score_min")
+	}
+
+	passThreshold := poll.PassThresholdSimpleMajority
+	if settings.PassThreshold != "" {
+		passThreshold = poll.PassThreshold(settings.PassThreshold)
+	}
+	if err := poll.PassThresholdValidator(passThreshold); err != nil {
+		return nil, fmt.Errorf("unknown pass threshold %q", settings.PassThreshold)
+	}
+	if settings.PassPercentage == 0 {
+		settings.PassPercentage = 50
+	}
+	if settings.PassPercentage < 1 || settings.PassPercentage > 100 {
+		return nil, errors.New("pass_percentage must be between 1 and 100")
+	}
+
+	resultsVisibility := poll.ResultsVisibilityAlways
+	if settings.ResultsVisibility != "" {
+		resultsVisibility = poll.ResultsVisibility(settings.ResultsVisibility)
+	}
+	if err := poll.ResultsVisibilityValidator(resultsVisibility); err != nil {
+		return nil, fmt.Errorf("unknown results visibility %q", settings.ResultsVisibility)
+	}
+
+	visibility := poll.VisibilityPublic
+	if settings.Visibility != "" {
+		visibility = poll.Visibility(settings.Visibility)
+	}
+	if err := poll.VisibilityValidator(visibility); err != nil {
+		return nil, fmt.Errorf("unknown visibility %q", settings.Visibility)
+	}
+
+	guestDedupe := poll.GuestDedupeToken
+	if settings.GuestDedupe != "" {
+		guestDedupe = poll.GuestDedupe(settings.GuestDedupe)
+	}
+	if err := poll.GuestDedupeValidator(guestDedupe); err != nil {
+		return nil, fmt.Errorf("unknown guest dedupe policy %q", settings.GuestDedupe)
+	}
+
+	if settings.OpensAt != nil && settings.ClosesAt != nil && !settings.ClosesAt.After(*settings.OpensAt) {
+		return nil, errors.New("closes_at must be after opens_at")
+	}
+
+	switch votingMethod {
+	case poll.VotingMethodApproval:
+		// Voters may approve of any number of options.
+		settings.MinChoices, settings.MaxChoices = 1, optionCount
+	case poll.VotingMethodProposal:
+		settings.MinChoices, settings.MaxChoices = 1, 1
+	}
+
+	if settings.MinChoices == 0 {
+		settings.MinChoices = 1
+	}
+	if settings.MaxChoices == 0 {
+		settings.MaxChoices = settings.MinChoices
+	}
+	if settings.MinChoices < 1 {
+		return nil, errors.New("min_choices must be at least 1")
+	}
+	if settings.MaxChoices < settings.MinChoices {
+		return nil, errors.New("max_choices must not be less than min_choices")
+	}
+	if settings.MaxChoices > optionCount {
+		return nil, errors.New("max_choices must not exceed the number of options")
 	}
 
-	// Create poll options
-	for i, optionText := range options {
-		if optionText == "" {
-			continue // Skip empty options
+	var created *ent.Poll
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		create := tx.Poll.Create()
+		if orgID != 0 {
+			create.SetOrganizationID(orgID)
 		}
-		_, err := s.client.PollOption.Create().
-			SetPollID(poll.ID).
-			SetOptionText(optionText).
-			SetOrder(i).
+		var err error
+		created, err = create.
+			SetTitle(title).
+			SetDescription(description).
+			SetCreatedBy(userID).
+			SetMinChoices(settings.MinChoices).
+			SetMaxChoices(settings.MaxChoices).
+			SetVotingMethod(votingMethod).
+			SetTallyRule(tallyRule).
+			SetScoreMin(settings.ScoreMin).
+			SetScoreMax(settings.ScoreMax).
+			SetPassThreshold(passThreshold).
+			SetPassPercentage(settings.PassPercentage).
+			SetNillableOpensAt(settings.OpensAt).
+			SetNillableClosesAt(settings.ClosesAt).
+			SetVisibility(visibility).
+			SetResultsVisibility(resultsVisibility).
+			SetAnonymous(settings.Anonymous).
+			SetAllowGuests(settings.AllowGuests).
+			SetGuestDedupe(guestDedupe).
 			Save(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create option %d: %w", i, err)
+			return err
+		}
+
+		// Create poll options
+		for i, optionText := range options {
+			if optionText == "" {
+				continue // Skip empty options
+			}
+			_, err := tx.PollOption.Create().
+				SetPollID(created.ID).
+				SetOptionText(optionText).
+				SetOrder(i).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create option %d: %w", i, err)
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return poll, nil
+	return created, nil
 }
 
-func (s *PollService) ListPolls(ctx context.Context) ([]*ent.Poll, error) {
-	polls, err := s.client.Poll.Query().
-		WithOptions().
+// ListPolls returns the polls the user may find in their active
+// organization, leaving out archived ones unless includeArchived is set;
+// userID is 0 for anonymous callers and orgID 0 outside any organization.
+func (s *PollService) ListPolls(ctx context.Context, userID, orgID int, includeArchived bool) ([]*ent.Poll, error) {
+	query := s.client.Poll.Query().
+		Where(listedFor(userID, orgID))
+	if !includeArchived {
+		query = query.Where(poll.ArchivedAtIsNil())
+	}
+
+	polls, err := query.
+		WithOptions(orderedOptions).
 		WithVotes().
 		All(ctx)
 	if err != nil {
@@ -66,114 +293,297 @@ func (s *PollService) ListPolls(ctx context.Context) ([]*ent.Poll, error) {
 func (s *PollService) GetPoll(ctx context.Context, id int) (*ent.Poll, error) {
 	return s.client.Poll.Query().
 		Where(poll.IDEQ(id)).
-		WithOptions().
+		WithOptions(orderedOptions).
 		WithVotes().
+		WithTransitions().
 		Only(ctx)
 }
 
+// orderedOptions loads a poll's options in the order voters see them.
+func orderedOptions(q *ent.PollOptionQuery) {
+	q.Order(ent.Asc(polloption.FieldOrder), ent.Asc(polloption.FieldID))
+}
+
+// GetVoteCounts returns the number of votes per option. For ranked polls
+// this is the number of first preferences and for score and STAR polls the
+// total score.
 func (s *PollService) GetVoteCounts(ctx context.Context, pollID int) (map[int]int, error) {
-	options, err := s.client.PollOption.Query().
-		Where(polloption.PollIDEQ(pollID)).
-		All(ctx)
+	p, err := s.client.Poll.Query().
+		Where(poll.IDEQ(pollID)).
+		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	counts := make(map[int]int)
-	for _, option := range options {
-		count, err := s.client.Vote.Query().
-			Where(vote.PollOptionIDEQ(option.ID)).
-			Count(ctx)
-		if err != nil {
-			return nil, err
-		}
-		counts[option.ID] = count
+	switch p.VotingMethod {
+	case poll.VotingMethodRanked:
+		return s.firstPreferenceCounts(ctx, p)
+	case poll.VotingMethodScore, poll.VotingMethodStar:
+		return s.scoreTotals(ctx, p)
+	default:
+		return s.selectionCounts(ctx, p)
 	}
-
-	return counts, nil
 }
 
-
-func (s *PollService) UpdatePoll(ctx context.Context, id, userID int, title, description string) (*ent.Poll, error) {
+// GetVoterCount returns the number of distinct users who cast a ballot on
+// the poll, as opposed to the number of selected options.
+func (s *PollService) GetVoterCount(ctx context.Context, pollID int) (int, error) {
 	p, err := s.client.Poll.Query().
-		Where(poll.IDEQ(id)).
+		Where(poll.IDEQ(pollID)).
 		Only(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+
+	counts, err := s.participationCounts(ctx, p)
+	if err != nil {
+		return 0, err
 	}
+	return counts.Members + counts.Guests, nil
+}
 
-	if p.CreatedBy != userID {
-		return nil, errors.New("unauthorized")
+func (s *PollService) UpdatePoll(ctx context.Context, id, userID int, title, description string) (*ent.Poll, error) {
+	if _, err := s.pollWithRight(ctx, id, userID, rightEdit); err != nil {
+		return nil, err
 	}
 
-	return s.client.Poll.UpdateOneID(id).
-		SetTitle(title).
-		SetDescription(description).
-		Save(ctx)
+	var updated *ent.Poll
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		updated, err = tx.Poll.UpdateOneID(id).
+			SetTitle(title).
+			SetDescription(description).
+			AddRevision(1).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
 }
 
 func (s *PollService) DeletePoll(ctx context.Context, id, userID int) error {
-	p, err := s.client.Poll.Query().
-		Where(poll.IDEQ(id)).
-		Only(ctx)
+	p, err := s.ownedPoll(ctx, id, userID)
 	if err != nil {
 		return err
 	}
 
-	if p.CreatedBy != userID {
-		return errors.New("unauthorized")
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		return tx.Poll.DeleteOneID(id).Exec(ctx)
+	})
+	if err != nil {
+		return err
 	}
+	s.audit.Record(ctx, audit.PollDeleted, userID, map[string]any{
+		"poll_id":    p.ID,
+		"title":      p.Title,
+		"created_by": p.CreatedBy,
+	})
+	return nil
+}
 
-	return s.client.Poll.DeleteOneID(id).Exec(ctx)
+// BallotInput is what a voter submits. Which fields apply depends on the
+// poll's voting method.
+type BallotInput struct {
+	// OptionIDs is the selection of options, or on ranked polls the full
+	// ranking with the most preferred option first.
+	OptionIDs []int
+	// Scores maps every option to its score on score and STAR polls.
+	Scores map[int]int
+	// ReceiptToken identifies the voter's earlier ballot on an anonymous
+	// poll, allowing it to be replaced.
+	ReceiptToken string
+	// Revision is the poll revision the ballot was filled in against. If
+	// set, the vote is refused once the poll has moved on.
+	Revision int
 }
 
-func (s *PollService) Vote(ctx context.Context, pollID, pollOptionID, userID int) error {
-	// Verify user exists
-	_, err := s.client.User.Query().
-		Where(user.IDEQ(userID)).
+// VoteReceipt is handed back for every ballot cast.
+type VoteReceipt struct {
+	// ReceiptToken is only issued on anonymous polls. It is the only way
+	// to replace the ballot later and is never stored in the clear.
+	ReceiptToken string `json:"receipt_token,omitempty"`
+	// Nonce was hashed together with the ballot into BallotHash. It isn't
+	// stored, so only the voter can show which ballot the hash stands for.
+	Nonce      string `json:"nonce"`
+	BallotHash string `json:"ballot_hash"`
+	// Sequence and ChainHash locate the ballot in the poll's ballot log.
+	Sequence  int    `json:"sequence"`
+	ChainHash string `json:"chain_hash"`
+}
+
+// maxVoteAttempts bounds how often Vote retries when a concurrent vote
+// took the same ballot log sequence number.
+const maxVoteAttempts = 3
+
+// Vote replaces the user's ballot on the poll. The previous ballot, if
+// any, is removed in the same transaction so a failed vote never leaves a
+// partial ballot behind. On anonymous polls a ballot can only be replaced
+// by presenting the receipt token issued when it was first cast.
+func (s *PollService) Vote(ctx context.Context, pollID int, voter Voter, in BallotInput) (*VoteReceipt, error) {
+	if voter.GuestSubject == "" {
+		if err := s.checkVoter(ctx, voter.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := s.client.Poll.Query().
+		Where(poll.IDEQ(pollID)).
 		Only(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return errors.New("user not found")
+		return nil, err
+	}
+
+	if voter.ShareLinkID == 0 {
+		ok, err := s.CanAccess(ctx, p, voter.UserID, voter.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrPollNotFound
 		}
-		return err
 	}
 
-	// Verify poll option exists and belongs to the poll
-	option, err := s.client.PollOption.Query().
-		Where(polloption.IDEQ(pollOptionID), polloption.PollIDEQ(pollID)).
-		Only(ctx)
+	if voter.GuestSubject != "" && !p.AllowGuests {
+		return nil, ErrGuestsNotAllowed
+	}
+
+	if err := checkOpen(p, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if in.Revision != 0 && in.Revision != p.Revision {
+		return nil, ErrPollChanged
+	}
+
+	if err := s.validateBallot(ctx, p, in); err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		receipt, err := s.castBallot(ctx, p, voter, in)
+		if err == nil {
+			s.recordVote(ctx, p, voter)
+		}
+		if err == nil || !errors.Is(err, errBallotLogConflict) || attempt == maxVoteAttempts {
+			return receipt, err
+		}
+	}
+}
+
+// recordVote adds a cast or changed ballot to the audit log. What was
+// chosen is left out.
+func (s *PollService) recordVote(ctx context.Context, p *ent.Poll, voter Voter) {
+	details := map[string]any{"poll_id": p.ID}
+	if voter.GuestSubject != "" {
+		details["guest"] = true
+	}
+	if voter.ShareLinkID != 0 {
+		details["share_link_id"] = voter.ShareLinkID
+	}
+	s.audit.Record(ctx, audit.VoteCast, voter.UserID, details)
+}
+
+// castBallot stores the ballot and appends its hash to the poll's ballot
+// log in one transaction.
+func (s *PollService) castBallot(ctx context.Context, p *ent.Poll, voter Voter, in BallotInput) (*VoteReceipt, error) {
+	nonce, err := newToken()
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return errors.New("poll option not found or doesn't belong to this poll")
+		return nil, err
+	}
+	receipt := &VoteReceipt{Nonce: nonce}
+
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if voter.ShareLinkID != 0 {
+			if err := useShareLink(ctx, tx.ShareLink.Update(), voter.ShareLinkID, p.ID, sharelink.GrantVote); err != nil {
+				return err
+			}
 		}
-		return err
+
+		owner := ballotOwner{userID: voter.UserID}
+		if voter.GuestSubject != "" {
+			guestID, err := guestVoter(ctx, tx, p, voter)
+			if err != nil {
+				return err
+			}
+			owner = ballotOwner{guestID: guestID}
+		}
+
+		var err error
+		switch {
+		case p.Anonymous:
+			receipt.ReceiptToken, err = castAnonymousBallot(ctx, tx, p, owner, in)
+		case p.VotingMethod == poll.VotingMethodRanked:
+			err = storeRankedBallot(ctx, tx, p, owner, in.OptionIDs)
+		case p.VotingMethod == poll.VotingMethodScore, p.VotingMethod == poll.VotingMethodStar:
+			err = storeScoreBallot(ctx, tx, p, owner, in.Scores)
+		default:
+			err = storeVotes(ctx, tx, p, owner, in.OptionIDs)
+		}
+		if err != nil {
+			return err
+		}
+
+		entry, err := appendBallotLog(ctx, tx, p.ID, ballotHash(p, nonce, in))
+		if err != nil {
+			return err
+		}
+		receipt.BallotHash = entry.BallotHash
+		receipt.Sequence = entry.Sequence
+		receipt.ChainHash = entry.Hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	_ = option // Use option to avoid unused variable
+	return receipt, nil
+}
 
-	// Check if user already voted on this poll
-	existing, err := s.client.Vote.Query().
-		Where(vote.PollIDEQ(pollID), vote.UserIDEQ(userID)).
+// checkVoter verifies the voting user exists.
+func (s *PollService) checkVoter(ctx context.Context, userID int) error {
+	_, err := s.client.User.Query().
+		Where(user.IDEQ(userID)).
 		Only(ctx)
-
-	if err == nil && existing != nil {
-		// Update existing vote to new option
-		_, err = s.client.Vote.UpdateOneID(existing.ID).
-			SetPollOptionID(pollOptionID).
-			Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("user not found")
+		}
 		return err
 	}
+	return nil
+}
 
-	// Check if error is "not found" - that's expected for new votes
-	if err != nil && !ent.IsNotFound(err) {
-		return err
+// PollStatus reports where now falls in the poll's voting window, taking
+// manual closing and archiving into account.
+func PollStatus(p *ent.Poll, now time.Time) string {
+	switch {
+	case p.ArchivedAt != nil:
+		return PollStatusArchived
+	case p.ClosedAt != nil:
+		return PollStatusClosed
+	case p.OpensAt != nil && now.Before(*p.OpensAt):
+		return PollStatusScheduled
+	case p.ClosesAt != nil && !now.Before(*p.ClosesAt):
+		return PollStatusClosed
+	default:
+		return PollStatusOpen
 	}
+}
 
-	// Create new vote
-	_, err = s.client.Vote.Create().
-		SetPollID(pollID).
-		SetPollOptionID(pollOptionID).
-		SetUserID(userID).
-		Save(ctx)
-	return err
+// checkOpen returns ErrPollNotOpen unless the poll accepts votes at now.
+func checkOpen(p *ent.Poll, now time.Time) error {
+	switch PollStatus(p, now) {
+	case PollStatusScheduled:
+		return fmt.Errorf("%w: voting opens at %s", ErrPollNotOpen, p.OpensAt.Format(time.RFC3339))
+	case PollStatusArchived:
+		return fmt.Errorf("%w: poll is archived", ErrPollNotOpen)
+	case PollStatusClosed:
+		closedAt := p.ClosesAt
+		if p.ClosedAt != nil {
+			closedAt = p.ClosedAt
+		}
+		return fmt.Errorf("%w: voting closed at %s", ErrPollNotOpen, closedAt.Format(time.RFC3339))
+	}
+	return nil
 }
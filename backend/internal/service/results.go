@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/polloption"
+)
+
+// PollResults is the tallied outcome of a poll. Which of the optional
+// sections are filled in depends on the poll's voting method.
+type PollResults struct {
+	PollID       int         `json:"poll_id"`
+	VotingMethod string      `json:"voting_method"`
+	TallyRule    string      `json:"tally_rule,omitempty"`
+	VoterCount   int         `json:"voter_count"`
+	VoteCounts   map[int]int `json:"vote_counts"`
+	// Winner is nil while there are no votes or when the top spot is tied.
+	Winner *int `json:"winner"`
+
+	InstantRunoff *InstantRunoffResult `json:"instant_runoff,omitempty"`
+	Condorcet     *CondorcetResult     `json:"condorcet,omitempty"`
+	Score         *ScoreResult         `json:"score,omitempty"`
+	Proposal      *ProposalResult      `json:"proposal,omitempty"`
+}
+
+// GetResults tallies the poll using the rule for its voting method.
+func (s *PollService) GetResults(ctx context.Context, pollID int) (*PollResults, error) {
+	p, err := s.client.Poll.Query().
+		Where(poll.IDEQ(pollID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	voteCounts, err := s.GetVoteCounts(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+	voterCount, err := s.GetVoterCount(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &PollResults{
+		PollID:       p.ID,
+		VotingMethod: p.VotingMethod.String(),
+		VoterCount:   voterCount,
+		VoteCounts:   voteCounts,
+	}
+
+	switch p.VotingMethod {
+	case poll.VotingMethodRanked:
+		optionIDs, err := s.client.PollOption.Query().
+			Where(polloption.PollIDEQ(pollID)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ballots, err := s.rankedBallots(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		results.TallyRule = p.TallyRule.String()
+		switch p.TallyRule {
+		case poll.TallyRuleCondorcet:
+			condorcet := Condorcet(optionIDs, ballots)
+			results.Condorcet = &condorcet
+			results.Winner = condorcet.Winner
+		default:
+			irv := InstantRunoff(optionIDs, ballots)
+			results.InstantRunoff = &irv
+			results.Winner = irv.Winner
+		}
+	case poll.VotingMethodScore, poll.VotingMethodStar:
+		optionIDs, err := s.client.PollOption.Query().
+			Where(polloption.PollIDEQ(pollID)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ballots, err := s.scoreBallots(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		score := ScoreTally(optionIDs, ballots, p.ScoreMin, p.ScoreMax)
+		results.Score = &score
+		results.Winner = score.Winner
+		if p.VotingMethod == poll.VotingMethodStar {
+			results.Winner = nil
+			if score.Runoff != nil {
+				results.Winner = score.Runoff.Winner
+			}
+		}
+	case poll.VotingMethodProposal:
+		options, err := s.client.PollOption.Query().
+			Where(polloption.PollIDEQ(pollID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		counts, err := proposalCounts(options, voteCounts)
+		if err != nil {
+			return nil, fmt.Errorf("proposal poll %d: %w", pollID, err)
+		}
+
+		proposal := ProposalTally(
+			counts["Yes"],
+			counts["No"],
+			counts["Abstain"],
+			p.PassThreshold.String(),
+			p.PassPercentage,
+		)
+		results.Proposal = &proposal
+	default:
+		// Plurality and approval polls are won by the most votes.
+		results.Winner = pluralityWinner(voteCounts)
+	}
+
+	return results, nil
+}
+
+// proposalCounts returns the votes for each of a proposal poll's options
+// by label, checking that it has exactly the options in proposalOptions.
+func proposalCounts(options []*ent.PollOption, voteCounts map[int]int) (map[string]int, error) {
+	counts := make(map[string]int, len(proposalOptions))
+	for _, o := range options {
+		counts[o.OptionText] = voteCounts[o.ID]
+	}
+	if len(options) != len(proposalOptions) {
+		return nil, fmt.Errorf("has %d options, want %d", len(options), len(proposalOptions))
+	}
+	for _, label := range proposalOptions {
+		if _, ok := counts[label]; !ok {
+			return nil, fmt.Errorf("has no %q option", label)
+		}
+	}
+	return counts, nil
+}
+
+// pluralityWinner returns the option with the most votes, or nil if there
+// are no votes or the lead is shared.
+func pluralityWinner(counts map[int]int) *int {
+	leaders, most := extremes(counts, true)
+	if most == 0 || len(leaders) != 1 {
+		return nil
+	}
+	return &leaders[0]
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/internal/event"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PollScheduler publishes an event.PollClosed event when a poll reaches its
+// closes_at time.
+type PollScheduler struct {
+	client *ent.Client
+	bus    *event.Bus
+	// maxWait bounds how long the scheduler sleeps, so polls created
+	// after it last looked are still picked up in time.
+	maxWait time.Duration
+}
+
+func NewPollScheduler(client *ent.Client, bus *event.Bus) *PollScheduler {
+	return &PollScheduler{
+		client:  client,
+		bus:     bus,
+		maxWait: 10 * time.Second,
+	}
+}
+
+// Run emits close events until ctx is cancelled. It starts by catching up
+// on polls that reached closes_at while the server was down.
+func (s *PollScheduler) Run(ctx context.Context) {
+	for {
+		now := time.Now()
+		if err := s.emitClosed(ctx, now); err != nil {
+			log.Printf("PollScheduler error: %v", err)
+		}
+
+		wait := s.maxWait
+		next, err := s.client.Poll.Query().
+			Where(poll.ClosesAtGT(now)).
+			Order(ent.Asc(poll.FieldClosesAt)).
+			First(ctx)
+		if err == nil && next.ClosesAt.Sub(now) < wait {
+			wait = next.ClosesAt.Sub(now)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// emitClosed publishes a close event for every poll that reached closes_at
+// by until and has no event for that closes_at yet. Polls closed by hand
+// already had their event published.
+func (s *PollScheduler) emitClosed(ctx context.Context, until time.Time) error {
+	polls, err := s.client.Poll.Query().
+		Where(poll.ClosesAtLTE(until), poll.ClosedAtIsNil(), closeEventPending()).
+		Order(ent.Asc(poll.FieldClosesAt)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range polls {
+		// Claim the event first, so that of several servers only one
+		// publishes it
+		n, err := s.client.Poll.Update().
+			Where(poll.IDEQ(p.ID), poll.ClosesAtEQ(*p.ClosesAt), closeEventPending()).
+			SetCloseEventAt(*p.ClosesAt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+
+		s.bus.Publish(event.Event{
+			Type:   event.PollClosed,
+			PollID: p.ID,
+			At:     *p.ClosesAt,
+		})
+	}
+	return nil
+}
+
+// closeEventPending matches polls whose current closes_at has not had its
+// close event published; moving closes_at makes a poll pending again.
+func closeEventPending() predicate.Poll {
+	return poll.Or(
+		poll.CloseEventAtIsNil(),
+		predicate.Poll(func(s *sql.Selector) {
+			s.Where(sql.ColumnsNEQ(s.C(poll.FieldCloseEventAt), s.C(poll.FieldClosesAt)))
+		}),
+	)
+}
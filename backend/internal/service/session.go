@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/refreshtoken"
+	"pollapp/backend/ent/session"
+	"pollapp/backend/internal/audit"
+)
+
+// Access tokens are short-lived; clients trade their refresh token for a
+// new pair before the access token expires. A session lasts as long as it
+// keeps being refreshed within refreshTokenTTL.
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidRefreshToken is returned for refresh tokens that are
+	// unknown, expired or belong to a revoked session.
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	// ErrRefreshTokenReused is returned when a refresh token is presented
+	// a second time. Its session is revoked, signing out everyone holding
+	// one of its tokens.
+	ErrRefreshTokenReused = errors.New("refresh token was already used; the session has been revoked")
+)
+
+// Tokens is what signing in or refreshing hands to the client.
+type Tokens struct {
+	AccessToken  string    `json:"token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// startSession opens a session for the user with orgID, 0 for none, as the
+// active organization and issues its first tokens.
+func (s *AuthService) startSession(ctx context.Context, u *ent.User, orgID int) (*Tokens, error) {
+	var tokens *Tokens
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		create := tx.Session.Create().
+			SetUserID(u.ID)
+		if orgID != 0 {
+			create.SetOrgID(orgID)
+		}
+		sess, err := create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		tokens, err = s.issueTokens(ctx, tx, u, sess.ID, orgID)
+		return err
+	})
+	return tokens, err
+}
+
+// Refresh trades a refresh token for a new access token and a new refresh
+// token of the same session. Every refresh token works once; presenting a
+// used one revokes the session.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	rt, err := s.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHashEQ(hashToken(refreshToken))).
+		WithSession().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidRefreshToken
+	} else if err != nil {
+		return nil, err
+	}
+	sess := rt.Edges.Session
+	if sess.RevokedAt != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	if rt.UsedAt != nil {
+		return nil, s.revokeReused(ctx, sess)
+	}
+	if time.Now().After(rt.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	u, err := s.client.User.Get(ctx, sess.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.BannedAt != nil {
+		return nil, ErrUserBanned
+	}
+	// Sessions from before the user's role required a second factor end
+	if s.mfaRequired(u) && u.TotpEnabledAt == nil {
+		return nil, ErrMFARequired
+	}
+
+	// Drop an active organization the user has left since
+	orgID := 0
+	if sess.OrgID != nil {
+		member, err := s.IsOrgMember(ctx, *sess.OrgID, u.ID)
+		if err != nil {
+			return nil, err
+		}
+		if member {
+			orgID = *sess.OrgID
+		}
+	}
+
+	var tokens *Tokens
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		// Only one of two concurrent refreshes with the same token wins
+		n, err := tx.RefreshToken.Update().
+			Where(refreshtoken.IDEQ(rt.ID), refreshtoken.UsedAtIsNil()).
+			SetUsedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrRefreshTokenReused
+		}
+
+		if orgID == 0 && sess.OrgID != nil {
+			if err := tx.Session.UpdateOneID(sess.ID).ClearOrgID().Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		tokens, err = s.issueTokens(ctx, tx, u, sess.ID, orgID)
+		return err
+	})
+	if errors.Is(err, ErrRefreshTokenReused) {
+		return nil, s.revokeReused(ctx, sess)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Logout revokes the session the refresh token belongs to, so neither its
+// refresh tokens nor its access tokens work any more.
+func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
+	rt, err := s.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHashEQ(hashToken(refreshToken))).
+		WithSession().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrInvalidRefreshToken
+	} else if err != nil {
+		return err
+	}
+
+	sess := rt.Edges.Session
+	if err := revokeSessions(ctx, s.client, "logout", session.IDEQ(sess.ID)); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, audit.Logout, sess.UserID, map[string]any{"session_id": sess.ID})
+	return nil
+}
+
+// revokeReused revokes a session one of whose refresh tokens came back
+// after it had been used, and returns ErrRefreshTokenReused.
+func (s *AuthService) revokeReused(ctx context.Context, sess *ent.Session) error {
+	if err := revokeSessions(ctx, s.client, "refresh token reused", session.IDEQ(sess.ID)); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, audit.RefreshTokenReused, sess.UserID, map[string]any{"session_id": sess.ID})
+	return ErrRefreshTokenReused
+}
+
+// revokeSessions revokes the matching sessions that are still active.
+func revokeSessions(ctx context.Context, client *ent.Client, reason string, where ...predicate.Session) error {
+	return client.Session.Update().
+		Where(where...).
+		Where(session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		SetRevokedReason(reason).
+		Exec(ctx)
+}
+
+// checkSession fails unless the session exists and hasn't been revoked.
+func (s *AuthService) checkSession(ctx context.Context, sessionID int) error {
+	sess, err := s.client.Session.Get(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if sess.RevokedAt != nil {
+		return errors.New("session has been revoked")
+	}
+	return nil
+}
+
+// issueTokens stores a new refresh token for the session and signs an
+// access token to go with it.
+func (s *AuthService) issueTokens(ctx context.Context, tx *ent.Tx, u *ent.User, sessionID, orgID int) (*Tokens, error) {
+	refreshToken, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.RefreshToken.Create().
+		SetSessionID(sessionID).
+		SetTokenHash(hashToken(refreshToken)).
+		SetExpiresAt(time.Now().Add(refreshTokenTTL)).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := s.issueToken(u, sessionID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
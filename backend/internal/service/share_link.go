@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/predicate"
+	"pollapp/backend/ent/sharelink"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var (
+	// ErrShareLinkInvalid is returned for share links that don't exist or
+	// have expired, been used up or been revoked.
+	ErrShareLinkInvalid = errors.New("share link is invalid, expired or revoked")
+	// ErrShareLinkReadOnly is returned when voting through a view link.
+	ErrShareLinkReadOnly = errors.New("share link does not allow voting")
+)
+
+// ShareLinkSettings are the grant and limits of a new share link. A nil
+// limit leaves it unbounded.
+type ShareLinkSettings struct {
+	Grant     string
+	ExpiresAt *time.Time
+	MaxUses   *int
+}
+
+// CreateShareLink mints a secret link to a public or unlisted poll and
+// returns it with its token, which is not stored and can't be shown again.
+func (s *PollService) CreateShareLink(ctx context.Context, pollID, userID int, settings ShareLinkSettings) (*ent.ShareLink, string, error) {
+	p, err := s.ownedPoll(ctx, pollID, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if p.Visibility == poll.VisibilityPrivate {
+		return nil, "", errors.New("private polls can't be shared by link; invite members instead")
+	}
+	if p.OrganizationID != nil {
+		return nil, "", errors.New("organization polls can't be shared outside the organization")
+	}
+
+	grant := sharelink.GrantVote
+	if settings.Grant != "" {
+		grant = sharelink.Grant(settings.Grant)
+	}
+	if err := sharelink.GrantValidator(grant); err != nil {
+		return nil, "", errors.New("grant must be view or vote")
+	}
+	if settings.ExpiresAt != nil && !settings.ExpiresAt.After(time.Now()) {
+		return nil, "", errors.New("expires_at must be in the future")
+	}
+	if settings.MaxUses != nil && *settings.MaxUses < 1 {
+		return nil, "", errors.New("max_uses must be at least 1")
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	link, err := s.client.ShareLink.Create().
+		SetPollID(pollID).
+		SetCreatedBy(userID).
+		SetTokenHash(hashToken(token)).
+		SetGrant(grant).
+		SetNillableExpiresAt(settings.ExpiresAt).
+		SetNillableMaxUses(settings.MaxUses).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return link, token, nil
+}
+
+// ListShareLinks returns all of the poll's share links, including revoked
+// and expired ones.
+func (s *PollService) ListShareLinks(ctx context.Context, pollID, userID int) ([]*ent.ShareLink, error) {
+	if _, err := s.ownedPoll(ctx, pollID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.client.ShareLink.Query().
+		Where(sharelink.PollIDEQ(pollID)).
+		Order(ent.Desc(sharelink.FieldCreatedAt)).
+		All(ctx)
+}
+
+// RevokeShareLink stops a share link from working.
+func (s *PollService) RevokeShareLink(ctx context.Context, pollID, userID, linkID int) error {
+	if _, err := s.ownedPoll(ctx, pollID, userID); err != nil {
+		return err
+	}
+
+	n, err := s.client.ShareLink.Update().
+		Where(
+			sharelink.IDEQ(linkID),
+			sharelink.PollIDEQ(pollID),
+			sharelink.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrShareLinkInvalid
+	}
+	return nil
+}
+
+// OpenShareLink resolves a share link token for viewing or, if forVote,
+// voting on its poll. Views through a view link count as uses; ballots
+// cast through a vote link are counted by Vote.
+func (s *PollService) OpenShareLink(ctx context.Context, token string, forVote bool) (*ent.ShareLink, error) {
+	link, err := s.client.ShareLink.Query().
+		Where(sharelink.TokenHashEQ(hashToken(token)), usableShareLink(time.Now())).
+		WithPoll().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrShareLinkInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Making a poll private shuts out everyone who isn't a member.
+	if link.Edges.Poll.Visibility == poll.VisibilityPrivate || link.Edges.Poll.OrganizationID != nil {
+		return nil, ErrShareLinkInvalid
+	}
+
+	switch {
+	case forVote && link.Grant != sharelink.GrantVote:
+		return nil, ErrShareLinkReadOnly
+	case !forVote && link.Grant == sharelink.GrantView:
+		if err := useShareLink(ctx, s.client.ShareLink.Update(), link.ID, link.PollID, sharelink.GrantView); err != nil {
+			return nil, err
+		}
+	}
+	return link, nil
+}
+
+// useShareLink counts one use of the link, failing if it has become
+// unusable in the meantime.
+func useShareLink(ctx context.Context, update *ent.ShareLinkUpdate, linkID, pollID int, grant sharelink.Grant) error {
+	n, err := update.
+		Where(
+			sharelink.IDEQ(linkID),
+			sharelink.PollIDEQ(pollID),
+			sharelink.GrantEQ(grant),
+			usableShareLink(time.Now()),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrShareLinkInvalid
+	}
+	return nil
+}
+
+// usableShareLink matches links that are neither revoked, expired nor
+// used up.
+func usableShareLink(now time.Time) predicate.ShareLink {
+	return sharelink.And(
+		sharelink.RevokedAtIsNil(),
+		sharelink.Or(sharelink.ExpiresAtIsNil(), sharelink.ExpiresAtGT(now)),
+		sharelink.Or(
+			sharelink.MaxUsesIsNil(),
+			predicate.ShareLink(sql.FieldsLT(sharelink.FieldUses, sharelink.FieldMaxUses)),
+		),
+	)
+}
@@ -0,0 +1,451 @@
+package service
+
+import "sort"
+
+// RankedBallot lists poll option IDs in order of preference, most preferred
+// first.
+type RankedBallot []int
+
+// RunoffRound is the state of an instant-runoff count after one round.
+type RunoffRound struct {
+	Round int `json:"round"`
+	// Tallies maps each option still in the running to the number of
+	// ballots currently counting towards it.
+	Tallies map[int]int `json:"tallies"`
+	// Exhausted is the number of ballots with no remaining preference.
+	Exhausted  int   `json:"exhausted"`
+	Eliminated []int `json:"eliminated,omitempty"`
+	// Transfers maps an option to the number of ballots it received from
+	// the options eliminated in this round.
+	Transfers map[int]int `json:"transfers,omitempty"`
+	// ExhaustedTransfers counts ballots from eliminated options that had
+	// no further preference.
+	ExhaustedTransfers int `json:"exhausted_transfers,omitempty"`
+}
+
+// InstantRunoffResult is the outcome of an instant-runoff count. Winner is
+// nil when there were no ballots or the final round ended in a tie, in
+// which case Tied lists the options sharing first place.
+type InstantRunoffResult struct {
+	Rounds []RunoffRound `json:"rounds"`
+	Winner *int          `json:"winner"`
+	Tied   []int         `json:"tied,omitempty"`
+}
+
+// InstantRunoff counts ranked ballots by repeatedly eliminating the option
+// with the fewest first preferences and transferring its ballots to their
+// next remaining preference, until one option holds a majority of the
+// ballots still in play. Options tied for last place are eliminated
+// together.
+func InstantRunoff(options []int, ballots []RankedBallot) InstantRunoffResult {
+	active := make(map[int]bool, len(options))
+	for _, id := range options {
+		active[id] = true
+	}
+
+	// topChoice returns the ballot's highest-ranked option still in the
+	// running, or 0 if the ballot is exhausted.
+	topChoice := func(b RankedBallot) int {
+		for _, id := range b {
+			if active[id] {
+				return id
+			}
+		}
+		return 0
+	}
+
+	var result InstantRunoffResult
+	for round := 1; ; round++ {
+		tallies := make(map[int]int, len(active))
+		for id := range active {
+			tallies[id] = 0
+		}
+		exhausted := 0
+		for _, b := range ballots {
+			if id := topChoice(b); id != 0 {
+				tallies[id]++
+			} else {
+				exhausted++
+			}
+		}
+
+		current := RunoffRound{
+			Round:     round,
+			Tallies:   tallies,
+			Exhausted: exhausted,
+		}
+
+		continuing := len(ballots) - exhausted
+		leaders, most := extremes(tallies, true)
+		losers, fewest := extremes(tallies, false)
+
+		switch {
+		case len(active) == 0 || continuing == 0:
+			result.Rounds = append(result.Rounds, current)
+			return result
+		case most*2 > continuing || len(active) == 1:
+			winner := leaders[0]
+			result.Winner = &winner
+			result.Rounds = append(result.Rounds, current)
+			return result
+		case most == fewest:
+			// Everyone left is tied; eliminating them all would leave
+			// nobody, so report the tie.
+			result.Tied = leaders
+			result.Rounds = append(result.Rounds, current)
+			return result
+		}
+
+		current.Eliminated = losers
+		current.Transfers = make(map[int]int)
+		eliminated := make(map[int]bool, len(losers))
+		for _, id := range losers {
+			eliminated[id] = true
+		}
+
+		before := make([]int, len(ballots))
+		for i, b := range ballots {
+			before[i] = topChoice(b)
+		}
+		for _, id := range losers {
+			delete(active, id)
+		}
+		for i, b := range ballots {
+			if !eliminated[before[i]] {
+				continue
+			}
+			if next := topChoice(b); next != 0 {
+				current.Transfers[next]++
+			} else {
+				current.ExhaustedTransfers++
+			}
+		}
+
+		result.Rounds = append(result.Rounds, current)
+	}
+}
+
+// extremes returns the options sharing the highest (or lowest) tally, in
+// ascending ID order, together with that tally.
+func extremes(tallies map[int]int, highest bool) ([]int, int) {
+	var ids []int
+	best := 0
+	for id, n := range tallies {
+		better := n > best
+		if !highest {
+			better = n < best
+		}
+		switch {
+		case ids == nil || better:
+			ids = []int{id}
+			best = n
+		case n == best:
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids, best
+}
+
+// CondorcetResult is the outcome of a pairwise comparison of ranked
+// ballots. Pairwise and StrongestPaths are square matrices indexed in the
+// order of Options: Pairwise[i][j] is the number of voters who ranked
+// Options[i] above Options[j], and StrongestPaths[i][j] is the strength of
+// the strongest Schulze path from Options[i] to Options[j].
+type CondorcetResult struct {
+	Options         []int   `json:"options"`
+	Pairwise        [][]int `json:"pairwise"`
+	StrongestPaths  [][]int `json:"strongest_paths"`
+	CondorcetWinner *int    `json:"condorcet_winner"`
+	// Ranking is the Schulze order from best to worst; options that cannot
+	// be separated share a group.
+	Ranking [][]int `json:"ranking"`
+	Winner  *int    `json:"winner"`
+}
+
+// Condorcet builds the pairwise preference matrix from ranked ballots and
+// reports the Condorcet winner, the option that beats every other option
+// head to head, if there is one. The full ranking, and the winner when no
+// Condorcet winner exists, come from the Schulze method. Options left off
+// a ballot count as ranked below every option on it.
+func Condorcet(options []int, ballots []RankedBallot) CondorcetResult {
+	ids := append([]int(nil), options...)
+	sort.Ints(ids)
+	n := len(ids)
+	index := make(map[int]int, n)
+	for i, id := range ids {
+		index[id] = i
+	}
+
+	d := squareMatrix(n)
+	for _, b := range ballots {
+		position := make([]int, n)
+		for i := range position {
+			position[i] = len(b)
+		}
+		for pos, id := range b {
+			if i, ok := index[id]; ok {
+				position[i] = pos
+			}
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if position[i] < position[j] {
+					d[i][j]++
+				}
+			}
+		}
+	}
+
+	result := CondorcetResult{
+		Options:  ids,
+		Pairwise: d,
+	}
+
+	for i := 0; i < n; i++ {
+		beatsAll := true
+		for j := 0; j < n; j++ {
+			if i != j && d[i][j] <= d[j][i] {
+				beatsAll = false
+				break
+			}
+		}
+		if beatsAll {
+			winner := ids[i]
+			result.CondorcetWinner = &winner
+			break
+		}
+	}
+
+	// Schulze: strongest paths via a widest-path Floyd-Warshall.
+	p := squareMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && d[i][j] > d[j][i] {
+				p[i][j] = d[i][j]
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if i == k {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if j == i || j == k {
+					continue
+				}
+				if via := min(p[i][k], p[k][j]); via > p[i][j] {
+					p[i][j] = via
+				}
+			}
+		}
+	}
+	result.StrongestPaths = p
+
+	wins := make([]int, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && p[i][j] > p[j][i] {
+				wins[i]++
+			}
+		}
+	}
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return wins[order[a]] > wins[order[b]] })
+	for k, i := range order {
+		if k > 0 && wins[i] == wins[order[k-1]] {
+			last := len(result.Ranking) - 1
+			result.Ranking[last] = append(result.Ranking[last], ids[i])
+			continue
+		}
+		result.Ranking = append(result.Ranking, []int{ids[i]})
+	}
+
+	switch {
+	case result.CondorcetWinner != nil:
+		winner := *result.CondorcetWinner
+		result.Winner = &winner
+	case len(ballots) > 0 && len(result.Ranking) > 0 && len(result.Ranking[0]) == 1:
+		winner := result.Ranking[0][0]
+		result.Winner = &winner
+	}
+
+	return result
+}
+
+func squareMatrix(n int) [][]int {
+	m := make([][]int, n)
+	for i := range m {
+		m[i] = make([]int, n)
+	}
+	return m
+}
+
+// ScoreBallot maps each poll option ID to the score the voter gave it.
+type ScoreBallot map[int]int
+
+// OptionScore summarises the scores one option received.
+type OptionScore struct {
+	OptionID int     `json:"option_id"`
+	Total    int     `json:"total"`
+	Average  float64 `json:"average"`
+	// Distribution maps each score in the poll's range to the number of
+	// voters who gave it.
+	Distribution map[int]int `json:"distribution"`
+}
+
+// StarRunoff is the automatic runoff between the two highest-scoring
+// options. Preferences counts, per finalist, the voters who scored it
+// above the other finalist.
+type StarRunoff struct {
+	Finalists    []int       `json:"finalists"`
+	Preferences  map[int]int `json:"preferences"`
+	NoPreference int         `json:"no_preference"`
+	Winner       *int        `json:"winner"`
+}
+
+// ScoreResult is the outcome of counting score ballots. Options are
+// ordered by total score, highest first. Winner is the highest total;
+// STAR polls use Runoff.Winner instead.
+type ScoreResult struct {
+	Min     int           `json:"min"`
+	Max     int           `json:"max"`
+	Options []OptionScore `json:"options"`
+	Winner  *int          `json:"winner"`
+	Runoff  *StarRunoff   `json:"runoff,omitempty"`
+}
+
+// ScoreTally totals score ballots and runs the STAR (Score Then Automatic
+// Runoff) runoff between the two options with the highest total score. A
+// runoff that ends level goes to the finalist with the higher total score.
+// Ties for a finalist spot are broken in favour of the lower option ID.
+func ScoreTally(options []int, ballots []ScoreBallot, minScore, maxScore int) ScoreResult {
+	result := ScoreResult{
+		Min: minScore,
+		Max: maxScore,
+	}
+
+	totals := make(map[int]int, len(options))
+	for _, id := range options {
+		summary := OptionScore{
+			OptionID:     id,
+			Distribution: make(map[int]int, maxScore-minScore+1),
+		}
+		for score := minScore; score <= maxScore; score++ {
+			summary.Distribution[score] = 0
+		}
+		for _, b := range ballots {
+			score, ok := b[id]
+			if !ok {
+				continue
+			}
+			summary.Total += score
+			summary.Distribution[score]++
+		}
+		if len(ballots) > 0 {
+			summary.Average = float64(summary.Total) / float64(len(ballots))
+		}
+		totals[id] = summary.Total
+		result.Options = append(result.Options, summary)
+	}
+
+	sort.SliceStable(result.Options, func(i, j int) bool {
+		a, b := result.Options[i], result.Options[j]
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		return a.OptionID < b.OptionID
+	})
+
+	if len(ballots) == 0 {
+		return result
+	}
+
+	if leaders, _ := extremes(totals, true); len(leaders) == 1 {
+		winner := leaders[0]
+		result.Winner = &winner
+	}
+
+	if len(result.Options) < 2 {
+		return result
+	}
+
+	a, b := result.Options[0].OptionID, result.Options[1].OptionID
+	runoff := &StarRunoff{
+		Finalists:   []int{a, b},
+		Preferences: map[int]int{a: 0, b: 0},
+	}
+	for _, ballot := range ballots {
+		switch {
+		case ballot[a] > ballot[b]:
+			runoff.Preferences[a]++
+		case ballot[b] > ballot[a]:
+			runoff.Preferences[b]++
+		default:
+			runoff.NoPreference++
+		}
+	}
+	switch {
+	case runoff.Preferences[a] > runoff.Preferences[b]:
+		runoff.Winner = &a
+	case runoff.Preferences[b] > runoff.Preferences[a]:
+		runoff.Winner = &b
+	case totals[a] > totals[b]:
+		runoff.Winner = &a
+	case totals[b] > totals[a]:
+		runoff.Winner = &b
+	}
+	result.Runoff = runoff
+
+	return result
+}
+
+// ProposalResult reports whether a Yes/No/Abstain proposal passed.
+// Abstentions are counted but never affect the outcome: YesPercentage is
+// the share of Yes among Yes and No votes.
+type ProposalResult struct {
+	Yes                int     `json:"yes"`
+	No                 int     `json:"no"`
+	Abstain            int     `json:"abstain"`
+	Threshold          string  `json:"threshold"`
+	RequiredPercentage float64 `json:"required_percentage"`
+	YesPercentage      float64 `json:"yes_percentage"`
+	Passed             bool    `json:"passed"`
+}
+
+// ProposalTally applies a pass threshold to proposal votes. A simple
+// majority needs more Yes than No votes, two thirds needs at least two Yes
+// votes for every No vote, and a percentage threshold needs Yes to reach
+// the given share of non-abstaining votes. A proposal with no Yes or No
+// votes never passes.
+func ProposalTally(yes, no, abstain int, threshold string, percentage int) ProposalResult {
+	result := ProposalResult{
+		Yes:       yes,
+		No:        no,
+		Abstain:   abstain,
+		Threshold: threshold,
+	}
+
+	decisive := yes + no
+	if decisive > 0 {
+		result.YesPercentage = float64(yes) * 100 / float64(decisive)
+	}
+
+	switch threshold {
+	case "two_thirds":
+		result.RequiredPercentage = 200.0 / 3
+		result.Passed = decisive > 0 && 3*yes >= 2*decisive
+	case "percentage":
+		result.RequiredPercentage = float64(percentage)
+		result.Passed = decisive > 0 && 100*yes >= percentage*decisive
+	default:
+		result.RequiredPercentage = 50
+		result.Passed = yes > no
+	}
+
+	return result
+}
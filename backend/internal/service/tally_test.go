@@ -0,0 +1,458 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"pollapp/backend/ent"
+)
+
+// repeat returns n copies of the ballot.
+func repeat(n int, b RankedBallot) []RankedBallot {
+	ballots := make([]RankedBallot, n)
+	for i := range ballots {
+		ballots[i] = b
+	}
+	return ballots
+}
+
+func concat(groups ...[]RankedBallot) []RankedBallot {
+	var ballots []RankedBallot
+	for _, g := range groups {
+		ballots = append(ballots, g...)
+	}
+	return ballots
+}
+
+func TestInstantRunoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []int
+		ballots []RankedBallot
+		winner  int // 0 for none
+		tied    []int
+		// rounds describes each round's tallies, eliminations and
+		// transfers.
+		rounds []RunoffRound
+	}{
+		{
+			name:    "first-round majority",
+			options: []int{1, 2, 3},
+			ballots: concat(
+				repeat(2, RankedBallot{1, 2}),
+				repeat(1, RankedBallot{1}),
+				repeat(1, RankedBallot{2, 1}),
+				repeat(1, RankedBallot{3}),
+			),
+			winner: 1,
+			rounds: []RunoffRound{
+				{Round: 1, Tallies: map[int]int{1: 3, 2: 1, 3: 1}},
+			},
+		},
+		{
+			name:    "multi-round elimination",
+			options: []int{1, 2, 3, 4},
+			ballots: concat(
+				repeat(5, RankedBallot{1}),
+				repeat(4, RankedBallot{2, 3}),
+				repeat(3, RankedBallot{3, 2}),
+				repeat(2, RankedBallot{4, 3}),
+			),
+			winner: 3,
+			rounds: []RunoffRound{
+				{Round: 1, Tallies: map[int]int{1: 5, 2: 4, 3: 3, 4: 2}, Eliminated: []int{4}, Transfers: map[int]int{3: 2}},
+				{Round: 2, Tallies: map[int]int{1: 5, 2: 4, 3: 5}, Eliminated: []int{2}, Transfers: map[int]int{3: 4}},
+				{Round: 3, Tallies: map[int]int{1: 5, 3: 9}},
+			},
+		},
+		{
+			// Once option 3 is out its ballots have no further
+			// preference, and option 1's 4 of the remaining 7 ballots are
+			// a majority even though they are not a majority of all 9.
+			name:    "exhausted ballots",
+			options: []int{1, 2, 3},
+			ballots: concat(
+				repeat(4, RankedBallot{1}),
+				repeat(3, RankedBallot{2}),
+				repeat(2, RankedBallot{3}),
+			),
+			winner: 1,
+			rounds: []RunoffRound{
+				{Round: 1, Tallies: map[int]int{1: 4, 2: 3, 3: 2}, Eliminated: []int{3}, Transfers: map[int]int{}, ExhaustedTransfers: 2},
+				{Round: 2, Tallies: map[int]int{1: 4, 2: 3}, Exhausted: 2},
+			},
+		},
+		{
+			name:    "options tied for last place are eliminated together",
+			options: []int{1, 2, 3, 4},
+			ballots: concat(
+				repeat(4, RankedBallot{1}),
+				repeat(3, RankedBallot{2, 1}),
+				repeat(1, RankedBallot{3, 2}),
+				repeat(1, RankedBallot{4, 2}),
+			),
+			winner: 2,
+			rounds: []RunoffRound{
+				{Round: 1, Tallies: map[int]int{1: 4, 2: 3, 3: 1, 4: 1}, Eliminated: []int{3, 4}, Transfers: map[int]int{2: 2}},
+				{Round: 2, Tallies: map[int]int{1: 4, 2: 5}},
+			},
+		},
+		{
+			name:    "tie for first in the final round",
+			options: []int{1, 2},
+			ballots: concat(
+				repeat(2, RankedBallot{1}),
+				repeat(2, RankedBallot{2}),
+			),
+			tied: []int{1, 2},
+			rounds: []RunoffRound{
+				{Round: 1, Tallies: map[int]int{1: 2, 2: 2}},
+			},
+		},
+		{
+			// Ballots that only rank options no longer on the poll leave
+			// nothing to count.
+			name:    "all ballots exhausted",
+			options: []int{1, 2},
+			ballots: []RankedBallot{{3}, {3, 4}, {}},
+			rounds: []RunoffRound{
+				{Round: 1, Tallies: map[int]int{1: 0, 2: 0}, Exhausted: 3},
+			},
+		},
+		{
+			name:    "no ballots",
+			options: []int{1, 2},
+			rounds: []RunoffRound{
+				{Round: 1, Tallies: map[int]int{1: 0, 2: 0}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InstantRunoff(tt.options, tt.ballots)
+
+			switch {
+			case tt.winner == 0 && got.Winner != nil:
+				t.Errorf("winner = %d, want none", *got.Winner)
+			case tt.winner != 0 && (got.Winner == nil || *got.Winner != tt.winner):
+				t.Errorf("winner = %v, want %d", got.Winner, tt.winner)
+			}
+			if !reflect.DeepEqual(got.Tied, tt.tied) {
+				t.Errorf("tied = %v, want %v", got.Tied, tt.tied)
+			}
+			if !reflect.DeepEqual(got.Rounds, tt.rounds) {
+				t.Errorf("rounds = %+v\nwant %+v", got.Rounds, tt.rounds)
+			}
+		})
+	}
+}
+
+func TestCondorcet(t *testing.T) {
+	tests := []struct {
+		name           string
+		ballots        []RankedBallot
+		pairwise       [][]int
+		strongestPaths [][]int
+		condorcet      int // 0 for none
+		ranking        [][]int
+		winner         int // 0 for none
+	}{
+		{
+			// Option 1 has the most first preferences, but 2 beats both
+			// 1 (4-3) and 3 (5-2) head to head.
+			name: "Condorcet winner",
+			ballots: concat(
+				repeat(3, RankedBallot{1, 2, 3}),
+				repeat(2, RankedBallot{3, 2, 1}),
+				repeat(2, RankedBallot{2, 1, 3}),
+			),
+			pairwise:       [][]int{{0, 3, 5}, {4, 0, 5}, {2, 2, 0}},
+			strongestPaths: [][]int{{0, 0, 5}, {4, 0, 5}, {0, 0, 0}},
+			condorcet:      2,
+			ranking:        [][]int{{2}, {1}, {3}},
+			winner:         2,
+		},
+		{
+			// 1 beats 2 (5-2), 2 beats 3 (5-2) and 3 beats 1 (4-3). The
+			// weakest defeat, 3 over 1, loses out: 1's path to 3 through
+			// 2 has strength 5, 3's direct win over 1 only 4.
+			name: "cycle resolved by Schulze",
+			ballots: concat(
+				repeat(3, RankedBallot{1, 2, 3}),
+				repeat(2, RankedBallot{2, 3, 1}),
+				repeat(2, RankedBallot{3, 1, 2}),
+			),
+			pairwise:       [][]int{{0, 5, 3}, {2, 0, 5}, {4, 2, 0}},
+			strongestPaths: [][]int{{0, 5, 5}, {4, 0, 5}, {4, 4, 0}},
+			ranking:        [][]int{{1}, {2}, {3}},
+			winner:         1,
+		},
+		{
+			// A perfectly symmetric cycle: every strongest path has the
+			// same strength, so nobody can be separated.
+			name:           "full tie",
+			ballots:        []RankedBallot{{1, 2, 3}, {2, 3, 1}, {3, 1, 2}},
+			pairwise:       [][]int{{0, 2, 1}, {1, 0, 2}, {2, 1, 0}},
+			strongestPaths: [][]int{{0, 2, 2}, {2, 0, 2}, {2, 2, 0}},
+			ranking:        [][]int{{1, 2, 3}},
+		},
+		{
+			name:           "no ballots",
+			pairwise:       [][]int{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+			strongestPaths: [][]int{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+			ranking:        [][]int{{1, 2, 3}},
+		},
+		{
+			// Options left off a ballot rank below those on it, so
+			// voters who only list 3 still prefer it to 1 and 2.
+			name: "unranked options count as last",
+			ballots: concat(
+				repeat(2, RankedBallot{3}),
+				repeat(1, RankedBallot{1, 2}),
+			),
+			pairwise:       [][]int{{0, 1, 1}, {0, 0, 1}, {2, 2, 0}},
+			strongestPaths: [][]int{{0, 1, 0}, {0, 0, 0}, {2, 2, 0}},
+			condorcet:      3,
+			ranking:        [][]int{{3}, {1}, {2}},
+			winner:         3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Condorcet([]int{3, 1, 2}, tt.ballots)
+
+			if want := []int{1, 2, 3}; !reflect.DeepEqual(got.Options, want) {
+				t.Errorf("options = %v, want %v", got.Options, want)
+			}
+			if !reflect.DeepEqual(got.Pairwise, tt.pairwise) {
+				t.Errorf("pairwise = %v, want %v", got.Pairwise, tt.pairwise)
+			}
+			if !reflect.DeepEqual(got.StrongestPaths, tt.strongestPaths) {
+				t.Errorf("strongest paths = %v, want %v", got.StrongestPaths, tt.strongestPaths)
+			}
+			switch {
+			case tt.condorcet == 0 && got.CondorcetWinner != nil:
+				t.Errorf("Condorcet winner = %d, want none", *got.CondorcetWinner)
+			case tt.condorcet != 0 && (got.CondorcetWinner == nil || *got.CondorcetWinner != tt.condorcet):
+				t.Errorf("Condorcet winner = %v, want %d", got.CondorcetWinner, tt.condorcet)
+			}
+			if !reflect.DeepEqual(got.Ranking, tt.ranking) {
+				t.Errorf("ranking = %v, want %v", got.Ranking, tt.ranking)
+			}
+			switch {
+			case tt.winner == 0 && got.Winner != nil:
+				t.Errorf("winner = %d, want none", *got.Winner)
+			case tt.winner != 0 && (got.Winner == nil || *got.Winner != tt.winner):
+				t.Errorf("winner = %v, want %d", got.Winner, tt.winner)
+			}
+		})
+	}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func TestScoreTally(t *testing.T) {
+	tests := []struct {
+		name    string
+		ballots []ScoreBallot
+		// totals lists option IDs and their totals, highest first.
+		totals [][2]int
+		winner int // 0 for none
+		runoff *StarRunoff
+	}{
+		{
+			name: "scoring round",
+			ballots: []ScoreBallot{
+				{1: 5, 2: 3, 3: 0},
+				{1: 4, 2: 5, 3: 1},
+				{1: 5, 2: 1, 3: 2},
+			},
+			totals: [][2]int{{1, 14}, {2, 9}, {3, 3}},
+			winner: 1,
+			runoff: &StarRunoff{
+				Finalists:   []int{1, 2},
+				Preferences: map[int]int{1: 2, 2: 1},
+				Winner:      intPtr(1),
+			},
+		},
+		{
+			// 1 has the highest total, but most voters score 2 above it.
+			name: "runoff overturns the score leader",
+			ballots: []ScoreBallot{
+				{1: 5, 2: 0, 3: 0},
+				{1: 1, 2: 2, 3: 0},
+				{1: 1, 2: 2, 3: 0},
+			},
+			totals: [][2]int{{1, 7}, {2, 4}, {3, 0}},
+			winner: 1,
+			runoff: &StarRunoff{
+				Finalists:   []int{1, 2},
+				Preferences: map[int]int{1: 1, 2: 2},
+				Winner:      intPtr(2),
+			},
+		},
+		{
+			// Options missing from a ballot score 0 in the runoff too.
+			name: "tie for a finalist spot goes to the lower ID",
+			ballots: []ScoreBallot{
+				{1: 3, 2: 4},
+				{1: 3, 3: 4},
+			},
+			totals: [][2]int{{1, 6}, {2, 4}, {3, 4}},
+			winner: 1,
+			runoff: &StarRunoff{
+				Finalists:   []int{1, 2},
+				Preferences: map[int]int{1: 1, 2: 1},
+				Winner:      intPtr(1),
+			},
+		},
+		{
+			name: "level runoff goes to the higher total",
+			ballots: []ScoreBallot{
+				{1: 5, 2: 0, 3: 0},
+				{1: 0, 2: 4, 3: 0},
+				{1: 3, 2: 3, 3: 0},
+			},
+			totals: [][2]int{{1, 8}, {2, 7}, {3, 0}},
+			winner: 1,
+			runoff: &StarRunoff{
+				Finalists:    []int{1, 2},
+				Preferences:  map[int]int{1: 1, 2: 1},
+				NoPreference: 1,
+				Winner:       intPtr(1),
+			},
+		},
+		{
+			name: "level runoff with level totals has no winner",
+			ballots: []ScoreBallot{
+				{1: 5, 2: 0, 3: 1},
+				{1: 0, 2: 5, 3: 1},
+			},
+			totals: [][2]int{{1, 5}, {2, 5}, {3, 2}},
+			runoff: &StarRunoff{
+				Finalists:   []int{1, 2},
+				Preferences: map[int]int{1: 1, 2: 1},
+			},
+		},
+		{
+			name:   "no ballots",
+			totals: [][2]int{{1, 0}, {2, 0}, {3, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ScoreTally([]int{1, 2, 3}, tt.ballots, 0, 5)
+
+			var totals [][2]int
+			for _, o := range got.Options {
+				totals = append(totals, [2]int{o.OptionID, o.Total})
+			}
+			if !reflect.DeepEqual(totals, tt.totals) {
+				t.Errorf("totals = %v, want %v", totals, tt.totals)
+			}
+			switch {
+			case tt.winner == 0 && got.Winner != nil:
+				t.Errorf("score winner = %d, want none", *got.Winner)
+			case tt.winner != 0 && (got.Winner == nil || *got.Winner != tt.winner):
+				t.Errorf("score winner = %v, want %d", got.Winner, tt.winner)
+			}
+			if !reflect.DeepEqual(got.Runoff, tt.runoff) {
+				t.Errorf("runoff = %+v, want %+v", got.Runoff, tt.runoff)
+			}
+		})
+	}
+}
+
+func TestScoreTallyDistribution(t *testing.T) {
+	got := ScoreTally([]int{1}, []ScoreBallot{{1: 2}, {1: 2}, {1: 0}, {}}, 0, 3)
+
+	o := got.Options[0]
+	if want := map[int]int{0: 1, 1: 0, 2: 2, 3: 0}; !reflect.DeepEqual(o.Distribution, want) {
+		t.Errorf("distribution = %v, want %v", o.Distribution, want)
+	}
+	// Averages are over every ballot, including ones that left the
+	// option unscored.
+	if o.Average != 1 {
+		t.Errorf("average = %v, want 1", o.Average)
+	}
+}
+
+func TestProposalTally(t *testing.T) {
+	tests := []struct {
+		name       string
+		yes, no    int
+		abstain    int
+		threshold  string
+		percentage int
+		required   float64
+		yesPercent float64
+		wantPassed bool
+	}{
+		{name: "simple majority", yes: 6, no: 5, threshold: "simple_majority", required: 50, yesPercent: 600.0 / 11, wantPassed: true},
+		{name: "simple majority needs more than half", yes: 5, no: 5, threshold: "simple_majority", required: 50, yesPercent: 50},
+		{name: "simple majority ignores abstentions", yes: 1, no: 0, abstain: 100, threshold: "simple_majority", required: 50, yesPercent: 100, wantPassed: true},
+		{name: "exactly two thirds passes", yes: 4, no: 2, threshold: "two_thirds", required: 200.0 / 3, yesPercent: 400.0 / 6, wantPassed: true},
+		{name: "just under two thirds fails", yes: 3, no: 2, threshold: "two_thirds", required: 200.0 / 3, yesPercent: 60},
+		{name: "two thirds ignores abstentions", yes: 2, no: 1, abstain: 10, threshold: "two_thirds", required: 200.0 / 3, yesPercent: 200.0 / 3, wantPassed: true},
+		{name: "exactly the percentage passes", yes: 3, no: 1, threshold: "percentage", percentage: 75, required: 75, yesPercent: 75, wantPassed: true},
+		{name: "just under the percentage fails", yes: 2, no: 1, threshold: "percentage", percentage: 75, required: 75, yesPercent: 200.0 / 3},
+		{name: "percentage is of non-abstaining votes", yes: 1, no: 1, abstain: 8, threshold: "percentage", percentage: 50, required: 50, yesPercent: 50, wantPassed: true},
+		{name: "unanimity", yes: 4, no: 1, threshold: "percentage", percentage: 100, required: 100, yesPercent: 80},
+		{name: "only abstentions never pass", abstain: 5, threshold: "percentage", percentage: 1, required: 1},
+		{name: "no votes never pass", threshold: "two_thirds", required: 200.0 / 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ProposalTally(tt.yes, tt.no, tt.abstain, tt.threshold, tt.percentage)
+
+			if got.Passed != tt.wantPassed {
+				t.Errorf("passed = %v, want %v", got.Passed, tt.wantPassed)
+			}
+			if got.RequiredPercentage != tt.required {
+				t.Errorf("required percentage = %v, want %v", got.RequiredPercentage, tt.required)
+			}
+			if got.YesPercentage != tt.yesPercent {
+				t.Errorf("yes percentage = %v, want %v", got.YesPercentage, tt.yesPercent)
+			}
+			if got.Abstain != tt.abstain {
+				t.Errorf("abstain = %d, want %d", got.Abstain, tt.abstain)
+			}
+		})
+	}
+}
+
+func TestProposalCounts(t *testing.T) {
+	votes := map[int]int{10: 3, 11: 1, 12: 7}
+
+	// Options are matched by label, whatever order they come in.
+	got, err := proposalCounts([]*ent.PollOption{
+		{ID: 12, OptionText: "Abstain", Order: 2},
+		{ID: 10, OptionText: "Yes", Order: 0},
+		{ID: 11, OptionText: "No", Order: 1},
+	}, votes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int{"Yes": 3, "No": 1, "Abstain": 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("counts = %v, want %v", got, want)
+	}
+
+	for name, options := range map[string][]*ent.PollOption{
+		"missing option": {{ID: 10, OptionText: "Yes"}, {ID: 11, OptionText: "No"}},
+		"unexpected option": {
+			{ID: 10, OptionText: "Yes"},
+			{ID: 11, OptionText: "No"},
+			{ID: 12, OptionText: "Maybe"},
+		},
+	} {
+		if _, err := proposalCounts(options, votes); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"pollapp/backend/ent"
+	"pollapp/backend/ent/ballot"
+	"pollapp/backend/ent/participation"
+	"pollapp/backend/ent/poll"
+	"pollapp/backend/ent/vote"
+)
+
+// CanSeeResults reports whether the user may see the poll's vote counts
+// under its results visibility policy. userID is 0 for anonymous callers.
+// The poll's creator and collaborators can always see the results.
+func (s *PollService) CanSeeResults(ctx context.Context, p *ent.Poll, userID int) (bool, error) {
+	ok, err := s.hasRight(ctx, p, userID, rightViewResults)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	switch p.ResultsVisibility {
+	case poll.ResultsVisibilityAlways:
+		return true, nil
+	case poll.ResultsVisibilityAfterVote:
+		if userID == 0 {
+			return false, nil
+		}
+		return s.HasVoted(ctx, p, userID)
+	case poll.ResultsVisibilityAfterClose:
+		status := PollStatus(p, time.Now())
+		return status == PollStatusClosed || status == PollStatusArchived, nil
+	default:
+		return false, nil
+	}
+}
+
+// HasVoted reports whether the user has cast a ballot on the poll.
+func (s *PollService) HasVoted(ctx context.Context, p *ent.Poll, userID int) (bool, error) {
+	if p.Anonymous {
+		return s.client.Participation.Query().
+			Where(participation.PollIDEQ(p.ID), participation.UserIDEQ(userID)).
+			Exist(ctx)
+	}
+	if usesBallots(p) {
+		return s.client.Ballot.Query().
+			Where(ballot.PollIDEQ(p.ID), ballot.UserIDEQ(userID)).
+			Exist(ctx)
+	}
+	return s.client.Vote.Query().
+		Where(vote.PollIDEQ(p.ID), vote.UserIDEQ(userID)).
+		Exist(ctx)
+}
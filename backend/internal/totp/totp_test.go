@@ -0,0 +1,81 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 key of the RFC 6238 test vectors,
+// "12345678901234567890", base32-encoded.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B, SHA-1. The RFC gives 8 digits; 6-digit codes
+	// are their last 6.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeInvalidSecret(t *testing.T) {
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Error("Code accepted an invalid secret")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current step", secret: rfcSecret, code: mustCode(t, step), wantStep: step, wantOK: true},
+		{name: "previous step", secret: rfcSecret, code: mustCode(t, step-1), wantStep: step - 1, wantOK: true},
+		{name: "next step", secret: rfcSecret, code: mustCode(t, step+1), wantStep: step + 1, wantOK: true},
+		{name: "two steps back", secret: rfcSecret, code: mustCode(t, step-2)},
+		{name: "two steps ahead", secret: rfcSecret, code: mustCode(t, step+2)},
+		{name: "lowercase secret", secret: "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", code: mustCode(t, step), wantStep: step, wantOK: true},
+		{name: "wrong code", secret: rfcSecret, code: "000000"},
+		{name: "too short", secret: rfcSecret, code: mustCode(t, step)[1:]},
+		{name: "too long", secret: rfcSecret, code: mustCode(t, step) + "0"},
+		{name: "invalid secret", secret: "not base32!", code: mustCode(t, step)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, ok := Validate(tt.secret, tt.code, now)
+			if ok != tt.wantOK || gotStep != tt.wantStep {
+				t.Errorf("Validate = %d, %v, want %d, %v", gotStep, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func mustCode(t *testing.T, step int64) string {
+	t.Helper()
+	code, err := Code(rfcSecret, step)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
@@ -35,7 +35,27 @@ mysql -u "$DB_USER" -p"$DB_PASSWORD" "$DB_NAME" <<EOF
 SET FOREIGN_KEY_CHECKS = 0;
 
 -- Drop tables if they exist (for clean setup)
+DROP TABLE IF EXISTS recovery_codes;
+DROP TABLE IF EXISTS email_tokens;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS sessions;
+DROP TABLE IF EXISTS audit_events;
+DROP TABLE IF EXISTS poll_revisions;
+DROP TABLE IF EXISTS poll_collaborators;
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
+DROP TABLE IF EXISTS share_links;
+DROP TABLE IF EXISTS poll_members;
+DROP TABLE IF EXISTS ballot_log_entries;
+DROP TABLE IF EXISTS anonymous_ballots;
+DROP TABLE IF EXISTS participations;
+DROP TABLE IF EXISTS poll_transitions;
+DROP TABLE IF EXISTS ballot_entries;
+DROP TABLE IF EXISTS ballots;
 DROP TABLE IF EXISTS votes;
+DROP TABLE IF EXISTS guest_voters;
 DROP TABLE IF EXISTS poll_options;
 DROP TABLE IF EXISTS polls;
 DROP TABLE IF EXISTS users;
@@ -46,17 +66,69 @@ CREATE TABLE users (
     username VARCHAR(255) NOT NULL UNIQUE,
     email VARCHAR(255) NOT NULL UNIQUE,
     password_hash VARCHAR(255) NOT NULL,
+    role VARCHAR(32) NOT NULL DEFAULT 'user',
+    banned_at TIMESTAMP NULL,
+    email_verified_at TIMESTAMP NULL,
+    totp_secret VARCHAR(255) NULL,
+    totp_enabled_at TIMESTAMP NULL,
+    totp_last_step BIGINT NOT NULL DEFAULT 0,
+    totp_failures INT NOT NULL DEFAULT 0,
+    totp_failed_at TIMESTAMP NULL,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+-- Create organizations table
+CREATE TABLE organizations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create organization_members table
+CREATE TABLE organization_members (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    role VARCHAR(32) NOT NULL DEFAULT 'member',
+    pending BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    UNIQUE KEY unique_organization_user (organization_id, user_id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 -- Create polls table
 CREATE TABLE polls (
     id BIGINT AUTO_INCREMENT PRIMARY KEY,
     title VARCHAR(255) NOT NULL,
     description TEXT NOT NULL,
     created_by BIGINT NOT NULL,
+    organization_id BIGINT NULL,
+    min_choices BIGINT NOT NULL DEFAULT 1,
+    max_choices BIGINT NOT NULL DEFAULT 1,
+    voting_method VARCHAR(32) NOT NULL DEFAULT 'plurality',
+    tally_rule VARCHAR(32) NOT NULL DEFAULT 'instant_runoff',
+    score_min BIGINT NOT NULL DEFAULT 0,
+    score_max BIGINT NOT NULL DEFAULT 5,
+    pass_threshold VARCHAR(32) NOT NULL DEFAULT 'simple_majority',
+    pass_percentage BIGINT NOT NULL DEFAULT 50,
+    opens_at TIMESTAMP NULL,
+    closes_at TIMESTAMP NULL,
+    close_event_at TIMESTAMP NULL,
+    closed_at TIMESTAMP NULL,
+    archived_at TIMESTAMP NULL,
+    visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+    results_visibility VARCHAR(32) NOT NULL DEFAULT 'always',
+    anonymous BOOLEAN NOT NULL DEFAULT FALSE,
+    allow_guests BOOLEAN NOT NULL DEFAULT FALSE,
+    guest_dedupe VARCHAR(32) NOT NULL DEFAULT 'token',
+    revision BIGINT NOT NULL DEFAULT 1,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    INDEX idx_created_by (created_by)
+    INDEX idx_created_by (created_by),
+    INDEX idx_closes_at (closes_at),
+    INDEX idx_organization_id (organization_id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 -- Create poll_options table
@@ -69,19 +141,266 @@ CREATE TABLE poll_options (
     FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
--- Create votes table
+-- Create guest_voters table (people without an account voting on polls
+-- that allow guests)
+CREATE TABLE guest_voters (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    ip_hash VARCHAR(64) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_poll_ip (poll_id, ip_hash),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create votes table (exactly one of user_id and guest_voter_id is set)
 CREATE TABLE votes (
     id BIGINT AUTO_INCREMENT PRIMARY KEY,
     poll_id BIGINT NOT NULL,
     poll_option_id BIGINT NOT NULL,
-    user_id BIGINT NOT NULL,
+    user_id BIGINT NULL,
+    guest_voter_id BIGINT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     INDEX idx_poll_id (poll_id),
     INDEX idx_poll_option_id (poll_option_id),
     INDEX idx_user_id (user_id),
-    UNIQUE KEY unique_user_poll (user_id, poll_id),
+    UNIQUE KEY unique_user_poll_option (user_id, poll_id, poll_option_id),
+    UNIQUE KEY unique_guest_poll_option (guest_voter_id, poll_id, poll_option_id),
     FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
     FOREIGN KEY (poll_option_id) REFERENCES poll_options(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (guest_voter_id) REFERENCES guest_voters(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create ballots table (ranked, score and STAR polls)
+CREATE TABLE ballots (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    user_id BIGINT NULL,
+    guest_voter_id BIGINT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    UNIQUE KEY unique_poll_user (poll_id, user_id),
+    UNIQUE KEY unique_poll_guest (poll_id, guest_voter_id),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (guest_voter_id) REFERENCES guest_voters(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create ballot_entries table
+CREATE TABLE ballot_entries (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    ballot_id BIGINT NOT NULL,
+    poll_option_id BIGINT NOT NULL,
+    \`rank\` BIGINT NULL,
+    score BIGINT NULL,
+    INDEX idx_poll_option_id (poll_option_id),
+    UNIQUE KEY unique_ballot_option (ballot_id, poll_option_id),
+    FOREIGN KEY (ballot_id) REFERENCES ballots(id) ON DELETE CASCADE,
+    FOREIGN KEY (poll_option_id) REFERENCES poll_options(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create poll_transitions table
+CREATE TABLE poll_transitions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    actor_id BIGINT NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_poll_id (poll_id),
+    INDEX idx_actor_id (actor_id),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
+    FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create participations table (who voted on anonymous polls)
+CREATE TABLE participations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    user_id BIGINT NULL,
+    guest_voter_id BIGINT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    UNIQUE KEY unique_poll_user (poll_id, user_id),
+    UNIQUE KEY unique_poll_guest (poll_id, guest_voter_id),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (guest_voter_id) REFERENCES guest_voters(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create anonymous_ballots table (what was chosen on anonymous polls;
+-- deliberately no user reference and a random primary key)
+CREATE TABLE anonymous_ballots (
+    id VARCHAR(64) PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    choices JSON NULL,
+    scores JSON NULL,
+    receipt_hash VARCHAR(64) NOT NULL UNIQUE,
+    cast_on TIMESTAMP NOT NULL,
+    INDEX idx_poll_id (poll_id),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create ballot_log_entries table (per-poll hash chain of ballot hashes)
+CREATE TABLE ballot_log_entries (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    sequence BIGINT NOT NULL,
+    ballot_hash VARCHAR(64) NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    UNIQUE KEY unique_poll_sequence (poll_id, sequence),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create poll_members table (users invited to a poll)
+CREATE TABLE poll_members (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    UNIQUE KEY unique_poll_user (poll_id, user_id),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create poll_collaborators table (users sharing control of a poll)
+CREATE TABLE poll_collaborators (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    role VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    UNIQUE KEY unique_poll_user (poll_id, user_id),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create share_links table (secret /p/<token> links to polls)
+CREATE TABLE share_links (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    created_by BIGINT NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    \`grant\` VARCHAR(32) NOT NULL DEFAULT 'vote',
+    expires_at TIMESTAMP NULL,
+    max_uses BIGINT NULL,
+    uses BIGINT NOT NULL DEFAULT 0,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_poll_id (poll_id),
+    FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create poll_revisions table (history of changes to polls and options;
+-- kept after the poll is deleted, so no foreign keys)
+CREATE TABLE poll_revisions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    entity VARCHAR(32) NOT NULL,
+    entity_id BIGINT NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    actor_id BIGINT NULL,
+    \`before\` JSON NULL,
+    \`after\` JSON NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_poll_created (poll_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create audit_events table (append-only security log; kept when users
+-- are deleted, so no foreign keys)
+CREATE TABLE audit_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event VARCHAR(64) NOT NULL,
+    actor_id BIGINT NULL,
+    ip VARCHAR(64) NULL,
+    user_agent VARCHAR(512) NULL,
+    details JSON NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_event_created (event, created_at),
+    INDEX idx_actor_created (actor_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create sessions table (one per sign-in, revoked on logout)
+CREATE TABLE sessions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    org_id BIGINT NULL,
+    revoked_at TIMESTAMP NULL,
+    revoked_reason VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create refresh_tokens table (single-use, rotated on every refresh)
+CREATE TABLE refresh_tokens (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    session_id BIGINT NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_session_id (session_id),
+    FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create user_identities table (accounts at OpenID providers linked to
+-- users)
+CREATE TABLE user_identities (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY unique_issuer_subject (issuer, subject),
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create api_keys table (personal keys for scripts and bots)
+CREATE TABLE api_keys (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    scopes JSON NULL,
+    org_id BIGINT NULL,
+    last_used_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create email_tokens table (single-use password reset and email
+-- verification tokens)
+CREATE TABLE email_tokens (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    purpose VARCHAR(32) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    email VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_purpose (user_id, purpose),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Create recovery_codes table (hashed single-use two-factor recovery
+-- codes)
+CREATE TABLE recovery_codes (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    code_hash VARCHAR(64) NOT NULL UNIQUE,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -8,6 +8,146 @@ USE pollapp;
 SET FOREIGN_KEY_CHECKS = 0;
 
 -- Delete all data from tables (ignore errors if tables don't exist)
+-- Ballot entries table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'ballot_entries') > 0,
+    'TRUNCATE TABLE ballot_entries', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Ballots table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'ballots') > 0,
+    'TRUNCATE TABLE ballots', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Poll transitions table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'poll_transitions') > 0,
+    'TRUNCATE TABLE poll_transitions', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Anonymous ballots table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'anonymous_ballots') > 0,
+    'TRUNCATE TABLE anonymous_ballots', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Participations table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'participations') > 0,
+    'TRUNCATE TABLE participations', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Ballot log entries table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'ballot_log_entries') > 0,
+    'TRUNCATE TABLE ballot_log_entries', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Guest voters table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'guest_voters') > 0,
+    'TRUNCATE TABLE guest_voters', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Poll members table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'poll_members') > 0,
+    'TRUNCATE TABLE poll_members', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Share links table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'share_links') > 0,
+    'TRUNCATE TABLE share_links', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Organization members table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'organization_members') > 0,
+    'TRUNCATE TABLE organization_members', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Organizations table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'organizations') > 0,
+    'TRUNCATE TABLE organizations', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Poll collaborators table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'poll_collaborators') > 0,
+    'TRUNCATE TABLE poll_collaborators', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Poll revisions table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'poll_revisions') > 0,
+    'TRUNCATE TABLE poll_revisions', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Audit events table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'audit_events') > 0,
+    'TRUNCATE TABLE audit_events', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Refresh tokens table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'refresh_tokens') > 0,
+    'TRUNCATE TABLE refresh_tokens', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Sessions table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'sessions') > 0,
+    'TRUNCATE TABLE sessions', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- User identities table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'user_identities') > 0,
+    'TRUNCATE TABLE user_identities', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- API keys table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'api_keys') > 0,
+    'TRUNCATE TABLE api_keys', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Email tokens table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'email_tokens') > 0,
+    'TRUNCATE TABLE email_tokens', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Recovery Codes table
+SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'recovery_codes') > 0,
+    'TRUNCATE TABLE recovery_codes', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
 -- Votes table
 SET @sql = IF((SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'pollapp' AND table_name = 'votes') > 0,
     'TRUNCATE TABLE votes', 'SELECT 1');
@@ -4,6 +4,9 @@ import Login from './pages/Login';
 import Register from './pages/Register';
 import PollList from './pages/PollList';
 import CreatePoll from './pages/CreatePoll';
+import ResetPassword from './pages/ResetPassword';
+import VerifyEmail from './pages/VerifyEmail';
+import TwoFactor from './pages/TwoFactor';
 import './App.css';
 
 function App() {
@@ -32,6 +35,9 @@ function App() {
         <Route path="/register" element={!isAuthenticated ? <Register /> : <Navigate to="/" />} />
         <Route path="/" element={isAuthenticated ? <PollList onLogout={() => setIsAuthenticated(false)} /> : <Navigate to="/login" />} />
         <Route path="/create" element={isAuthenticated ? <CreatePoll /> : <Navigate to="/login" />} />
+        <Route path="/reset-password" element={<ResetPassword />} />
+        <Route path="/verify-email" element={<VerifyEmail />} />
+        <Route path="/two-factor" element={isAuthenticated ? <TwoFactor /> : <Navigate to="/login" />} />
       </Routes>
     </Router>
   );
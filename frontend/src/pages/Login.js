@@ -1,29 +1,102 @@
-import React, { useState } from 'react';
+import React, { useCallback, useEffect, useState } from 'react';
 import { useNavigate, Link } from 'react-router-dom';
 import { authAPI } from '../services/api';
+import { TOTPSetup } from './TwoFactor';
 import './Login.css';
 
 function Login({ onLogin }) {
   const [email, setEmail] = useState('');
   const [password, setPassword] = useState('');
   const [error, setError] = useState('');
+  // A second factor is needed when login hands back an mfa token
+  const [mfa, setMFA] = useState(null);
+  const [code, setCode] = useState('');
   const navigate = useNavigate();
 
+  const finishLogin = useCallback((tokens) => {
+    localStorage.setItem('token', tokens.token);
+    localStorage.setItem('refresh_token', tokens.refresh_token);
+    // Notify parent component of login
+    if (onLogin) {
+      onLogin();
+    }
+    navigate('/');
+  }, [navigate, onLogin]);
+
+  const handleLoginResult = useCallback((result) => {
+    if (result.mfa_token) {
+      setMFA({ token: result.mfa_token, enroll: !!result.mfa_enrollment_required });
+    } else {
+      finishLogin(result);
+    }
+  }, [finishLogin]);
+
+  // Single sign-on comes back here with the tokens in the URL fragment
+  useEffect(() => {
+    const params = new URLSearchParams(window.location.hash.slice(1));
+    if (!params.get('token') && !params.get('mfa_token') && !params.get('error')) {
+      return;
+    }
+    window.history.replaceState(null, '', window.location.pathname);
+    if (params.get('error')) {
+      setError(params.get('error'));
+      return;
+    }
+    handleLoginResult(Object.fromEntries(params));
+  }, [handleLoginResult]);
+
   const handleSubmit = async (e) => {
     e.preventDefault();
     try {
       const response = await authAPI.login(email, password);
-      localStorage.setItem('token', response.data.token);
-      // Notify parent component of login
-      if (onLogin) {
-        onLogin();
-      }
-      navigate('/');
+      handleLoginResult(response.data);
     } catch (err) {
       setError(err.response?.data?.error || 'Login failed');
     }
   };
 
+  const handleVerify = async (e) => {
+    e.preventDefault();
+    setError('');
+    try {
+      const response = await authAPI.verifyMFA(mfa.token, code);
+      finishLogin(response.data);
+    } catch (err) {
+      setError(err.response?.data?.error || 'Invalid code');
+    }
+  };
+
+  if (mfa) {
+    return (
+      <div className="login-container">
+        <div className="login-card">
+          <h2>Two-Factor Authentication</h2>
+          {mfa.enroll ? (
+            <>
+              <p>Your account requires two-factor authentication. Set it up to continue.</p>
+              <TOTPSetup mfaToken={mfa.token} onDone={finishLogin} />
+            </>
+          ) : (
+            <>
+              {error && <div className="error">{error}</div>}
+              <form onSubmit={handleVerify}>
+                <input
+                  type="text"
+                  autoComplete="one-time-code"
+                  placeholder="Code from your authenticator, or a recovery code"
+                  value={code}
+                  onChange={(e) => setCode(e.target.value)}
+                  required
+                />
+                <button type="submit">Verify</button>
+              </form>
+            </>
+          )}
+        </div>
+      </div>
+    );
+  }
+
   return (
     <div className="login-container">
       <div className="login-card">
@@ -46,6 +119,12 @@ function Login({ onLogin }) {
           />
           <button type="submit">Login</button>
         </form>
+        <p>
+          <Link to="/reset-password">Forgot your password?</Link>
+        </p>
+        <p>
+          <a href={authAPI.oidcLoginURL}>Sign in with SSO</a>
+        </p>
         <p>
           Don't have an account? <Link to="/register">Register</Link>
         </p>
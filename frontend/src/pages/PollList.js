@@ -1,6 +1,6 @@
 import React, { useState, useEffect } from 'react';
 import { useNavigate } from 'react-router-dom';
-import { pollAPI } from '../services/api';
+import { authAPI, pollAPI } from '../services/api';
 import './PollList.css';
 
 function PollList({ onLogout }) {
@@ -33,8 +33,17 @@ function PollList({ onLogout }) {
     }
   };
 
-  const handleLogout = () => {
+  const handleLogout = async () => {
+    const refreshToken = localStorage.getItem('refresh_token');
+    if (refreshToken) {
+      try {
+        await authAPI.logout(refreshToken);
+      } catch (err) {
+        console.error('Logout failed:', err);
+      }
+    }
     localStorage.removeItem('token');
+    localStorage.removeItem('refresh_token');
     if (onLogout) {
       onLogout();
     }
@@ -49,6 +58,7 @@ function PollList({ onLogout }) {
         <h1>Poll App</h1>
         <div>
           <button onClick={() => navigate('/create')}>Create Poll</button>
+          <button onClick={() => navigate('/two-factor')}>Two-Factor</button>
           <button onClick={handleLogout}>Logout</button>
         </div>
       </header>
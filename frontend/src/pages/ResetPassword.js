@@ -0,0 +1,69 @@
+import React, { useState } from 'react';
+import { Link, useSearchParams } from 'react-router-dom';
+import { authAPI } from '../services/api';
+import './Login.css';
+
+// ResetPassword asks for an email to send a reset link to or, opened from
+// that link, for the new password.
+function ResetPassword() {
+  const [searchParams] = useSearchParams();
+  const token = searchParams.get('token');
+  const [email, setEmail] = useState('');
+  const [password, setPassword] = useState('');
+  const [message, setMessage] = useState('');
+  const [error, setError] = useState('');
+
+  const handleSubmit = async (e) => {
+    e.preventDefault();
+    setError('');
+    try {
+      if (token) {
+        await authAPI.resetPassword(token, password);
+        setMessage('Your password has been changed. You can now log in.');
+      } else {
+        const response = await authAPI.forgotPassword(email);
+        setMessage(response.data.message);
+      }
+    } catch (err) {
+      setError(err.response?.data?.error || 'Something went wrong');
+    }
+  };
+
+  return (
+    <div className="login-container">
+      <div className="login-card">
+        <h2>Reset Password</h2>
+        {error && <div className="error">{error}</div>}
+        {message ? (
+          <p>{message}</p>
+        ) : (
+          <form onSubmit={handleSubmit}>
+            {token ? (
+              <input
+                type="password"
+                placeholder="New password"
+                value={password}
+                onChange={(e) => setPassword(e.target.value)}
+                required
+              />
+            ) : (
+              <input
+                type="email"
+                placeholder="Email"
+                value={email}
+                onChange={(e) => setEmail(e.target.value)}
+                required
+              />
+            )}
+            <button type="submit">{token ? 'Set password' : 'Send reset link'}</button>
+          </form>
+        )}
+        <p>
+          <Link to="/login">Back to login</Link>
+        </p>
+      </div>
+    </div>
+  );
+}
+
+export default ResetPassword;
@@ -0,0 +1,120 @@
+import React, { useEffect, useState } from 'react';
+import { Link, useNavigate } from 'react-router-dom';
+import { authAPI } from '../services/api';
+import './Login.css';
+
+// TOTPSetup walks through adding an authenticator app: scan the secret,
+// confirm a code, then note down the recovery codes. Pass the mfa token
+// when enrolling while signing in.
+export function TOTPSetup({ mfaToken, onDone }) {
+  const [enrollment, setEnrollment] = useState(null);
+  const [code, setCode] = useState('');
+  const [result, setResult] = useState(null);
+  const [error, setError] = useState('');
+
+  useEffect(() => {
+    authAPI.enrollMFA(mfaToken)
+      .then((response) => setEnrollment(response.data))
+      .catch((err) => setError(err.response?.data?.error || 'Could not start setup'));
+  }, [mfaToken]);
+
+  const handleConfirm = async (e) => {
+    e.preventDefault();
+    setError('');
+    try {
+      const response = await authAPI.confirmMFA(code, mfaToken);
+      setResult(response.data);
+    } catch (err) {
+      setError(err.response?.data?.error || 'Invalid code');
+    }
+  };
+
+  if (result) {
+    return (
+      <div>
+        <p>Two-factor authentication is on. Keep these recovery codes somewhere safe; each signs you in once if you lose your authenticator.</p>
+        <pre>{result.recovery_codes.join('\n')}</pre>
+        <button onClick={() => onDone(result)}>Continue</button>
+      </div>
+    );
+  }
+
+  return (
+    <div>
+      {error && <div className="error">{error}</div>}
+      {enrollment && (
+        <>
+          <p>
+            Add this account to your authenticator app by opening <a href={enrollment.otpauth_uri}>this link</a> or
+            entering the key <code>{enrollment.secret}</code>, then enter the code it shows.
+          </p>
+          <form onSubmit={handleConfirm}>
+            <input
+              type="text"
+              inputMode="numeric"
+              autoComplete="one-time-code"
+              placeholder="6-digit code"
+              value={code}
+              onChange={(e) => setCode(e.target.value)}
+              required
+            />
+            <button type="submit">Confirm</button>
+          </form>
+        </>
+      )}
+    </div>
+  );
+}
+
+function TwoFactor() {
+  const [settingUp, setSettingUp] = useState(false);
+  const [code, setCode] = useState('');
+  const [message, setMessage] = useState('');
+  const [error, setError] = useState('');
+  const navigate = useNavigate();
+
+  const handleDisable = async (e) => {
+    e.preventDefault();
+    setError('');
+    try {
+      await authAPI.disableMFA(code);
+      setMessage('Two-factor authentication is off.');
+      setCode('');
+    } catch (err) {
+      setError(err.response?.data?.error || 'Could not turn off two-factor authentication');
+    }
+  };
+
+  return (
+    <div className="login-container">
+      <div className="login-card">
+        <h2>Two-Factor Authentication</h2>
+        {settingUp ? (
+          <TOTPSetup onDone={() => navigate('/')} />
+        ) : (
+          <>
+            <button onClick={() => setSettingUp(true)}>Set up an authenticator app</button>
+            <p>To turn it off, enter a code from your authenticator or a recovery code.</p>
+            {error && <div className="error">{error}</div>}
+            {message && <p>{message}</p>}
+            <form onSubmit={handleDisable}>
+              <input
+                type="text"
+                placeholder="Code"
+                value={code}
+                onChange={(e) => setCode(e.target.value)}
+                required
+              />
+              <button type="submit">Turn off</button>
+            </form>
+          </>
+        )}
+        <p>
+          <Link to="/">Back to polls</Link>
+        </p>
+      </div>
+    </div>
+  );
+}
+
+export default TwoFactor;
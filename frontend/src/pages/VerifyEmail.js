@@ -0,0 +1,34 @@
+import React, { useEffect, useState } from 'react';
+import { Link, useSearchParams } from 'react-router-dom';
+import { authAPI } from '../services/api';
+import './Login.css';
+
+function VerifyEmail() {
+  const [searchParams] = useSearchParams();
+  const token = searchParams.get('token');
+  const [status, setStatus] = useState('Verifying your email...');
+
+  useEffect(() => {
+    if (!token) {
+      setStatus('This link is missing its token.');
+      return;
+    }
+    authAPI.verifyEmail(token)
+      .then(() => setStatus('Your email is verified.'))
+      .catch((err) => setStatus(err.response?.data?.error || 'Verification failed'));
+  }, [token]);
+
+  return (
+    <div className="login-container">
+      <div className="login-card">
+        <h2>Verify Email</h2>
+        <p>{status}</p>
+        <p>
+          <Link to="/">Continue</Link>
+        </p>
+      </div>
+    </div>
+  );
+}
+
+export default VerifyEmail;
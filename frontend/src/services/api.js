@@ -15,11 +15,66 @@ api.interceptors.request.use((config) => {
   return config;
 });
 
+// Access tokens are short-lived: when one is refused, trade the refresh
+// token for a new pair once and retry the request
+let refreshing = null;
+
+api.interceptors.response.use(
+  (response) => response,
+  async (error) => {
+    const original = error.config;
+    const refreshToken = localStorage.getItem('refresh_token');
+    const signingIn = ['/auth/login', '/auth/refresh', '/auth/logout'].includes(original.url)
+      || original.url.startsWith('/auth/mfa/');
+    if (error.response?.status !== 401 || original._retried || !refreshToken || signingIn) {
+      return Promise.reject(error);
+    }
+    original._retried = true;
+
+    try {
+      if (!refreshing) {
+        refreshing = axios
+          .post(`${API_BASE_URL}/auth/refresh`, { refresh_token: refreshToken })
+          .finally(() => {
+            refreshing = null;
+          });
+      }
+      const response = await refreshing;
+      localStorage.setItem('token', response.data.token);
+      localStorage.setItem('refresh_token', response.data.refresh_token);
+    } catch (refreshError) {
+      localStorage.removeItem('token');
+      localStorage.removeItem('refresh_token');
+      return Promise.reject(error);
+    }
+    return api(original);
+  }
+);
+
 export const authAPI = {
   register: (username, email, password) =>
     api.post('/auth/register', { username, email, password }),
   login: (email, password) =>
     api.post('/auth/login', { email, password }),
+  logout: (refreshToken) =>
+    api.post('/auth/logout', { refresh_token: refreshToken }),
+  forgotPassword: (email) =>
+    api.post('/auth/forgot', { email }),
+  resetPassword: (token, password) =>
+    api.post('/auth/reset', { token, password }),
+  verifyEmail: (token) =>
+    api.post('/auth/verify', { token }),
+  verifyMFA: (mfaToken, code) =>
+    api.post('/auth/mfa/verify', { mfa_token: mfaToken, code }),
+  // Pass the mfa token from login to enroll while signing in
+  enrollMFA: (mfaToken) =>
+    api.post('/auth/mfa/enroll', { mfa_token: mfaToken }),
+  confirmMFA: (code, mfaToken) =>
+    api.post('/auth/mfa/confirm', { code, mfa_token: mfaToken }),
+  disableMFA: (code) =>
+    api.post('/auth/mfa/disable', { code }),
+  // Single sign-on is a full-page redirect, not an API call
+  oidcLoginURL: `${API_BASE_URL}/auth/oidc/login`,
 };
 
 export const pollAPI = {